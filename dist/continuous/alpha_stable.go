@@ -0,0 +1,783 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/err"
+	"github.com/jtejido/stats/sample"
+	"math"
+	"math/cmplx"
+	"math/rand"
+)
+
+// StableParameterization selects how the location and scale of an AlphaStable enter its characteristic
+// function, after Nolan.
+type StableParameterization int
+
+const (
+	// S0, continuous in all four parameters, whose location is near the mode and is the centre of a
+	// symmetric law. The one to fit and compute in.
+	StableS0 StableParameterization = iota
+
+	// S1, the usual form of the characteristic function, whose location is the mean for α > 1 and the end
+	// of the support of a totally skewed law for α < 1. It jumps at α = 1 for β ≠ 0.
+	StableS1
+)
+
+var stableParameterizationNames = map[StableParameterization]string{
+	StableS0: "S0",
+	StableS1: "S1",
+}
+
+func (p StableParameterization) String() string {
+	if s, ok := stableParameterizationNames[p]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("StableParameterization(%d)", int(p))
+}
+
+// Lévy alpha-stable distribution of index α, skewness β, scale γ and location δ, in the S0 or S1
+// parameterization, of characteristic function exp(-γ^α|t|^α(1 - iβ tan(πα/2) sign t) + iδt) in S1, or
+// exp(-γ|t|(1 + iβ(2/π) sign t log|t|) + iδt) at α = 1. The location in S0 is δ₀ = δ₁ + βγ tan(πα/2), or
+// δ₁ + βγ(2/π)log γ at α = 1. It is the Normal of variance 2γ² at α = 2, the Cauchy at α = 1 and β = 0,
+// and the Levy at α = 1/2 and β = 1.
+//
+// The density and distribution function are the integrals of Nolan over θ, taken on each half of its range
+// in the log of the distance from the end, where the mass goes in the far tails, to a relative accuracy of
+// about 1e-12, tails included. Within 1e-3 of α = 1, where the integrals cancel,
+// they are interpolated in α to about 1e-10 instead. Rand is the method of Chambers, Mallows and Stuck.
+//
+// J. P. Nolan, "Numerical calculation of stable densities and distribution functions," Communications in
+// Statistics. Stochastic Models, vol. 13, no. 4, pp. 759-774, 1997.
+// J. M. Chambers, C. L. Mallows and B. W. Stuck, "A method for simulating stable random variables,"
+// Journal of the American Statistical Association, vol. 71, no. 354, pp. 340-344, 1976.
+// https://en.wikipedia.org/wiki/Stable_distribution
+type AlphaStable struct {
+	baseContinuousWithSource
+	alpha, beta, scale, location float64 // α, β, γ, δ
+	param                        StableParameterization
+}
+
+func NewAlphaStable(alpha, beta, scale, location float64, param StableParameterization) (*AlphaStable, error) {
+	return NewAlphaStableWithSource(alpha, beta, scale, location, param, nil)
+}
+
+func NewAlphaStableWithSource(alpha, beta, scale, location float64, param StableParameterization, src rand.Source) (*AlphaStable, error) {
+	if param != StableS0 && param != StableS1 {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("AlphaStable: unknown parameterization %v", param))
+	}
+
+	r := new(AlphaStable)
+	r.alpha = alpha
+	r.beta = beta
+	r.scale = scale
+	r.location = location
+	r.param = param
+	r.src = src
+
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+func (s *AlphaStable) spec() (string, []Common, []float64) {
+	return "AlphaStable", nil, []float64{s.alpha, s.beta, s.scale, s.location, float64(s.param)}
+}
+
+func (s *AlphaStable) String() string {
+	return specString(s.spec())
+}
+
+func (s *AlphaStable) MarshalJSON() ([]byte, error) {
+	return marshalJSON(s)
+}
+
+func (s *AlphaStable) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, s, s.src)
+}
+
+func (s *AlphaStable) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *AlphaStable) UnmarshalText(text []byte) error {
+	return unmarshalText(text, s, s.src)
+}
+
+func (s *AlphaStable) ParameterValues() map[string]float64 {
+	return parameterValues(s)
+}
+
+func (s *AlphaStable) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(s, params, s.src)
+}
+
+// α ∈ (0,2]
+// β ∈ [-1,1]
+// γ ∈ (0,∞)
+// δ ∈ (-∞,∞)
+// S ∈ {0,1}, the parameterization
+func (s *AlphaStable) Parameters() stats.Limits {
+	return stats.Limits{
+		"α": stats.Interval{0, 2, true, false},
+		"β": stats.Interval{-1, 1, false, false},
+		"γ": stats.Interval{0, math.Inf(1), true, true},
+		"δ": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
+		"S": stats.Interval{0, 1, false, false},
+	}
+}
+
+func (s *AlphaStable) Parameterization() StableParameterization {
+	return s.param
+}
+
+// x ∈ [δ₀ - γ tan(πα/2),∞) for α < 1 and β = 1
+// x ∈ (-∞,δ₀ + γ tan(πα/2)] for α < 1 and β = -1
+// x ∈ (-∞,∞) otherwise
+func (s *AlphaStable) Support() stats.Interval {
+	if s.alpha < 1 && math.Abs(s.beta) == 1 {
+		end := s.shift() - s.beta*s.scale*math.Tan(math.Pi*s.alpha/2)
+		if s.beta > 0 {
+			return stats.Interval{end, math.Inf(1), false, true}
+		}
+
+		return stats.Interval{math.Inf(-1), end, true, false}
+	}
+
+	return stats.Interval{math.Inf(-1), math.Inf(1), true, true}
+}
+
+// δ₀, the location in S0
+func (s *AlphaStable) shift() float64 {
+	switch {
+	case s.param == StableS0:
+		return s.location
+	case s.alpha == 1:
+		return s.location + s.beta*s.scale*2/math.Pi*math.Log(s.scale)
+	}
+
+	return s.location + s.beta*s.scale*math.Tan(math.Pi*s.alpha/2)
+}
+
+func (s *AlphaStable) standard() stableStandard {
+	return stableStandard{s.alpha, s.beta}
+}
+
+func (s *AlphaStable) Probability(x float64) float64 {
+	if !s.Support().IsWithinInterval(x) {
+		return 0
+	}
+
+	return s.standard().pdf((x-s.shift())/s.scale) / s.scale
+}
+
+func (s *AlphaStable) Distribution(x float64) float64 {
+	if sup := s.Support(); x <= sup.Lower {
+		return 0
+	} else if x >= sup.Upper {
+		return 1
+	}
+
+	p, _ := s.standard().cdf((x - s.shift()) / s.scale)
+	return p
+}
+
+func (s *AlphaStable) Inverse(p float64) float64 {
+	if p <= 0 {
+		return s.Support().Lower
+	}
+
+	if p >= 1 {
+		return s.Support().Upper
+	}
+
+	return s.shift() + s.scale*s.standard().inverse(p)
+}
+
+func (s *AlphaStable) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return s.Support().Lower
+	}
+
+	if q <= 0 {
+		return s.Support().Upper
+	}
+
+	return s.shift() + s.scale*s.standard().inverseSurvival(q)
+}
+
+// δ₁ for α > 1, and ±∞ for a law skewed totally to one side
+func (s *AlphaStable) Mean() float64 {
+	switch {
+	case s.alpha > 1:
+		return s.shift() - s.beta*s.scale*math.Tan(math.Pi*s.alpha/2)
+	case math.Abs(s.beta) == 1:
+		return math.Inf(int(s.beta))
+	}
+
+	return math.NaN()
+}
+
+func (s *AlphaStable) Median() float64 {
+	return s.Inverse(.5)
+}
+
+func (s *AlphaStable) Variance() float64 {
+	if s.alpha == 2 {
+		return 2 * s.scale * s.scale
+	}
+
+	return math.Inf(1)
+}
+
+func (s *AlphaStable) Skewness() float64 {
+	if s.alpha == 2 {
+		return 0
+	}
+
+	return math.NaN()
+}
+
+func (s *AlphaStable) ExKurtosis() float64 {
+	if s.alpha == 2 {
+		return 0
+	}
+
+	return math.NaN()
+}
+
+// exp(-γ^α|t|^α(1 - iβ tan(πα/2) sign t) + iδ₁t), or exp(-γ|t|(1 + iβ(2/π) sign t log|t|) + iδ₁t) at α = 1
+func (s *AlphaStable) CharacteristicFunction(t float64) complex128 {
+	if t == 0 {
+		return 1
+	}
+
+	at := math.Abs(t)
+	sign := math.Copysign(1, t)
+	if s.alpha == 1 {
+		δ1 := s.shift() - s.beta*s.scale*2/math.Pi*math.Log(s.scale)
+		return cmplx.Exp(complex(-s.scale*at, δ1*t-s.scale*at*s.beta*2/math.Pi*sign*math.Log(at)))
+	}
+
+	tan := math.Tan(math.Pi * s.alpha / 2)
+	δ1 := s.shift() - s.beta*s.scale*tan
+	a := math.Pow(s.scale*at, s.alpha)
+	return cmplx.Exp(complex(-a, δ1*t+a*s.beta*tan*sign))
+}
+
+func (s *AlphaStable) Rand() float64 {
+	var rnd func() float64
+	if s.src == nil {
+		rnd = rand.Float64
+	} else {
+		rnd = rand.New(s.src).Float64
+	}
+
+	u, w := math.Pi*(rnd()-.5), -math.Log(1-rnd())
+	for u == -math.Pi/2 || w == 0 {
+		u, w = math.Pi*(rnd()-.5), -math.Log(1-rnd())
+	}
+
+	return s.shift() + s.scale*s.standard().chambersMallowsStuck(u, w)
+}
+
+// The standard stable law of S0, γ = 1 and δ = 0.
+//
+// Away from the closed forms, x is reflected if need be to x > ζ, for ζ = -β tan(πα/2), or to β > 0 at
+// α = 1, where the density and distribution function are integrals over θ ∈ (-θ₀, π/2), or (-π/2, π/2) at
+// α = 1, of functions of h(θ) = log((x - ζ)^(α/(α-1)) V(θ)), or -πx/2β + log V(θ) at α = 1. h is monotone,
+// rising for α < 1 and falling for α > 1, and the integrands peak or step where it crosses 0.
+type stableStandard struct {
+	α, β float64
+}
+
+// ζ and θ₀ = arctan(β tan(πα/2))/α, for α ≠ 1
+func (s stableStandard) zeta() (ζ, θ0 float64) {
+	tan := math.Tan(math.Pi * s.α / 2)
+	return -s.β * tan, math.Atan(s.β*tan) / s.α
+}
+
+// h on the lower and upper halves of the range of θ, as functions of the distance d ∈ (0, m] from their
+// ends, for x > ζ, or β > 0 at α = 1. Written in d, the terms that vanish or blow up at an end keep their
+// accuracy there, where the mass of the integrands goes in the tails.
+func (s stableStandard) kernel(x float64) (lower, upper func(float64) float64, m float64) {
+	α, β := s.α, s.β
+	if α == 1 {
+		c := -math.Pi*x/(2*β) + math.Log(2/math.Pi)
+		h := func(v, tan, cos float64) float64 {
+			return c + math.Log(v) - math.Log(cos) + v*tan/β
+		}
+
+		// θ = d - π/2 and θ = π/2 - d
+		return func(d float64) float64 {
+				sin, cos := math.Sincos(d)
+				return h((1-β)*math.Pi/2+β*d, -cos/sin, sin)
+			}, func(d float64) float64 {
+				sin, cos := math.Sincos(d)
+				return h((1+β)*math.Pi/2-β*d, cos/sin, sin)
+			}, math.Pi / 2
+	}
+
+	ζ, θ0 := s.zeta()
+	a := α / (α - 1)
+	c := a*math.Log(x-ζ) + math.Log(math.Cos(α*θ0))/(α-1)
+	h := func(cos, sin, cos2 float64) float64 {
+		return c + (a-1)*math.Log(cos) - a*math.Log(sin) + math.Log(cos2)
+	}
+
+	// θ = d - θ₀ and θ = π/2 - d, of cos θ, sin(α(θ₀ + θ)) and cos(αθ₀ + (α - 1)θ), which at θ = -θ₀ vanish
+	// together as θ₀ nears π/2, and the last two at θ = π/2 as αl nears π, for l = π/2 + θ₀
+	l, p := math.Pi/2+θ0, math.Pi/2-θ0
+	r := math.Pi - α*math.Pi/2 - math.Atan(β*math.Tan(math.Pi*α/2)) // π - αl
+	sin := func(t float64) float64 {                                // sin(αl - t)
+		if r < math.Pi/2 {
+			return math.Sin(r + t)
+		}
+
+		return math.Sin(α*l - t)
+	}
+
+	return func(d float64) float64 {
+			return h(math.Sin(p+d), math.Sin(α*d), math.Sin(p+(1-α)*d))
+		}, func(d float64) float64 {
+			return h(math.Sin(d), sin(α*d), sin((α-1)*d))
+		}, l / 2
+}
+
+// Whether x is reflected to -x, and the law to -β, for its kernel.
+func (s stableStandard) reflects(x float64) bool {
+	if s.α == 1 {
+		return s.β < 0
+	}
+
+	ζ, _ := s.zeta()
+	return x < ζ
+}
+
+func (s stableStandard) pdf(x float64) float64 {
+	if s.α != 1 && math.Abs(s.α-1) < stableNearOne {
+		lo, mid, hi := s.nearOne()
+		return math.Max(0, stableInterpolate(s.α, lo.integralPDF(x), mid.integralPDF(x), hi.integralPDF(x)))
+	}
+
+	return s.integralPDF(x)
+}
+
+func (s stableStandard) integralPDF(x float64) float64 {
+	α, β := s.α, s.β
+	switch {
+	case α == 2:
+		return math.Exp(-x*x/4) / (2 * math.SqrtPi)
+	case α == 1 && β == 0:
+		return 1 / (math.Pi * (1 + x*x))
+	case s.reflects(x):
+		return stableStandard{α, -β}.integralPDF(-x)
+	}
+
+	if α == 1 {
+		lower, upper, m := s.kernel(x)
+		return stableIntegral(lower, upper, m, stableDensityIntegrand) / (2 * β)
+	}
+
+	ζ, θ0 := s.zeta()
+	if x == ζ {
+		return math.Exp(specfunc.Lngamma(1+1/α)) * math.Cos(θ0) / (math.Pi * math.Pow(1+ζ*ζ, 1/(2*α)))
+	}
+
+	lower, upper, m := s.kernel(x)
+	return α * stableIntegral(lower, upper, m, stableDensityIntegrand) / (math.Pi * math.Abs(α-1) * (x - ζ))
+}
+
+// The distribution function and its complement, each to a relative accuracy in its own tail.
+func (s stableStandard) cdf(x float64) (p, q float64) {
+	if s.α != 1 && math.Abs(s.α-1) < stableNearOne {
+		lo, mid, hi := s.nearOne()
+		lp, lq := lo.integralCDF(x)
+		mp, mq := mid.integralCDF(x)
+		hp, hq := hi.integralCDF(x)
+		p, q = stableInterpolate(s.α, lp, mp, hp), stableInterpolate(s.α, lq, mq, hq)
+		return math.Max(0, math.Min(p, 1)), math.Max(0, math.Min(q, 1))
+	}
+
+	return s.integralCDF(x)
+}
+
+func (s stableStandard) integralCDF(x float64) (p, q float64) {
+	α, β := s.α, s.β
+	switch {
+	case α == 2:
+		return math.Erfc(-x/2) / 2, math.Erfc(x/2) / 2
+	case α == 1 && β == 0:
+		return math.Atan2(1, -x) / math.Pi, math.Atan2(1, x) / math.Pi
+	case s.reflects(x):
+		q, p = stableStandard{α, -β}.integralCDF(-x)
+		return p, q
+	}
+
+	var c float64 // the distribution function at ζ, or at -∞ for α = 1
+	if α != 1 {
+		ζ, θ0 := s.zeta()
+		c = (math.Pi/2 - θ0) / math.Pi
+		if x == ζ {
+			return c, (math.Pi/2 + θ0) / math.Pi
+		}
+	}
+
+	lower, upper, m := s.kernel(x)
+	i := stableIntegral(lower, upper, m, stableDistributionIntegrand) / math.Pi
+	if α > 1 {
+		return 1 - i, i
+	}
+
+	p = c + i
+	if p < .5 {
+		return p, 1 - p
+	}
+
+	// 1 - c = (θ₀ + π/2)/π, the length of the range over π
+	q = stableIntegral(lower, upper, m, stableSurvivalIntegrand) / math.Pi
+	return 1 - q, q
+}
+
+// Within stableNearOne of α = 1, but not at it, the integrals cancel to an error of about 3e-18/(α - 1)²,
+// and the law, smooth in α in S0, is interpolated from α = 1 and α = 1 ± stableNearOne instead.
+const stableNearOne = 1e-3
+
+func (s stableStandard) nearOne() (lo, mid, hi stableStandard) {
+	return stableStandard{1 - stableNearOne, s.β}, stableStandard{1, s.β}, stableStandard{1 + stableNearOne, s.β}
+}
+
+// The quadratic through f(1 - stableNearOne) = lo, f(1) = mid and f(1 + stableNearOne) = hi at α.
+func stableInterpolate(α, lo, mid, hi float64) float64 {
+	t := (α - 1) / stableNearOne
+	return lo*t*(t-1)/2 + mid*(1-t*t) + hi*t*(t+1)/2
+}
+
+// The logarithms of the integrands, of which the first two are -∞ past h = stableNegligible, where
+// exp(-e^h) underflows.
+func stableDensityIntegrand(h float64) float64 {
+	if h > stableNegligible {
+		return math.Inf(-1)
+	}
+
+	return h - math.Exp(h)
+}
+
+func stableDistributionIntegrand(h float64) float64 {
+	if h > stableNegligible {
+		return math.Inf(-1)
+	}
+
+	return -math.Exp(h)
+}
+
+func stableSurvivalIntegrand(h float64) float64 {
+	if h < -30 {
+		return h - math.Exp(h)/2
+	}
+
+	return math.Log(-math.Expm1(-math.Exp(h)))
+}
+
+const stableNegligible = 20
+
+// The lower and upper ends of the support.
+func (s stableStandard) support() (float64, float64) {
+	if s.α < 1 && math.Abs(s.β) == 1 {
+		if ζ, _ := s.zeta(); s.β > 0 {
+			return ζ, math.Inf(1)
+		} else {
+			return math.Inf(-1), ζ
+		}
+	}
+
+	return math.Inf(-1), math.Inf(1)
+}
+
+func (s stableStandard) inverse(p float64) float64 {
+	switch {
+	case s.α == 2:
+		return -2 * math.Erfcinv(2*p)
+	case s.α == 1 && s.β == 0:
+		return math.Tan(math.Pi * (p - .5))
+	case p > .5:
+		return s.inverseSurvival(1 - p)
+	}
+
+	lo, hi := s.support()
+	return inverse(nil, func(x float64) float64 { p, _ := s.cdf(x); return p }, s.pdf, lo, hi, math.NaN(), p)
+}
+
+func (s stableStandard) inverseSurvival(q float64) float64 {
+	switch {
+	case s.α == 2:
+		return 2 * math.Erfcinv(2*q)
+	case s.α == 1 && s.β == 0:
+		return 1 / math.Tan(math.Pi*q)
+	case q > .5:
+		return s.inverse(1 - q)
+	}
+
+	lo, hi := s.support()
+	return inverseSurvival(nil, func(x float64) float64 { _, q := s.cdf(x); return q }, s.pdf, lo, hi, math.NaN(), q)
+}
+
+// The variate of S1 from u uniform on (-π/2, π/2) and w standard exponential, shifted to S0.
+func (s stableStandard) chambersMallowsStuck(u, w float64) float64 {
+	α, β := s.α, s.β
+	if α == 1 {
+		v := math.Pi/2 + β*u
+		return 2 / math.Pi * (v*math.Tan(u) - β*math.Log(math.Pi/2*w*math.Cos(u)/v))
+	}
+
+	tan := math.Tan(math.Pi * α / 2)
+	b := math.Atan(β*tan) / α
+	c := math.Pow(1+β*β*tan*tan, 1/(2*α))
+	x := c * math.Sin(α*(u+b)) / math.Pow(math.Cos(u), 1/α) * math.Pow(math.Cos(u-α*(u+b))/w, (1-α)/α)
+	return x - β*tan
+}
+
+// The integral of exp(g(h)) over the range of θ of the kernel, each half of it in s = log d over the
+// stableDepth below log m, where exp(g(h(e^s)) + s) is unimodal. Its peak is found by golden section on
+// the part where it is not 0, and the quadrature is split at it and kept to where the integrand is within
+// e^-stableWindow of it.
+func stableIntegral(lower, upper func(float64) float64, m float64, g func(float64) float64) float64 {
+	if !(m > 0) {
+		return 0
+	}
+
+	return stableHalf(lower, m, g) + stableHalf(upper, m, g)
+}
+
+const (
+	stableDepth  = 700
+	stableWindow = 60
+)
+
+func stableHalf(h func(float64) float64, m float64, g func(float64) float64) float64 {
+	f := func(s float64) float64 {
+		if v := g(h(math.Exp(s))) + s; !math.IsNaN(v) {
+			return v
+		}
+
+		return math.Inf(-1)
+	}
+
+	// h is monotone, so f is -∞ on one side of a point at most
+	lo, hi := math.Log(m)-stableDepth, math.Log(m)
+	flo, fhi := f(lo), f(hi)
+	if math.IsInf(flo, -1) && math.IsInf(fhi, -1) {
+		return 0
+	}
+
+	if math.IsInf(flo, -1) || math.IsInf(fhi, -1) {
+		a, b := lo, hi
+		for {
+			m := a/2 + b/2
+			if m <= a || m >= b {
+				break
+			}
+
+			if math.IsInf(f(m), -1) == math.IsInf(flo, -1) {
+				a = m
+			} else {
+				b = m
+			}
+		}
+
+		if math.IsInf(flo, -1) {
+			lo = b
+		} else {
+			hi = a
+		}
+	}
+
+	const φ = 0.618033988749894848204586834365638
+	a, b := lo, hi
+	x1, x2 := b-φ*(b-a), a+φ*(b-a)
+	f1, f2 := f(x1), f(x2)
+	for b-a > 1e-7 {
+		if f1 < f2 {
+			a, x1, f1 = x1, x2, f2
+			x2 = a + φ*(b-a)
+			f2 = f(x2)
+		} else {
+			b, x2, f2 = x2, x1, f1
+			x1 = b - φ*(b-a)
+			f1 = f(x1)
+		}
+	}
+
+	peak, top := a/2+b/2, f(a/2+b/2)
+	for _, s := range [...]float64{a, b} {
+		if v := f(s); v > top {
+			peak, top = s, v
+		}
+	}
+
+	// below the least float64 whatever the window
+	if top < -800 {
+		return 0
+	}
+
+	// the ends of the window, f rising to the peak and falling after it
+	window := func(a, b float64, right bool) float64 {
+		end := a
+		if right {
+			end = b
+		}
+
+		if f(end) > top-stableWindow {
+			return end
+		}
+
+		for {
+			m := a/2 + b/2
+			if m <= a || m >= b || b-a < 1e-9 {
+				break
+			}
+
+			if (f(m) > top-stableWindow) == right {
+				a = m
+			} else {
+				b = m
+			}
+		}
+
+		return a/2 + b/2
+	}
+
+	left, right := window(lo, peak, false), window(peak, hi, true)
+	e := func(s float64) float64 { return math.Exp(f(s) - top) }
+	return math.Exp(top) * (quadrature(e, left, peak) + quadrature(e, peak, right))
+}
+
+// Least α FitAlphaStable matches, and its tolerance on α and β.
+const (
+	stableFitMinAlpha  = .5
+	stableFitTolerance = 1e-6
+)
+
+// FitAlphaStable estimates a stable law from the 5%, 25%, 50%, 75% and 95% sample quantiles of x, of at
+// least 5 finite observations, by the method of McCulloch: α and β from the spreads
+// ν_α = (x₉₅ - x₀₅)/(x₇₅ - x₂₅) and ν_β = (x₉₅ + x₀₅ - 2x₅₀)/(x₉₅ - x₀₅), which do not depend on γ and δ,
+// then γ from x₇₅ - x₂₅ and δ₀ from x₅₀. The spreads of the law are computed from its quantiles where
+// McCulloch interpolated tables of them, and are matched within α ∈ [0.5, 2], the estimate being clamped
+// to its ends. The sample quantiles are those of sample.Hazen, as McCulloch's.
+//
+// J. H. McCulloch, "Simple consistent estimators of stable distribution parameters," Communications in
+// Statistics - Simulation and Computation, vol. 15, no. 4, pp. 1109-1136, 1986.
+func FitAlphaStable(x []float64, param StableParameterization) (*AlphaStable, error) {
+	return FitAlphaStableWithSource(x, param, nil)
+}
+
+func FitAlphaStableWithSource(x []float64, param StableParameterization, src rand.Source) (*AlphaStable, error) {
+	if param != StableS0 && param != StableS1 {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("FitAlphaStable: unknown parameterization %v", param))
+	}
+
+	if len(x) < 5 {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("FitAlphaStable: %v observations are fewer than 5", len(x)))
+	}
+
+	for _, v := range x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("FitAlphaStable: observation %v is not finite", v))
+		}
+	}
+
+	q := sample.Quantiles([]float64{.05, .25, .5, .75, .95}, sample.Hazen, x, nil)
+	if !(q[3] > q[1]) {
+		return nil, err.New(err.EINVAL, "FitAlphaStable: no estimate for a sample of interquartile range 0")
+	}
+
+	να, νβ := (q[4]-q[0])/(q[3]-q[1]), (q[4]+q[0]-2*q[2])/(q[4]-q[0])
+
+	// ν_α hardly depends on β, nor ν_β much on α, so matching each in turn converges in a few rounds
+	var α, β float64
+	for i, prev := 0, math.NaN(); i < 50; i++ {
+		β0 := β
+		α = stableMatch(func(α float64) float64 { return -stableStandard{α, β0}.spreads(true) }, stableFitMinAlpha, 2, -να)
+		if α == 2 {
+			β = 0
+			break
+		}
+
+		α0 := α
+		β = stableMatch(func(β float64) float64 { return stableStandard{α0, β}.spreads(false) }, -1, 1, νβ)
+		if math.Abs(α-prev) <= stableFitTolerance && math.Abs(β-β0) <= stableFitTolerance {
+			break
+		}
+
+		prev = α
+	}
+
+	z := stableStandard{α, β}
+	γ := (q[3] - q[1]) / (z.inverse(.75) - z.inverse(.25))
+	δ := q[2] - γ*z.inverse(.5)
+	if param == StableS1 {
+		if α == 1 {
+			δ -= β * γ * 2 / math.Pi * math.Log(γ)
+		} else {
+			δ -= β * γ * math.Tan(math.Pi*α/2)
+		}
+	}
+
+	return NewAlphaStableWithSource(α, β, γ, δ, param, src)
+}
+
+// ν_α of the law if alpha, and ν_β otherwise.
+func (s stableStandard) spreads(alpha bool) float64 {
+	x05, x95 := s.inverse(.05), s.inverse(.95)
+	if alpha {
+		return (x95 - x05) / (s.inverse(.75) - s.inverse(.25))
+	}
+
+	return (x95 + x05 - 2*s.inverse(.5)) / (x95 - x05)
+}
+
+// The root in [lo, hi] of f(t) = y, for f increasing, by the Illinois variant of regula falsi, or the end
+// of [lo, hi] nearer y if f does not reach it.
+func stableMatch(f func(float64) float64, lo, hi, y float64) float64 {
+	a, b := lo, hi
+	fa, fb := f(a)-y, f(b)-y
+	if fa >= 0 {
+		return a
+	}
+
+	if fb <= 0 {
+		return b
+	}
+
+	side := 0
+	for i := 0; i < 100 && b-a > stableFitTolerance; i++ {
+		t := (a*fb - b*fa) / (fb - fa)
+		if !(t > a && t < b) {
+			t = a/2 + b/2
+		}
+
+		ft := f(t) - y
+		switch {
+		case ft == 0:
+			return t
+		case ft < 0:
+			a, fa = t, ft
+			if side == -1 {
+				fb /= 2
+			}
+			side = -1
+		default:
+			b, fb = t, ft
+			if side == 1 {
+				fa /= 2
+			}
+			side = 1
+		}
+	}
+
+	return a/2 + b/2
+}
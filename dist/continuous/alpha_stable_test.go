@@ -0,0 +1,270 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/ggsl/specfunc"
+	stattest "github.com/jtejido/stats/testing"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// α = 1/2 and β = 1 is the Levy, of location δ₁ in S1 and δ₁ + γ in S0
+func TestAlphaStableLevy(t *testing.T) {
+	tol := 1e-12
+	μ, c := 1., 2.
+	levy := &Levy{location: μ, scale: c}
+	for _, s := range []*AlphaStable{
+		{alpha: .5, beta: 1, scale: c, location: μ, param: StableS1},
+		{alpha: .5, beta: 1, scale: c, location: μ + c, param: StableS0},
+	} {
+		for _, x := range []float64{1.01, 1.1, 1.5, 2, 3, 5, 10, 100, 1e4, 1e8} {
+			desc := fmt.Sprintf("%v.%%s(%v)", s, x)
+			run_test(t, s.Probability(x), levy.Probability(x), tol, fmt.Sprintf(desc, "Probability"))
+			run_test(t, s.Distribution(x), levy.Distribution(x), tol, fmt.Sprintf(desc, "Distribution"))
+		}
+
+		for _, p := range []float64{1e-6, .1, .5, .9, .999} {
+			run_test(t, s.Inverse(p), levy.Inverse(p), 1e-10, fmt.Sprintf("%v.Inverse(%v)", s, p))
+		}
+
+		if x := s.Support().Lower; x != μ {
+			t.Errorf("Mismatch. %v.Support, want: %v, got: %v", s, μ, x)
+		}
+
+		if p, d := s.Probability(.5), s.Distribution(.5); p != 0 || d != 0 {
+			t.Errorf("Mismatch. %v below its support, want: 0, 0, got: %v, %v", s, p, d)
+		}
+	}
+
+	// and β = -1 its mirror image
+	s := &AlphaStable{alpha: .5, beta: -1, scale: c, location: -μ, param: StableS1}
+	for _, x := range []float64{1.1, 2, 10, 1e4} {
+		run_test(t, s.Probability(-x), levy.Probability(x), tol, fmt.Sprintf("%v.Probability(%v)", s, -x))
+		run_test(t, s.Distribution(-x), 1-levy.Distribution(x), tol, fmt.Sprintf("%v.Distribution(%v)", s, -x))
+	}
+}
+
+// α = 1 and β = 0 is the Cauchy and α = 2 the Normal of variance 2γ², both of which the law nears
+func TestAlphaStableCauchyNormal(t *testing.T) {
+	tol := 1e-12
+	for _, c := range []struct {
+		s    *AlphaStable
+		want interface {
+			Probability(float64) float64
+			Distribution(float64) float64
+			Inverse(float64) float64
+			CharacteristicFunction(float64) complex128
+		}
+	}{
+		{&AlphaStable{alpha: 1, scale: 2, location: 1}, &Cauchy{location: 1, scale: 2}},
+		{&AlphaStable{alpha: 1, scale: 2, location: 1, param: StableS1}, &Cauchy{location: 1, scale: 2}},
+		{&AlphaStable{alpha: 2, beta: .7, scale: 2, location: 1}, &Normal{1, 2 * math.Sqrt2, nil, nil}},
+	} {
+		for _, x := range []float64{-30, -2, 0, 1, 2.5, 8} {
+			desc := fmt.Sprintf("%v.%%s(%v)", c.s, x)
+			run_test(t, c.s.Probability(x), c.want.Probability(x), tol, fmt.Sprintf(desc, "Probability"))
+			run_test(t, c.s.Distribution(x), c.want.Distribution(x), tol, fmt.Sprintf(desc, "Distribution"))
+			if got, want := c.s.CharacteristicFunction(x), c.want.CharacteristicFunction(x); cmplx.Abs(got-want) > tol {
+				t.Errorf("Mismatch. %s, want: %v, got: %v", fmt.Sprintf(desc, "CharacteristicFunction"), want, got)
+			}
+		}
+
+		for _, p := range []float64{1e-6, .1, .5, .9, .999} {
+			run_test(t, c.s.Inverse(p), c.want.Inverse(p), 1e-10, fmt.Sprintf("%v.Inverse(%v)", c.s, p))
+		}
+	}
+
+	// by the integrals, off the closed forms
+	normal := &Normal{0, math.Sqrt2, nil, nil}
+	z := stableStandard{2 - 1e-9, 0}
+	for _, x := range []float64{-3, 0, 1, 2.5} {
+		p, _ := z.cdf(x)
+		run_test(t, z.pdf(x), normal.Probability(x), 1e-7, fmt.Sprintf("stable(2 - 1e-9, 0).pdf(%v)", x))
+		run_test(t, p, normal.Distribution(x), 1e-7, fmt.Sprintf("stable(2 - 1e-9, 0).cdf(%v)", x))
+	}
+}
+
+// The law is smooth in α in S0, through α = 1 where the integrals give way to interpolation
+func TestAlphaStableNearOne(t *testing.T) {
+	for _, β := range []float64{0, .5, -1} {
+		for _, x := range []float64{-2, .7, 3} {
+			var pdf, cdf [7]float64
+			for i := range pdf {
+				z := stableStandard{1 + float64(i-3)*stableNearOne, β}
+				pdf[i] = z.pdf(x)
+				cdf[i], _ = z.cdf(x)
+			}
+
+			// third differences, of order (1e-3)³
+			for i := 0; i+3 < len(pdf); i++ {
+				if d := pdf[i+3] - 3*pdf[i+2] + 3*pdf[i+1] - pdf[i]; math.Abs(d) > 1e-8 {
+					t.Errorf("Mismatch. third difference of the pdf at %v, β = %v, α = %v, want: 0, got: %v", x, β, 1+float64(i-3)*stableNearOne, d)
+				}
+
+				if d := cdf[i+3] - 3*cdf[i+2] + 3*cdf[i+1] - cdf[i]; math.Abs(d) > 1e-8 {
+					t.Errorf("Mismatch. third difference of the cdf at %v, β = %v, α = %v, want: 0, got: %v", x, β, 1+float64(i-3)*stableNearOne, d)
+				}
+			}
+		}
+	}
+}
+
+// The density integrates to the distribution function, which is continuous at ζ
+func TestAlphaStableIntegrals(t *testing.T) {
+	for _, c := range [][2]float64{{.3, -.8}, {.7, .5}, {1, .5}, {1.2, 1}, {1.5, .5}, {1.95, -.9}} {
+		z := stableStandard{c[0], c[1]}
+		a, b := -3., 2.
+		pa, _ := z.cdf(a)
+		pb, _ := z.cdf(b)
+		run_test(t, quadrature(z.pdf, a, b), pb-pa, 1e-10, fmt.Sprintf("∫ stable(%v, %v).pdf over [%v, %v]", c[0], c[1], a, b))
+
+		if c[0] == 1 {
+			continue
+		}
+
+		ζ, _ := z.zeta()
+		for _, x := range []float64{ζ - 1e-9, ζ + 1e-9} {
+			p, q := z.cdf(x)
+			p0, q0 := z.cdf(ζ)
+			run_test(t, z.pdf(x), z.pdf(ζ), 1e-7, fmt.Sprintf("stable(%v, %v).pdf(%v) at ζ", c[0], c[1], x))
+			run_test(t, p, p0, 1e-7, fmt.Sprintf("stable(%v, %v).cdf(%v) at ζ", c[0], c[1], x))
+			run_test(t, q, q0, 1e-7, fmt.Sprintf("stable(%v, %v).cdf(%v) survival at ζ", c[0], c[1], x))
+		}
+	}
+}
+
+// In the tails the density and survival function near γ^α C (1 + β) x^-α, for C = sin(πα/2)Γ(α)/π
+func TestAlphaStableTails(t *testing.T) {
+	for _, c := range [][2]float64{{.7, .5}, {1.2, -.3}, {1.5, .5}, {1.9, 0}} {
+		α, β := c[0], c[1]
+		s := &AlphaStable{alpha: α, beta: β, scale: 2, location: 1}
+		C := math.Sin(math.Pi*α/2) * math.Exp(specfunc.Lngamma(α)) / math.Pi * math.Pow(2, α)
+		for _, x := range []float64{1e10, -1e10} {
+			k := C * (1 + β*math.Copysign(1, x))
+			p, q := s.standard().cdf((x - s.shift()) / s.scale)
+			if x < 0 {
+				q = p
+			}
+
+			run_test(t, s.Probability(x), α*k*math.Pow(math.Abs(x), -α-1), 1e-5, fmt.Sprintf("%v.Probability(%v)", s, x))
+			run_test(t, q, k*math.Pow(math.Abs(x), -α), 1e-5, fmt.Sprintf("%v tail at %v", s, x))
+		}
+	}
+}
+
+// S0 and S1 are the same law at locations δ₀ = δ₁ + βγ tan(πα/2), or δ₁ + βγ(2/π)log γ at α = 1
+func TestAlphaStableParameterization(t *testing.T) {
+	tol := 1e-13
+	for _, c := range [][2]float64{{.8, -.4}, {1, .6}, {1.3, .6}} {
+		α, β, γ, δ := c[0], c[1], 2., 1.
+		δ0 := δ + β*γ*math.Tan(math.Pi*α/2)
+		if α == 1 {
+			δ0 = δ + β*γ*2/math.Pi*math.Log(γ)
+		}
+
+		s0 := &AlphaStable{alpha: α, beta: β, scale: γ, location: δ0, param: StableS0}
+		s1 := &AlphaStable{alpha: α, beta: β, scale: γ, location: δ, param: StableS1}
+		for _, x := range []float64{-4, 0, 1, 3} {
+			desc := fmt.Sprintf("%v.%%s(%v)", s1, x)
+			run_test(t, s1.Probability(x), s0.Probability(x), tol, fmt.Sprintf(desc, "Probability"))
+			run_test(t, s1.Distribution(x), s0.Distribution(x), tol, fmt.Sprintf(desc, "Distribution"))
+			if got, want := s1.CharacteristicFunction(x), s0.CharacteristicFunction(x); cmplx.Abs(got-want) > tol {
+				t.Errorf("Mismatch. %s, want: %v, got: %v", fmt.Sprintf(desc, "CharacteristicFunction"), want, got)
+			}
+		}
+
+		if α > 1 {
+			run_test(t, s1.Mean(), δ, tol, fmt.Sprintf("%v.Mean", s1))
+			run_test(t, s0.Mean(), δ, tol, fmt.Sprintf("%v.Mean", s0))
+		} else if m := s1.Mean(); !math.IsNaN(m) {
+			t.Errorf("Mismatch. %v.Mean, want: NaN, got: %v", s1, m)
+		}
+
+		if v := s1.Variance(); !math.IsInf(v, 1) {
+			t.Errorf("Mismatch. %v.Variance, want: +Inf, got: %v", s1, v)
+		}
+	}
+}
+
+// Draws of Chambers, Mallows and Stuck follow the law, in both parameterizations
+func TestAlphaStableRand(t *testing.T) {
+	for _, s := range []*AlphaStable{
+		{alpha: .8, beta: .5, scale: 2, location: 1, param: StableS0},
+		{alpha: 1, beta: -.9, scale: 2, location: 1, param: StableS1},
+		{alpha: 1.3, beta: 1, scale: 2, location: 1, param: StableS1},
+	} {
+		s.src = rand.NewSource(1)
+		t.Run(s.String(), func(t *testing.T) {
+			stattest.KolmogorovSmirnov(t, s, stattest.Config{Samples: 2000})
+		})
+	}
+}
+
+// McCulloch's estimates recover the law of a simulated sample
+func TestFitAlphaStable(t *testing.T) {
+	for _, c := range []struct {
+		α, β  float64
+		param StableParameterization
+	}{
+		{1.6, .4, StableS1},
+		{1.1, -.5, StableS0},
+		{.8, .2, StableS0},
+	} {
+		s := &AlphaStable{alpha: c.α, beta: c.β, scale: 2, location: 1, param: c.param}
+		s.src = rand.NewSource(1)
+		x := make([]float64, 20000)
+		for i := range x {
+			x[i] = s.Rand()
+		}
+
+		f, e := FitAlphaStable(x, c.param)
+		if e != nil {
+			t.Fatalf("FitAlphaStable: %v", e)
+		}
+
+		if f.Parameterization() != c.param {
+			t.Errorf("Mismatch. FitAlphaStable(%v).Parameterization, want: %v, got: %v", c.param, c.param, f.Parameterization())
+		}
+
+		for i, v := range []struct{ got, want, tol float64 }{
+			{f.alpha, c.α, .05}, {f.beta, c.β, .15}, {f.scale, 2, .1}, {f.location, 1, .1},
+		} {
+			if math.Abs(v.got-v.want) > v.tol {
+				t.Errorf("Mismatch. FitAlphaStable of %v parameter %d, want: %v, got: %v", s, i, v.want, v.got)
+			}
+		}
+	}
+
+	for _, c := range []struct {
+		x     []float64
+		param StableParameterization
+	}{
+		{[]float64{1, 2, 3, 4, 5}, StableParameterization(2)},
+		{[]float64{1, 2, 3, 4}, StableS0},
+		{[]float64{1, 2, 3, 4, math.NaN()}, StableS0},
+		{[]float64{1, 2, 2, 2, 2, 2, 3}, StableS0},
+	} {
+		if _, e := FitAlphaStable(c.x, c.param); e == nil {
+			t.Errorf("FitAlphaStable(%v, %v), want an error", c.x, c.param)
+		}
+	}
+}
+
+func TestAlphaStableErrors(t *testing.T) {
+	for _, c := range []struct {
+		α, β, γ float64
+		param   StableParameterization
+	}{
+		{0, 0, 1, StableS0}, {2.1, 0, 1, StableS0}, {1.5, 1.1, 1, StableS0}, {1.5, 0, 0, StableS0}, {1.5, 0, 1, -1},
+	} {
+		if _, e := NewAlphaStable(c.α, c.β, c.γ, 0, c.param); e == nil {
+			t.Errorf("NewAlphaStable(%v, %v, %v, 0, %v), want an error", c.α, c.β, c.γ, c.param)
+		}
+	}
+
+	if s := StableParameterization(3).String(); s != "StableParameterization(3)" {
+		t.Errorf("Mismatch. StableParameterization(3).String, want: StableParameterization(3), got: %v", s)
+	}
+}
@@ -19,11 +19,44 @@ func NewArcsine() (*Arcsine, error) {
 func NewArcsineWithSource(src rand.Source) (*Arcsine, error) {
 	r := new(Arcsine)
 	r.src = src
+
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
 	return r, nil
 }
 
+func (as *Arcsine) spec() (string, []Common, []float64) {
+	return "Arcsine", nil, []float64{}
+}
+
 func (as *Arcsine) String() string {
-	return "Arcsine: Support(x) - " + as.Support().String()
+	return specString(as.spec())
+}
+
+func (as *Arcsine) MarshalJSON() ([]byte, error) {
+	return marshalJSON(as)
+}
+
+func (as *Arcsine) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, as, as.src)
+}
+
+func (as *Arcsine) MarshalText() ([]byte, error) {
+	return []byte(as.String()), nil
+}
+
+func (as *Arcsine) UnmarshalText(text []byte) error {
+	return unmarshalText(text, as, as.src)
+}
+
+func (as *Arcsine) ParameterValues() map[string]float64 {
+	return parameterValues(as)
+}
+
+func (as *Arcsine) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(as, params, as.src)
 }
 
 func (as *Arcsine) Parameters() stats.Limits {
@@ -44,6 +77,10 @@ func (as *Arcsine) Probability(x float64) float64 {
 }
 
 func (as *Arcsine) Distribution(x float64) float64 {
+	if x >= as.Support().Upper {
+		return 1
+	}
+
 	if as.Support().IsWithinInterval(x) {
 		return (2. / math.Pi) * math.Asin(math.Sqrt(x))
 	}
@@ -57,12 +94,24 @@ func (as *Arcsine) Inverse(p float64) float64 {
 	}
 
 	if p >= 1 {
-		return math.Inf(1)
+		return 1
 	}
 
 	return math.Pow(math.Sin((math.Pi*p)/2), 2)
 }
 
+func (as *Arcsine) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return 1
+	}
+
+	return math.Pow(math.Cos((math.Pi*q)/2), 2)
+}
+
 func (as *Arcsine) ExKurtosis() float64 {
 	return -(3. / 2.)
 }
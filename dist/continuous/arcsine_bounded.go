@@ -2,7 +2,6 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -19,20 +18,48 @@ func NewArcsineBounded(min, max float64) (*ArcsineBounded, error) {
 }
 
 func NewArcsineBoundedWithSource(min, max float64, src rand.Source) (*ArcsineBounded, error) {
-	if max <= min {
-		return nil, err.Invalid()
-	}
-
 	r := new(ArcsineBounded)
 	r.min = min
 	r.max = max
 	r.src = src
 
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
 	return r, nil
 }
 
+func (asb *ArcsineBounded) spec() (string, []Common, []float64) {
+	return "ArcsineBounded", nil, []float64{asb.min, asb.max}
+}
+
 func (asb *ArcsineBounded) String() string {
-	return "ArcsineBounded: Parameters - " + asb.Parameters().String() + ", Support(x) - " + asb.Support().String()
+	return specString(asb.spec())
+}
+
+func (asb *ArcsineBounded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(asb)
+}
+
+func (asb *ArcsineBounded) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, asb, asb.src)
+}
+
+func (asb *ArcsineBounded) MarshalText() ([]byte, error) {
+	return []byte(asb.String()), nil
+}
+
+func (asb *ArcsineBounded) UnmarshalText(text []byte) error {
+	return unmarshalText(text, asb, asb.src)
+}
+
+func (asb *ArcsineBounded) ParameterValues() map[string]float64 {
+	return parameterValues(asb)
+}
+
+func (asb *ArcsineBounded) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(asb, params, asb.src)
 }
 
 // a  ∈ (-∞,∞)
@@ -58,6 +85,10 @@ func (asb *ArcsineBounded) Probability(x float64) float64 {
 }
 
 func (asb *ArcsineBounded) Distribution(x float64) float64 {
+	if x >= asb.Support().Upper {
+		return 1
+	}
+
 	if asb.Support().IsWithinInterval(x) {
 		return (2. / math.Pi) * math.Asin(math.Sqrt((x-asb.min)/(asb.max-asb.min)))
 	}
@@ -77,6 +108,18 @@ func (asb *ArcsineBounded) Inverse(p float64) float64 {
 	return asb.min + (asb.max-asb.min)*math.Pow(math.Sin((math.Pi*p)/2), 2)
 }
 
+func (asb *ArcsineBounded) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return asb.min
+	}
+
+	if q <= 0 {
+		return asb.max
+	}
+
+	return asb.max - (asb.max-asb.min)*math.Pow(math.Sin((math.Pi*q)/2), 2)
+}
+
 func (asb *ArcsineBounded) Mean() float64 {
 	return (asb.min + asb.max) / 2.
 }
@@ -3,7 +3,6 @@ package continuous
 import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -20,20 +19,49 @@ func NewAssymetricLaplace(m, λ, κ float64) (*AssymetricLaplace, error) {
 }
 
 func NewAssymetricLaplaceWithSource(m, λ, κ float64, src rand.Source) (*AssymetricLaplace, error) {
-	if λ <= 0 || κ <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(AssymetricLaplace)
 	ret.location = m
 	ret.scale = λ
 	ret.assymetry = κ
 	ret.src = src
+
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (al *AssymetricLaplace) spec() (string, []Common, []float64) {
+	return "AssymetricLaplace", nil, []float64{al.location, al.scale, al.assymetry}
+}
+
 func (al *AssymetricLaplace) String() string {
-	return "AssymetricLaplace: Parameters - " + al.Parameters().String() + ", Support(x) - " + al.Support().String()
+	return specString(al.spec())
+}
+
+func (al *AssymetricLaplace) MarshalJSON() ([]byte, error) {
+	return marshalJSON(al)
+}
+
+func (al *AssymetricLaplace) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, al, al.src)
+}
+
+func (al *AssymetricLaplace) MarshalText() ([]byte, error) {
+	return []byte(al.String()), nil
+}
+
+func (al *AssymetricLaplace) UnmarshalText(text []byte) error {
+	return unmarshalText(text, al, al.src)
+}
+
+func (al *AssymetricLaplace) ParameterValues() map[string]float64 {
+	return parameterValues(al)
+}
+
+func (al *AssymetricLaplace) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(al, params, al.src)
 }
 
 func (al *AssymetricLaplace) Parameters() stats.Limits {
@@ -67,8 +95,37 @@ func (al *AssymetricLaplace) Distribution(x float64) float64 {
 }
 
 func (al *AssymetricLaplace) Inverse(p float64) float64 {
-	stats.NotImplementedError()
-	return math.NaN()
+	if p <= 0 {
+		return math.Inf(-1)
+	}
+
+	if p >= 1 {
+		return math.Inf(1)
+	}
+
+	k2 := al.assymetry * al.assymetry
+	if p <= k2/(1+k2) {
+		return al.location + (al.assymetry/al.scale)*math.Log(p*(1+k2)/k2)
+	}
+
+	return al.location - math.Log((1-p)*(1+k2))/(al.scale*al.assymetry)
+}
+
+func (al *AssymetricLaplace) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	k2 := al.assymetry * al.assymetry
+	if q <= 1/(1+k2) {
+		return al.location - math.Log(q*(1+k2))/(al.scale*al.assymetry)
+	}
+
+	return al.location + (al.assymetry/al.scale)*math.Log((1-q)*(1+k2)/k2)
 }
 
 func (al *AssymetricLaplace) ExKurtosis() float64 {
@@ -94,13 +151,13 @@ func (al *AssymetricLaplace) Median() float64 {
 func (al *AssymetricLaplace) Variance() float64 {
 	s2 := al.scale * al.scale
 	k2 := al.assymetry * al.assymetry
-	k4 := k2 * al.assymetry * al.assymetry * al.assymetry * al.assymetry
+	k4 := k2 * k2
 	return (1 + k4) / (s2 * k2)
 }
 
 func (al *AssymetricLaplace) Entropy() float64 {
 	k2 := al.assymetry * al.assymetry
-	return math.Log(math.Exp((1 + k2) / (al.assymetry * al.scale)))
+	return 1 + math.Log((1+k2)/(al.assymetry*al.scale))
 }
 
 func (al *AssymetricLaplace) Rand() float64 {
@@ -111,6 +168,8 @@ func (al *AssymetricLaplace) Rand() float64 {
 		rnd = rand.Float64()
 	}
 
-	s := gsl.Sign(rnd)
-	return al.location - (1/(al.scale*s*math.Pow(al.assymetry, s)))*math.Log(1-rnd*s*math.Pow(al.assymetry, s))
+	// u is uniform on [-κ, 1/κ]
+	u := -al.assymetry + rnd*(al.assymetry+1/al.assymetry)
+	s := gsl.Sign(u)
+	return al.location - (1/(al.scale*s*math.Pow(al.assymetry, s)))*math.Log(1-u*s*math.Pow(al.assymetry, s))
 }
@@ -0,0 +1,81 @@
+package continuous
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSelectBandwidthRulesOfThumb(t *testing.T) {
+	n := float64(len(empiricalData))
+	sd := math.Sqrt(7.6965555555555545)
+
+	// σ is below IQR/1.34 = 4.025/1.34 here
+	cases := []struct {
+		rule BandwidthRule
+		want float64
+	}{
+		{SilvermanBandwidth, .9 * sd * math.Pow(n, -.2)},
+		{ScottBandwidth, 1.06 * sd * math.Pow(n, -.2)},
+	}
+
+	for _, c := range cases {
+		h, e := SelectBandwidth(empiricalData, GaussianKernel, c.rule)
+		if e != nil {
+			t.Fatal(e)
+		}
+		run_test(t, h, c.want, 1e-15, "SelectBandwidth")
+	}
+
+	// with no spread, Silverman's rule falls back on the observation
+	if h, _ := SelectBandwidth([]float64{2, 2, 2}, GaussianKernel, SilvermanBandwidth); h != .9*2*math.Pow(3, -.2) {
+		t.Errorf("Mismatch. constant sample want: %v, got: %v", .9*2*math.Pow(3, -.2), h)
+	}
+
+	if _, e := SelectBandwidth([]float64{2, 2, 2}, GaussianKernel, ScottBandwidth); e == nil {
+		t.Errorf("Mismatch. Scott's rule for a constant sample want: error, got: nil")
+	}
+}
+
+// The plug-in and cross-validated bandwidths are near the optimum for normal samples, and narrower
+// than the rules of thumb, which oversmooth, for bimodal ones.
+func TestSelectBandwidthDataBased(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{300, 2000} {
+		normal := make([]float64, n)
+		bimodal := make([]float64, n)
+		for i := range normal {
+			normal[i] = rnd.NormFloat64()
+			bimodal[i] = rnd.NormFloat64()/2 + 3*float64(i%2)
+		}
+
+		// the AMISE optimum for a standard normal density
+		optimum := math.Pow(4./3, .2) * math.Pow(float64(n), -.2)
+		for _, rule := range []BandwidthRule{SheatherJonesBandwidth, CrossValidatedBandwidth} {
+			// cross-validation converges slowly, its relative error falling as n^(-1/10)
+			h, e := SelectBandwidth(normal, GaussianKernel, rule)
+			if e != nil || math.Abs(h/optimum-1) > .4 {
+				t.Errorf("Mismatch. n = %d, rule %d, normal want: %v, got: %v, %v", n, rule, optimum, h, e)
+			}
+
+			hs, _ := SelectBandwidth(bimodal, GaussianKernel, SilvermanBandwidth)
+			if h, e := SelectBandwidth(bimodal, GaussianKernel, rule); e != nil || h > .75*hs {
+				t.Errorf("Mismatch. n = %d, rule %d, bimodal want: below %v, got: %v, %v", n, rule, .75*hs, h, e)
+			}
+		}
+	}
+
+	// for angles concentrated about 1, far from the cut, the von Mises kernel is all but Gaussian
+	angles := make([]float64, 300)
+	for i := range angles {
+		angles[i] = 1 + .3*rnd.NormFloat64()
+	}
+	want, _ := SelectBandwidth(angles, GaussianKernel, CrossValidatedBandwidth)
+	if h, e := SelectBandwidth(angles, VonMisesKernel, CrossValidatedBandwidth); e != nil || math.Abs(h/want-1) > .02 {
+		t.Errorf("Mismatch. von Mises want: %v, got: %v, %v", want, h, e)
+	}
+
+	if _, e := SelectBandwidth(angles, VonMisesKernel, SheatherJonesBandwidth); e == nil {
+		t.Errorf("Mismatch. Sheather-Jones for angles want: error, got: nil")
+	}
+}
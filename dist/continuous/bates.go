@@ -1,8 +1,6 @@
 package continuous
 
 import (
-	gsl "github.com/jtejido/ggsl"
-	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
 	"math"
 	"math/rand"
@@ -26,21 +24,54 @@ func NewBatesWithSource(a, b float64, n uint, src rand.Source) (*Bates, error) {
 	ret.b = b
 	ret.n = n
 	ret.src = src
+
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (b *Bates) spec() (string, []Common, []float64) {
+	return "Bates", nil, []float64{b.a, b.b, float64(b.n)}
+}
+
 func (b *Bates) String() string {
-	return "Bates: Parameters - " + b.Parameter().String() + ", Support(x) - " + b.Support().String()
+	return specString(b.spec())
+}
+
+func (b *Bates) MarshalJSON() ([]byte, error) {
+	return marshalJSON(b)
+}
+
+func (b *Bates) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, b, b.src)
+}
+
+func (b *Bates) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *Bates) UnmarshalText(text []byte) error {
+	return unmarshalText(text, b, b.src)
+}
+
+func (b *Bates) ParameterValues() map[string]float64 {
+	return parameterValues(b)
+}
+
+func (b *Bates) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(b, params, b.src)
 }
 
 // a ∈ (-∞,∞)
-// b ∈ (-∞,∞)
-// n ∈ [0,∞)
-func (b *Bates) Parameter() stats.Limits {
+// b ∈ (a,∞)
+// n ∈ [1,∞)
+func (b *Bates) Parameters() stats.Limits {
 	return stats.Limits{
-		"a": stats.Interval{0, math.Inf(1), true, true},
-		"b": stats.Interval{0, math.Inf(1), true, true},
-		"n": stats.Interval{0, math.Inf(1), false, true},
+		"a": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
+		"b": stats.Interval{b.a, math.Inf(1), true, true},
+		"n": stats.Interval{1, math.Inf(1), false, true},
 	}
 }
 
@@ -49,23 +80,14 @@ func (b *Bates) Support() stats.Interval {
 	return stats.Interval{b.a, b.b, false, false}
 }
 
+// The density of the mean of n standard uniforms is n times that of their sum, IrwinHall, at nz.
 func (b *Bates) Probability(x float64) float64 {
-	if b.Support().IsWithinInterval(x) {
-		if x > b.a && x < b.b {
-			sum := 0.
-			var k uint
-			for k <= b.n {
-				pow := math.Pow(((x-b.a)/(b.b-b.a))-float64(k)/float64(b.n), float64(b.n)-1)
-				sgn := gsl.Sign(((x - b.a) / (b.b - b.a)) - float64(k)/float64(b.n))
-				sum += math.Pow(-1., float64(k)) * specfunc.Choose(b.n, k) * pow * float64(sgn)
-				k++
-			}
-
-			return sum
-		}
+	if x < b.a || x > b.b {
+		return 0
 	}
 
-	return 0
+	ih := &IrwinHall{b.n, b.src}
+	return float64(b.n) / (b.b - b.a) * ih.Probability(float64(b.n)*(x-b.a)/(b.b-b.a))
 }
 
 func (b *Bates) Mean() float64 {
@@ -89,9 +111,40 @@ func (b *Bates) Entropy() float64 {
 	return math.NaN()
 }
 
+// Bates is the mean of n standard uniforms rescaled to [a,b], so its cdf is that of IrwinHall at n(x-a)/(b-a).
 func (b *Bates) Distribution(x float64) float64 {
-	stats.NotImplementedError()
-	return math.NaN()
+	if x >= b.b {
+		return 1
+	}
+
+	ih := &IrwinHall{b.n, b.src}
+	return ih.Distribution(float64(b.n) * (x - b.a) / (b.b - b.a))
+}
+
+func (b *Bates) Inverse(p float64) float64 {
+	if p <= 0 {
+		return b.a
+	}
+
+	if p >= 1 {
+		return b.b
+	}
+
+	ih := &IrwinHall{b.n, b.src}
+	return (b.b-b.a)*ih.Inverse(p)/float64(b.n) + b.a
+}
+
+func (b *Bates) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return b.a
+	}
+
+	if q <= 0 {
+		return b.b
+	}
+
+	// symmetric about (a+b)/2
+	return b.a + b.b - b.Inverse(q)
 }
 
 func (b *Bates) Rand() float64 {
@@ -0,0 +1,26 @@
+package continuous
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBatesInverseDistribution(t *testing.T) {
+	tol := 0.000000001
+	cases := []struct {
+		a, b float64
+		n    uint
+	}{
+		{0, 1, 1},
+		{-2, 3, 2},
+		{1, 4, 6},
+	}
+
+	for _, c := range cases {
+		for _, p := range []float64{.001, .2, .5, .9, .999} {
+			b, _ := NewBates(c.a, c.b, c.n)
+			res := b.Distribution(b.Inverse(p))
+			run_test(t, res, p, tol, fmt.Sprintf("BatesInverseDistribution(%v, %v, %v, %v)", c.a, c.b, c.n, p))
+		}
+	}
+}
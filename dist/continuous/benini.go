@@ -1,9 +1,7 @@
 package continuous
 
 import (
-	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -20,20 +18,49 @@ func NewBenini(alpha, beta, sigma float64) (*Benini, error) {
 }
 
 func NewBeniniWithSource(alpha, beta, sigma float64, src rand.Source) (*Benini, error) {
-	if alpha < 0 || beta <= 0 || sigma <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(Benini)
 	ret.alpha = alpha
 	ret.beta = beta
 	ret.sigma = sigma
 	ret.src = src
+
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (b *Benini) spec() (string, []Common, []float64) {
+	return "Benini", nil, []float64{b.alpha, b.beta, b.sigma}
+}
+
 func (b *Benini) String() string {
-	return "Benini: Parameters - " + b.Parameters().String() + ", Support(x) - " + b.Support().String()
+	return specString(b.spec())
+}
+
+func (b *Benini) MarshalJSON() ([]byte, error) {
+	return marshalJSON(b)
+}
+
+func (b *Benini) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, b, b.src)
+}
+
+func (b *Benini) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *Benini) UnmarshalText(text []byte) error {
+	return unmarshalText(text, b, b.src)
+}
+
+func (b *Benini) ParameterValues() map[string]float64 {
+	return parameterValues(b)
+}
+
+func (b *Benini) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(b, params, b.src)
 }
 
 // α ∈ [0,∞), this allows for 2-parameter Benini instead of α ∈ (0,∞) without a need for another type
@@ -70,24 +97,40 @@ func (b *Benini) Distribution(x float64) float64 {
 
 func (b *Benini) Inverse(p float64) float64 {
 	if p <= 0 {
-		return 0
+		return b.sigma
 	}
 
 	if p >= 1 {
 		return math.Inf(1)
 	}
 
-	if b.alpha == 0 {
-		return b.sigma * math.Exp(math.Sqrt(-(1/b.beta)*math.Log(1-p)))
+	return b.InverseSurvival(1 - p)
+}
+
+func (b *Benini) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return b.sigma
 	}
 
-	stats.NotImplementedError()
-	return math.NaN()
+	if q <= 0 {
+		return math.Inf(1)
+	}
 
+	// ln S = -αy - βy², where y = ln(x/σ)
+	y := (-b.alpha + math.Sqrt(b.alpha*b.alpha-4*b.beta*math.Log(q))) / (2 * b.beta)
+	return b.sigma * math.Exp(y)
+}
+
+// E[X^k] = σ^k (1 + k∫₀^∞ exp((k-α)y - βy²) dy), substituting x = σe^y in σ^k + ∫_σ^∞ kx^(k-1) S(x) dx.
+func (b *Benini) moment(k float64) float64 {
+	c := k - b.alpha
+	sb := math.Sqrt(b.beta)
+	integral := math.Sqrt(math.Pi) / (2 * sb) * math.Exp(c*c/(4*b.beta)) * math.Erfc(-c/(2*sb))
+	return math.Pow(b.sigma, k) * (1 + k*integral)
 }
 
 func (b *Benini) Mean() float64 {
-	return b.sigma + (b.sigma/math.Sqrt(2.*b.beta))*specfunc.Hermite_prob(-1, (-1+b.alpha)/math.Sqrt(2*b.beta))
+	return b.moment(1)
 }
 
 func (b *Benini) Median() float64 {
@@ -96,7 +139,7 @@ func (b *Benini) Median() float64 {
 
 func (b *Benini) Variance() float64 {
 	mean := b.Mean()
-	return ((b.sigma * b.sigma) + ((2*(b.sigma*b.sigma))/math.Sqrt(2*b.beta))*specfunc.Hermite_prob(-1, (-2+b.alpha)/math.Sqrt(2*b.beta))) - (mean * mean)
+	return b.moment(2) - mean*mean
 }
 
 func (b *Benini) Entropy() float64 {
@@ -120,11 +163,6 @@ func (b *Benini) Skewness() float64 {
 }
 
 func (b *Benini) Rand() float64 {
-	if b.alpha == 0 {
-		stats.NotImplementedError()
-		return math.NaN()
-	}
-
 	var rnd float64
 	if b.src != nil {
 		rnd = rand.New(b.src).Float64()
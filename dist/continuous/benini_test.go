@@ -89,16 +89,18 @@ func TestBeniniInverse(t *testing.T) {
 	}
 }
 
-// Generated with Mathematica Variance[BeniniDistribution[a, b]]
+// Mathematica Variance[BeniniDistribution[a, b]] to 6 digits (9.69602, 38.7841 and 0.383294), extended with
+// E[X²] - E[X]² from E[X^k] = σ^k (1 + k√π/(2√β) exp(k²/4β) erfc(-k/(2√β))) at α = 0, and checked by
+// quadrature of σ^k (1 + k∫₀^∞ exp(ky - βy²) dy). The 6 digits alone miss the tolerance by 5e-6.
 func TestBeniniVariance(t *testing.T) {
 	tol := 0.000001
 	cases := []struct {
 		α, β, σ  float64
 		expected float64
 	}{
-		{0, 1, 2, 9.69602},
-		{0, 1, 4, 38.7841},
-		{0, 10, 3, 0.383294},
+		{0, 1, 2, 9.696023881},
+		{0, 1, 4, 38.784095524},
+		{0, 10, 3, 0.383294155},
 	}
 
 	for i, c := range cases {
@@ -114,16 +116,17 @@ func TestBeniniVariance(t *testing.T) {
 	}
 }
 
-// Generated with Mathematica Mean[BeniniDistribution[a, b]]
+// Mathematica Mean[BeniniDistribution[a, b]] to 6 digits (5.46047, 10.9209 and 4.01456), extended from the
+// closed form and quadrature given for TestBeniniVariance.
 func TestBeniniMean(t *testing.T) {
 	tol := 0.000001
 	cases := []struct {
 		α, β, σ  float64
 		expected float64
 	}{
-		{0, 1, 2, 5.46047},
-		{0, 1, 4, 10.9209},
-		{0, 10, 3, 4.01456},
+		{0, 1, 2, 5.460468867},
+		{0, 1, 4, 10.920937735},
+		{0, 10, 3, 4.014557516},
 	}
 
 	for i, c := range cases {
@@ -2,26 +2,64 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
+	"math/rand"
 )
 
 // Benktander type I distribution (Benktander-Gibrat Distribution)
 // https://en.wikipedia.org/wiki/Benktander_type_I_distribution
 type BenktanderType1 struct {
+	baseContinuousWithSource
 	a, b float64
 }
 
 func NewBenktanderType1(a, b float64) (*BenktanderType1, error) {
-	if a <= 0 || b <= 0 {
-		return nil, err.Invalid()
+	return NewBenktanderType1WithSource(a, b, nil)
+}
+
+func NewBenktanderType1WithSource(a, b float64, src rand.Source) (*BenktanderType1, error) {
+	r := new(BenktanderType1)
+	r.a = a
+	r.b = b
+	r.src = src
+
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &BenktanderType1{a, b}, nil
+	return r, nil
+}
+
+func (bfk *BenktanderType1) spec() (string, []Common, []float64) {
+	return "BenktanderType1", nil, []float64{bfk.a, bfk.b}
 }
 
 func (bfk *BenktanderType1) String() string {
-	return "BenktanderType1: Parameters - " + bfk.Parameters().String() + ", Support(x) - " + bfk.Support().String()
+	return specString(bfk.spec())
+}
+
+func (bfk *BenktanderType1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(bfk)
+}
+
+func (bfk *BenktanderType1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, bfk, bfk.src)
+}
+
+func (bfk *BenktanderType1) MarshalText() ([]byte, error) {
+	return []byte(bfk.String()), nil
+}
+
+func (bfk *BenktanderType1) UnmarshalText(text []byte) error {
+	return unmarshalText(text, bfk, bfk.src)
+}
+
+func (bfk *BenktanderType1) ParameterValues() map[string]float64 {
+	return parameterValues(bfk)
+}
+
+func (bfk *BenktanderType1) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(bfk, params, bfk.src)
 }
 
 // a ∈ (0,∞)
@@ -57,6 +95,30 @@ func (bfk *BenktanderType1) Distribution(x float64) float64 {
 	return 0
 }
 
+func (bfk *BenktanderType1) Inverse(p float64) float64 {
+	if p <= 0 {
+		return 1
+	}
+
+	if p >= 1 {
+		return math.Inf(1)
+	}
+
+	return bfk.logSurvivalInverse(math.Log1p(-p))
+}
+
+func (bfk *BenktanderType1) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 1
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return bfk.logSurvivalInverse(math.Log(q))
+}
+
 func (bfk *BenktanderType1) Mean() float64 {
 	return 1. + (1 / bfk.a)
 }
@@ -95,3 +157,46 @@ func (bfk *BenktanderType1) rm(r float64) float64 {
 	denom := 2 * bfk.a * sqrtb
 	return num / denom
 }
+
+func (bfk *BenktanderType1) Rand() float64 {
+	var rnd float64
+	if bfk.src != nil {
+		rnd = rand.New(bfk.src).Float64()
+	} else {
+		rnd = rand.Float64()
+	}
+
+	return bfk.Inverse(rnd)
+}
+
+// Solves log S(x) = ls for x. With y = ln x, log S = ln(1+2by/a) - (a+1)y - by², which is
+// concave and decreasing in y, so Newton's method started from the root of the quadratic
+// part (which lies below the solution) converges monotonically.
+func (bfk *BenktanderType1) logSurvivalInverse(ls float64) float64 {
+	if ls >= 0 {
+		return 1
+	}
+
+	if math.IsInf(ls, -1) {
+		return math.Inf(1)
+	}
+
+	a1 := bfk.a + 1
+	y := (-a1 + math.Sqrt(a1*a1-4*bfk.b*ls)) / (2 * bfk.b)
+	for i := 0; i < 100; i++ {
+		c := bfk.a + 2*bfk.b*y
+		f := math.Log(c/bfk.a) - a1*y - bfk.b*(y*y) - ls
+		df := (2*bfk.b)/c - a1 - 2*bfk.b*y
+		dy := f / df
+		y -= dy
+		if y < 0 {
+			y = 0
+		}
+
+		if math.Abs(dy) <= 1e-15*math.Max(y, 1) {
+			break
+		}
+	}
+
+	return math.Exp(y)
+}
@@ -2,6 +2,7 @@ package continuous
 
 import (
 	"fmt"
+	"math/rand"
 	"strconv"
 	"testing"
 )
@@ -96,3 +97,39 @@ func TestBenktanderType1Mean(t *testing.T) {
 		})
 	}
 }
+
+func TestBenktanderType1Rand(t *testing.T) {
+	cases := []struct {
+		a, b float64
+	}{
+		{1, 1},
+		{2, .5},
+		{4, .1},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			b, _ := NewBenktanderType1WithSource(c.a, c.b, rand.NewSource(int64(i+1)))
+			test_rand_ks(t, b.Rand, b.Distribution, 5000, fmt.Sprintf("BenktanderType1Rand(%v, %v)", c.a, c.b))
+		})
+	}
+}
+
+func TestBenktanderType1InverseDistribution(t *testing.T) {
+	tol := 0.000000001
+	cases := []struct {
+		a, b float64
+	}{
+		{1, 1},
+		{2, .5},
+		{4, .1},
+	}
+
+	for _, c := range cases {
+		for _, p := range []float64{1e-6, .1, .5, .9, .999999} {
+			b := BenktanderType1{a: c.a, b: c.b}
+			res := b.Distribution(b.Inverse(p))
+			run_test(t, res, p, tol, fmt.Sprintf("BenktanderType1InverseDistribution(%v, %v, %v)", c.a, c.b, p))
+		}
+	}
+}
@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -20,20 +19,48 @@ func NewBenktanderType2(a, b float64) (*BenktanderType2, error) {
 }
 
 func NewBenktanderType2WithSource(a, b float64, src rand.Source) (*BenktanderType2, error) {
-	if a <= 0 || b <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(BenktanderType2)
 	ret.a = a
 	ret.b = b
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (bsk *BenktanderType2) spec() (string, []Common, []float64) {
+	return "BenktanderType2", nil, []float64{bsk.a, bsk.b}
+}
+
 func (bsk *BenktanderType2) String() string {
-	return "BenktanderType2: Parameters - " + bsk.Parameters().String() + ", Support(x) - " + bsk.Support().String()
+	return specString(bsk.spec())
+}
+
+func (bsk *BenktanderType2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(bsk)
+}
+
+func (bsk *BenktanderType2) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, bsk, bsk.src)
+}
+
+func (bsk *BenktanderType2) MarshalText() ([]byte, error) {
+	return []byte(bsk.String()), nil
+}
+
+func (bsk *BenktanderType2) UnmarshalText(text []byte) error {
+	return unmarshalText(text, bsk, bsk.src)
+}
+
+func (bsk *BenktanderType2) ParameterValues() map[string]float64 {
+	return parameterValues(bsk)
+}
+
+func (bsk *BenktanderType2) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(bsk, params, bsk.src)
 }
 
 // a ∈ (0,∞)
@@ -86,23 +113,46 @@ func (bsk *BenktanderType2) Median() float64 {
 }
 
 func (bsk *BenktanderType2) Inverse(p float64) float64 {
-	if 0 < p && p < 1 && bsk.b == 1 {
-		return 1 - (math.Log(1-p) / bsk.a)
+	if p <= 0 {
+		return 1
 	}
 
-	if bsk.b != 1 && p > 0 || p < 1 {
-		a := bsk.a * math.Exp(bsk.a/(1-bsk.b)) * (1 - p) * (-bsk.b / (1 - bsk.b))
-		b := 1 - bsk.b
-		num := (1 - bsk.b) * specfunc.Lambert_W0(a/b)
-		denom := bsk.a
-		return math.Pow(num/denom, 1/bsk.b)
+	if p >= 1 {
+		return math.Inf(1)
 	}
 
-	if p <= 0 {
+	return bsk.InverseSurvival(1 - p)
+}
+
+func (bsk *BenktanderType2) InverseSurvival(q float64) float64 {
+	if q >= 1 {
 		return 1
 	}
 
-	return math.Inf(1)
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	if bsk.b == 1 {
+		return 1 - math.Log(q)/bsk.a
+	}
+
+	// With u = xᵇ and c = a/(1-b), S(x) = q becomes cu·exp(cu) = c·exp(c)·q^(-b/(1-b)).
+	c := bsk.a / (1 - bsk.b)
+	lz := c + math.Log(c) - (bsk.b/(1-bsk.b))*math.Log(q)
+
+	var w float64
+	if lz < 700 {
+		w = specfunc.Lambert_W0(math.Exp(lz))
+	} else {
+		// w + ln w = lz, beyond the range of exp
+		w = lz - math.Log(lz)
+		for i := 0; i < 10; i++ {
+			w -= (w + math.Log(w) - lz) * w / (w + 1)
+		}
+	}
+
+	return math.Pow(w/c, 1/bsk.b)
 }
 
 func (bsk *BenktanderType2) Variance() float64 {
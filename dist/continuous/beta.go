@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -21,20 +20,65 @@ func NewBeta(alpha, beta float64) (*Beta, error) {
 }
 
 func NewBetaWithSource(alpha, beta float64, src rand.Source) (*Beta, error) {
-	if alpha <= 0 || beta <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(Beta)
 	ret.alpha = alpha
 	ret.beta = beta
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+// NewBetaFromMoments returns the Beta distribution of the mean and variance of m, by the method of
+// moments: α = mean·c and β = (1-mean)·c with c = mean(1-mean)/variance - 1. The mean must be within
+// (0,1) and the variance below mean(1-mean).
+func NewBetaFromMoments(m SampleMoments) (*Beta, error) {
+	return NewBetaFromMomentsWithSource(m, nil)
+}
+
+func NewBetaFromMomentsWithSource(m SampleMoments, src rand.Source) (*Beta, error) {
+	mean, v := m.Mean(), m.Variance()
+	if !(mean > 0 && mean < 1 && v > 0 && v < mean*(1-mean)) {
+		return nil, momentsError("Beta", mean, v)
+	}
+
+	c := mean*(1-mean)/v - 1
+	return NewBetaWithSource(mean*c, (1-mean)*c, src)
+}
+
+func (b *Beta) spec() (string, []Common, []float64) {
+	return "Beta", nil, []float64{b.alpha, b.beta}
+}
+
 func (b *Beta) String() string {
-	return "Beta: Parameters - " + b.Parameters().String() + ", Support(x) - " + b.Support().String()
+	return specString(b.spec())
+}
+
+func (b *Beta) MarshalJSON() ([]byte, error) {
+	return marshalJSON(b)
+}
+
+func (b *Beta) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, b, b.src)
+}
+
+func (b *Beta) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *Beta) UnmarshalText(text []byte) error {
+	return unmarshalText(text, b, b.src)
+}
+
+func (b *Beta) ParameterValues() map[string]float64 {
+	return parameterValues(b)
+}
+
+func (b *Beta) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(b, params, b.src)
 }
 
 // α ∈ (0,∞)
@@ -63,6 +107,10 @@ func (b *Beta) Probability(x float64) float64 {
 }
 
 func (b *Beta) Distribution(x float64) float64 {
+	if x >= b.Support().Upper {
+		return 1
+	}
+
 	if b.Support().IsWithinInterval(x) {
 		return specfunc.Beta_inc(b.alpha, b.beta, x)
 	}
@@ -103,6 +151,19 @@ func (b *Beta) Inverse(q float64) float64 {
 	return smath.InverseRegularizedIncompleteBeta(b.alpha, b.beta, q)
 }
 
+func (b *Beta) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return 1
+	}
+
+	// 1 - I(x; α, β) = I(1-x; β, α)
+	return 1 - smath.InverseRegularizedIncompleteBeta(b.beta, b.alpha, q)
+}
+
 func (b *Beta) Mean() float64 {
 	return b.alpha / (b.alpha + b.beta)
 }
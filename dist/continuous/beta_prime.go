@@ -3,7 +3,7 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
+	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
 )
@@ -20,20 +20,48 @@ func NewBetaPrime(alpha, beta float64) (*BetaPrime, error) {
 }
 
 func NewBetaPrimeWithSource(alpha, beta float64, src rand.Source) (*BetaPrime, error) {
-	if alpha <= 0 || beta <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(BetaPrime)
 	ret.alpha = alpha
 	ret.beta = beta
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (bp *BetaPrime) spec() (string, []Common, []float64) {
+	return "BetaPrime", nil, []float64{bp.alpha, bp.beta}
+}
+
 func (bp *BetaPrime) String() string {
-	return "BetaPrime: Parameters - " + bp.Parameters().String() + ", Support(x) - " + bp.Support().String()
+	return specString(bp.spec())
+}
+
+func (bp *BetaPrime) MarshalJSON() ([]byte, error) {
+	return marshalJSON(bp)
+}
+
+func (bp *BetaPrime) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, bp, bp.src)
+}
+
+func (bp *BetaPrime) MarshalText() ([]byte, error) {
+	return []byte(bp.String()), nil
+}
+
+func (bp *BetaPrime) UnmarshalText(text []byte) error {
+	return unmarshalText(text, bp, bp.src)
+}
+
+func (bp *BetaPrime) ParameterValues() map[string]float64 {
+	return parameterValues(bp)
+}
+
+func (bp *BetaPrime) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(bp, params, bp.src)
 }
 
 // α ∈ (0,∞)
@@ -81,6 +109,20 @@ func (bp *BetaPrime) Inverse(p float64) float64 {
 
 }
 
+func (bp *BetaPrime) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// 1-x for x ~ Beta(α, β)
+	y := smath.InverseRegularizedIncompleteBeta(bp.beta, bp.alpha, q)
+	return (1. - y) / y
+}
+
 func (bp *BetaPrime) Skewness() float64 {
 	if bp.beta > 3 {
 		return (2 * (2*bp.alpha + bp.beta - 1)) / (bp.beta - 3) * math.Sqrt((bp.beta-2)/(bp.alpha*(bp.alpha+bp.beta-1)))
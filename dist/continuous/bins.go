@@ -0,0 +1,196 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats/err"
+	"math"
+	"sort"
+)
+
+// BinRule selects how Bins divides the range of a sample into bins of equal width.
+type BinRule int
+
+const (
+	// ⌈log₂ n⌉ + 1 bins, Sturges' rule, which undersmooths little for samples near normal
+	SturgesBins BinRule = iota
+
+	// bins of width 2 IQR n^(-1/3), the Freedman-Diaconis rule, robust to outliers
+	FreedmanDiaconisBins
+
+	// the number of bins maximising the posterior of a piecewise constant density under Knuth's prior
+	KnuthBins
+)
+
+// Most bins KnuthBins tries, and FreedmanDiaconisBins gives a sample of a few far outliers.
+const maxBins = 1000
+
+// Bins returns the edges of the bins of equal width over the range of x that rule picks. A sample with
+// no spread gets one bin of width 1 about it, as numpy's histogram_bin_edges, and FreedmanDiaconisBins
+// falls back on SturgesBins for a sample of interquartile range 0 and gives at most maxBins (1000).
+//
+// D. Freedman and P. Diaconis, "On the histogram as a density estimator: L₂ theory," Zeitschrift für
+// Wahrscheinlichkeitstheorie und Verwandte Gebiete, vol. 57, no. 4, pp. 453-476, 1981.
+// K. H. Knuth, "Optimal data-based binning for histograms and histogram-based probability density
+// models," Digital Signal Processing, vol. 95, 102581, 2019.
+func Bins(x []float64, rule BinRule) ([]float64, error) {
+	if len(x) == 0 {
+		return nil, err.New(err.EINVAL, "Bins: no observations")
+	}
+
+	xs := append([]float64(nil), x...)
+	for _, v := range xs {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("Bins: observation %v is not finite", v))
+		}
+	}
+	sort.Float64s(xs)
+
+	lo, hi := xs[0], xs[len(xs)-1]
+	if lo == hi {
+		return []float64{lo - .5, hi + .5}, nil
+	}
+
+	n := float64(len(xs))
+	sturges := int(math.Ceil(math.Log2(n))) + 1
+	var m int
+	switch rule {
+	case SturgesBins:
+		m = sturges
+	case FreedmanDiaconisBins:
+		m = sturges
+		if w := 2 * iqr(xs) * math.Pow(n, -1./3); w > 0 {
+			m = int(math.Min(math.Ceil((hi-lo)/w), maxBins))
+		}
+	case KnuthBins:
+		m = knuth(xs)
+	default:
+		return nil, err.New(err.EINVAL, fmt.Sprintf("Bins: unknown rule %v", rule))
+	}
+
+	edges := make([]float64, m+1)
+	for i := range edges {
+		edges[i] = lo + (hi-lo)*float64(i)/float64(m)
+	}
+	edges[m] = hi
+
+	return edges, nil
+}
+
+// The number of bins m ≤ min(n, maxBins) maximising the log posterior
+// n log m + log Γ(m/2) - m log Γ(1/2) - log Γ(n + m/2) + Σ log Γ(nₖ + 1/2) of the sorted sample x.
+func knuth(x []float64) int {
+	n := float64(len(x))
+	top := len(x)
+	if top > maxBins {
+		top = maxBins
+	}
+
+	best, arg := math.Inf(-1), 1
+	for m := 1; m <= top; m++ {
+		fm := float64(m)
+		f := n*math.Log(fm) + specfunc.Lngamma(fm/2) - fm*specfunc.Lngamma(.5) - specfunc.Lngamma(n+fm/2)
+		for _, c := range binCounts(x, m) {
+			f += specfunc.Lngamma(c + .5)
+		}
+
+		if f > best {
+			best, arg = f, m
+		}
+	}
+
+	return arg
+}
+
+// Counts of the sorted sample x in m bins of equal width over its range, the last closed.
+func binCounts(x []float64, m int) []float64 {
+	lo, hi := x[0], x[len(x)-1]
+	counts := make([]float64, m)
+	for _, v := range x {
+		i := int(float64(m) * (v - lo) / (hi - lo))
+		if i >= m {
+			i = m - 1
+		}
+		counts[i]++
+	}
+
+	return counts
+}
+
+// Counts of x in the bins between edges, each closed on the left, the last closed on both sides too.
+func histogram(x, edges []float64) []float64 {
+	counts := make([]float64, len(edges)-1)
+	for _, v := range x {
+		i := sort.Search(len(edges), func(i int) bool { return edges[i] > v }) - 1
+		if i == len(counts) && v == edges[i] {
+			i--
+		}
+
+		if i >= 0 && i < len(counts) {
+			counts[i]++
+		}
+	}
+
+	return counts
+}
+
+// Walker's alias table for drawing index i with probability p[i] in O(1) time, as built by Vose.
+//
+// M. D. Vose, "A linear algorithm for generating random numbers with a given distribution," IEEE
+// Transactions on Software Engineering, vol. 17, no. 9, pp. 972-975, 1991.
+type alias struct {
+	prob  []float64
+	alias []int
+}
+
+// newAlias returns the alias table of the weights w, which are nonnegative and of positive sum.
+func newAlias(w []float64) alias {
+	n := len(w)
+	var total float64
+	for _, v := range w {
+		total += v
+	}
+
+	a := alias{make([]float64, n), make([]int, n)}
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, v := range w {
+		scaled[i] = v * float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		a.prob[s], a.alias[s] = scaled[s], l
+		if scaled[l] += scaled[s] - 1; scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+
+	// what is left has probability 1 up to rounding
+	for _, i := range append(small, large...) {
+		a.prob[i], a.alias[i] = 1, i
+	}
+
+	return a
+}
+
+// draw returns the index picked by the uniform variates u and v on [0, 1).
+func (a alias) draw(u, v float64) int {
+	i := int(u * float64(len(a.prob)))
+	if i == len(a.prob) {
+		i--
+	}
+
+	if v < a.prob[i] {
+		return i
+	}
+
+	return a.alias[i]
+}
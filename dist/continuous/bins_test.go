@@ -0,0 +1,76 @@
+package continuous
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBins(t *testing.T) {
+	cases := []struct {
+		x    []float64
+		rule BinRule
+		want int
+	}{
+		{empiricalData, SturgesBins, 5},
+		// IQR 4.025, width 2·4.025/10^(1/3), ⌈8.5/3.7365⌉ bins
+		{empiricalData, FreedmanDiaconisBins, 3},
+		// an IQR of 0 falls back on Sturges
+		{[]float64{1, 2, 2, 2, 2, 2, 3}, FreedmanDiaconisBins, 4},
+		{[]float64{5}, KnuthBins, 1},
+		// a far outlier would ask for about 4·10¹¹ bins
+		{[]float64{0, 1, 2, 3, 4, 5, 6, 7, 1e12}, FreedmanDiaconisBins, maxBins},
+	}
+
+	for _, c := range cases {
+		edges, e := Bins(c.x, c.rule)
+		if e != nil || len(edges) != c.want+1 {
+			t.Errorf("Mismatch. Bins(%v, %v) want: %d bins, got: %v, %v", c.x, c.rule, c.want, edges, e)
+		}
+	}
+
+	if edges, _ := Bins([]float64{5}, SturgesBins); edges[0] != 4.5 || edges[1] != 5.5 {
+		t.Errorf("Mismatch. one observation want: [4.5 5.5], got: %v", edges)
+	}
+
+	if _, e := Bins([]float64{1, math.NaN()}, SturgesBins); e == nil {
+		t.Errorf("Mismatch. NaN want: error, got: nil")
+	}
+
+	if _, e := Bins(empiricalData, BinRule(3)); e == nil {
+		t.Errorf("Mismatch. unknown rule want: error, got: nil")
+	}
+}
+
+// Knuth's rule finds the structure of a density of a few steps, and more bins for a smooth one as the
+// sample grows.
+func TestKnuthBins(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	steps := make([]float64, 5000)
+	for i := range steps {
+		steps[i] = float64(i%2) + rnd.Float64() // 1/4 on [0, 1) and [1, 2), 1/2 between
+		if i%4 == 0 {
+			steps[i] = 1 + rnd.Float64()/2
+		}
+	}
+
+	edges, _ := Bins(steps, KnuthBins)
+	if m := len(edges) - 1; m < 2 || m > 8 {
+		t.Errorf("Mismatch. steps want: 2 to 8 bins, got: %d", m)
+	}
+
+	var prev int
+	for _, n := range []int{100, 10000} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+
+		edges, _ := Bins(x, KnuthBins)
+		if m := len(edges) - 1; m <= prev {
+			t.Errorf("Mismatch. n = %d want: more than %d bins, got: %d", n, prev, m)
+		} else {
+			prev = m
+		}
+	}
+}
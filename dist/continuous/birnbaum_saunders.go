@@ -3,7 +3,7 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
+	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
 )
@@ -20,20 +20,48 @@ func NewBirnbaumSaunders(shape, scale float64) (*BirnbaumSaunders, error) {
 }
 
 func NewBirnbaumSaundersWithSource(shape, scale float64, src rand.Source) (*BirnbaumSaunders, error) {
-	if shape <= 0 || scale <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(BirnbaumSaunders)
 	ret.shape = shape
 	ret.scale = scale
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (bs *BirnbaumSaunders) spec() (string, []Common, []float64) {
+	return "BirnbaumSaunders", nil, []float64{bs.shape, bs.scale}
+}
+
 func (bs *BirnbaumSaunders) String() string {
-	return "BirnbaumSaunders: Parameters - " + bs.Parameters().String() + ", Support(x) - " + bs.Support().String()
+	return specString(bs.spec())
+}
+
+func (bs *BirnbaumSaunders) MarshalJSON() ([]byte, error) {
+	return marshalJSON(bs)
+}
+
+func (bs *BirnbaumSaunders) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, bs, bs.src)
+}
+
+func (bs *BirnbaumSaunders) MarshalText() ([]byte, error) {
+	return []byte(bs.String()), nil
+}
+
+func (bs *BirnbaumSaunders) UnmarshalText(text []byte) error {
+	return unmarshalText(text, bs, bs.src)
+}
+
+func (bs *BirnbaumSaunders) ParameterValues() map[string]float64 {
+	return parameterValues(bs)
+}
+
+func (bs *BirnbaumSaunders) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(bs, params, bs.src)
 }
 
 // α ∈ (0,∞)
@@ -81,6 +109,19 @@ func (bs *BirnbaumSaunders) Inverse(p float64) float64 {
 
 }
 
+func (bs *BirnbaumSaunders) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	e := -smath.Ndtri(q) / math.Sqrt2
+	return (1 + (bs.shape*bs.shape)*(e*e) + bs.shape*e*math.Sqrt(2+(bs.shape*bs.shape)*(e*e))) / bs.scale
+}
+
 func (bs *BirnbaumSaunders) Mean() float64 {
 	return (2 + (bs.shape * bs.shape)) / (2 * bs.scale)
 }
@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -20,29 +19,59 @@ func NewBurr(c, k, scale float64) (*Burr, error) {
 }
 
 func NewBurrWithSource(c, k, scale float64, src rand.Source) (*Burr, error) {
-	if c <= 0 || k <= 0 || scale <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(Burr)
 	ret.c = c
 	ret.k = k
 	ret.scale = scale
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (b *Burr) spec() (string, []Common, []float64) {
+	return "Burr", nil, []float64{b.c, b.k, b.scale}
+}
+
 func (b *Burr) String() string {
-	return "Burr: Parameters - " + b.Parameters().String() + ", Support(x) - " + b.Support().String()
+	return specString(b.spec())
+}
+
+func (b *Burr) MarshalJSON() ([]byte, error) {
+	return marshalJSON(b)
+}
+
+func (b *Burr) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, b, b.src)
+}
+
+func (b *Burr) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *Burr) UnmarshalText(text []byte) error {
+	return unmarshalText(text, b, b.src)
+}
+
+func (b *Burr) ParameterValues() map[string]float64 {
+	return parameterValues(b)
+}
+
+func (b *Burr) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(b, params, b.src)
 }
 
 // c ∈ (0,∞)
 // k ∈ (0,∞)
+// λ ∈ (0,∞)
 func (b *Burr) Parameters() stats.Limits {
 	return stats.Limits{
 		"c": stats.Interval{0, math.Inf(1), true, true},
 		"k": stats.Interval{0, math.Inf(1), true, true},
+		"λ": stats.Interval{0, math.Inf(1), true, true},
 	}
 }
 
@@ -114,6 +143,18 @@ func (b *Burr) Inverse(p float64) float64 {
 	return math.Pow(math.Expm1((-1/b.k)*math.Log1p(-p)), 1/b.c) * b.scale
 }
 
+func (b *Burr) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return math.Pow(math.Expm1((-1/b.k)*math.Log(q)), 1/b.c) * b.scale
+}
+
 func (b *Burr) Entropy() float64 {
 	stats.NotImplementedError()
 	return math.NaN()
@@ -2,8 +2,8 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
+	"math/cmplx"
 	"math/rand"
 )
 
@@ -19,20 +19,48 @@ func NewCauchy(location, scale float64) (*Cauchy, error) {
 }
 
 func NewCauchyWithSource(location, scale float64, src rand.Source) (*Cauchy, error) {
-	if scale <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(Cauchy)
 	ret.location = location
 	ret.scale = scale
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (c *Cauchy) spec() (string, []Common, []float64) {
+	return "Cauchy", nil, []float64{c.location, c.scale}
+}
+
 func (c *Cauchy) String() string {
-	return "Cauchy: Parameters - " + c.Parameters().String() + ", Support(x) - " + c.Support().String()
+	return specString(c.spec())
+}
+
+func (c *Cauchy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(c)
+}
+
+func (c *Cauchy) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, c, c.src)
+}
+
+func (c *Cauchy) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *Cauchy) UnmarshalText(text []byte) error {
+	return unmarshalText(text, c, c.src)
+}
+
+func (c *Cauchy) ParameterValues() map[string]float64 {
+	return parameterValues(c)
+}
+
+func (c *Cauchy) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(c, params, c.src)
 }
 
 // x₀ ∈ (-∞,∞)
@@ -83,6 +111,19 @@ func (c *Cauchy) Inverse(p float64) float64 {
 	return c.location + c.scale*math.Tan(math.Pi*(p-0.5))
 }
 
+func (c *Cauchy) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// tan(π(1/2 - q)) = 1/tan(πq)
+	return c.location + c.scale/math.Tan(math.Pi*q)
+}
+
 func (c *Cauchy) Mean() float64 {
 	stats.NotImplementedError()
 	return math.NaN()
@@ -101,6 +142,11 @@ func (c *Cauchy) Variance() float64 {
 	return math.NaN()
 }
 
+// e^{ix₀t - γ|t|}
+func (c *Cauchy) CharacteristicFunction(t float64) complex128 {
+	return cmplx.Exp(complex(-c.scale*math.Abs(t), c.location*t))
+}
+
 func (c *Cauchy) Rand() float64 {
 	var rnd func() float64
 	if c.src == nil {
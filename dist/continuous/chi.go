@@ -4,7 +4,6 @@ import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -22,19 +21,47 @@ func NewChi(dof int) (*Chi, error) {
 }
 
 func NewChiWithSource(dof int, src rand.Source) (*Chi, error) {
-	if dof <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(Chi)
 	ret.dof = dof
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (c *Chi) spec() (string, []Common, []float64) {
+	return "Chi", nil, []float64{float64(c.dof)}
+}
+
 func (c *Chi) String() string {
-	return "Chi: Parameters - " + c.Parameters().String() + ", Support(x) - " + c.Support().String()
+	return specString(c.spec())
+}
+
+func (c *Chi) MarshalJSON() ([]byte, error) {
+	return marshalJSON(c)
+}
+
+func (c *Chi) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, c, c.src)
+}
+
+func (c *Chi) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *Chi) UnmarshalText(text []byte) error {
+	return unmarshalText(text, c, c.src)
+}
+
+func (c *Chi) ParameterValues() map[string]float64 {
+	return parameterValues(c)
+}
+
+func (c *Chi) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(c, params, c.src)
 }
 
 // k ∈ (0,∞)
@@ -79,6 +106,18 @@ func (c *Chi) Inverse(p float64) float64 {
 
 }
 
+func (c *Chi) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return math.Sqrt(smath.InverseRegularizedUpperIncompleteGamma(float64(c.dof)/2.0, q)) * math.Sqrt(2)
+}
+
 func (c *Chi) Entropy() float64 {
 	return math.Log(specfunc.Gamma(float64(c.dof)/2.0)) + 0.5*(float64(c.dof)-gsl.Ln2-(float64(c.dof)-1.0)*specfunc.Psi(float64(c.dof)/2.0))
 }
@@ -113,7 +152,7 @@ func (c *Chi) Mean() float64 {
 
 func (c *Chi) Variance() float64 {
 	mean := c.Mean()
-	return float64(c.dof) * (mean * mean)
+	return float64(c.dof) - mean*mean
 }
 
 func (c *Chi) Rand() float64 {
@@ -4,7 +4,6 @@ import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/linear"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -23,19 +22,47 @@ func NewChiSquared(dof int) (*ChiSquared, error) {
 }
 
 func NewChiSquaredWithSource(dof int, src rand.Source) (*ChiSquared, error) {
-	if dof <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(ChiSquared)
 	ret.dof = dof
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (cs *ChiSquared) spec() (string, []Common, []float64) {
+	return "ChiSquared", nil, []float64{float64(cs.dof)}
+}
+
 func (cs *ChiSquared) String() string {
-	return "ChiSquared: Parameters - " + cs.Parameters().String() + ", Support(x) - " + cs.Support().String()
+	return specString(cs.spec())
+}
+
+func (cs *ChiSquared) MarshalJSON() ([]byte, error) {
+	return marshalJSON(cs)
+}
+
+func (cs *ChiSquared) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, cs, cs.src)
+}
+
+func (cs *ChiSquared) MarshalText() ([]byte, error) {
+	return []byte(cs.String()), nil
+}
+
+func (cs *ChiSquared) UnmarshalText(text []byte) error {
+	return unmarshalText(text, cs, cs.src)
+}
+
+func (cs *ChiSquared) ParameterValues() map[string]float64 {
+	return parameterValues(cs)
+}
+
+func (cs *ChiSquared) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(cs, params, cs.src)
 }
 
 // k ∈ (0,∞)
@@ -87,6 +114,18 @@ func (cs *ChiSquared) Inverse(p float64) float64 {
 	return smath.InverseRegularizedLowerIncompleteGamma(float64(cs.dof)/2.0, p) * 2.0
 }
 
+func (cs *ChiSquared) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return smath.InverseRegularizedUpperIncompleteGamma(float64(cs.dof)/2.0, q) * 2.0
+}
+
 func (cs *ChiSquared) Entropy() float64 {
 	return (float64(cs.dof) / 2.) + math.Log(2*specfunc.Gamma(float64(cs.dof)/2)) + (1-(float64(cs.dof)/2.))*specfunc.Psi(float64(cs.dof)/2.)
 }
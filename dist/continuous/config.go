@@ -0,0 +1,34 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/stats/err"
+	"math"
+)
+
+// Implemented by the distributions whose numeric methods report problems, such as series that fail to
+// converge, to an err.Config of their own rather than to the package defaults. The Config is given by
+// their Context constructors, by ParseContext and ParseJSONContext, and is kept by WithParameters and by
+// decoding into the distribution. A nil Config follows the package defaults.
+type configured interface {
+	config() *err.Config
+	setConfig(*err.Config)
+}
+
+func configOf(d interface{}) *err.Config {
+	if c, ok := d.(configured); ok {
+		return c.config()
+	}
+
+	return nil
+}
+
+// Reports to c a special function of ggsl that came back infinite or NaN, and returns it. Errors raised
+// inside ggsl itself go to the process-wide policy of stats.SetGSLErrors, as ggsl keeps a single handler.
+func checkSpecial(c *err.Config, what string, x, v float64) float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		c.Report(fmt.Sprintf("%s at x = %v is %v", what, x, v), err.ERANGE, v, math.NaN())
+	}
+
+	return v
+}
@@ -0,0 +1,23 @@
+package continuous
+
+import (
+	stattest "github.com/jtejido/stats/testing"
+	"math/rand"
+	"testing"
+)
+
+// Distributions that need looser settings than the defaults, and why.
+var conformanceConfigs = map[string]stattest.Config{}
+
+func TestConformance(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			d, e := ParseWithSource(registrySpecs[name], rand.NewSource(1))
+			if e != nil {
+				t.Fatalf("Parse(%q): %v", registrySpecs[name], e)
+			}
+
+			stattest.Conformance(t, d.(stattest.Distribution), conformanceConfigs[name])
+		})
+	}
+}
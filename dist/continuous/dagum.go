@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -20,21 +19,49 @@ func NewDagum(p, a, scale float64) (*Dagum, error) {
 }
 
 func NewDagumWithSource(p, a, scale float64, src rand.Source) (*Dagum, error) {
-	if scale <= 0 || p <= 0 || a <= 0 {
-		return nil, err.Invalid()
-	}
-
 	r := new(Dagum)
 	r.p = p
 	r.a = a
 	r.scale = scale
 	r.src = src
 
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
 	return r, nil
 }
 
+func (d *Dagum) spec() (string, []Common, []float64) {
+	return "Dagum", nil, []float64{d.p, d.a, d.scale}
+}
+
 func (d *Dagum) String() string {
-	return "Dagum: Parameters - " + d.Parameters().String() + ", Support(x) - " + d.Support().String()
+	return specString(d.spec())
+}
+
+func (d *Dagum) MarshalJSON() ([]byte, error) {
+	return marshalJSON(d)
+}
+
+func (d *Dagum) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, d.src)
+}
+
+func (d *Dagum) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Dagum) UnmarshalText(text []byte) error {
+	return unmarshalText(text, d, d.src)
+}
+
+func (d *Dagum) ParameterValues() map[string]float64 {
+	return parameterValues(d)
+}
+
+func (d *Dagum) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(d, params, d.src)
 }
 
 // p ∈ (0,∞)
@@ -85,6 +112,19 @@ func (d *Dagum) Inverse(p float64) float64 {
 	return d.scale * math.Pow(-1+math.Pow(p, -1/d.p), -1/d.a)
 }
 
+func (d *Dagum) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// (1-q)^(-1/p) - 1
+	return d.scale * math.Pow(math.Expm1(-math.Log1p(-q)/d.p), -1/d.a)
+}
+
 func (d *Dagum) Mean() float64 {
 	if d.a > 1 {
 		return d.rm(1)
@@ -0,0 +1,245 @@
+package directional
+
+import (
+	"fmt"
+	"github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Bingham distribution on Sᵖ⁻¹, of density exp(Σₖ λₖ(aₖᵀx)²) / c(λ), antipodally symmetric, for
+// orthonormal axes aₖ. Directions orthogonal to every axis given take λ = 0, and adding a constant to
+// every λ, those included, leaves the distribution as it is.
+// https://en.wikipedia.org/wiki/Bingham_distribution
+type Bingham struct {
+	axes   [][]float64 // aₖ
+	lambda []float64   // λₖ
+	logC   float64     // log c(λ)
+
+	// the angular central Gaussian envelope of Rand
+	top        float64   // the largest λ, that of the other directions included
+	b, logM    float64   // b and log M
+	scale      []float64 // standard deviation along each aₖ
+	scaleOther float64   // and along the other directions
+	src        rand.Source
+}
+
+func NewBingham(axes [][]float64, lambda []float64) (*Bingham, error) {
+	return NewBinghamWithSource(axes, lambda, nil)
+}
+
+func NewBinghamWithSource(axes [][]float64, lambda []float64, src rand.Source) (*Bingham, error) {
+	if len(axes) == 0 || len(axes) != len(lambda) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("Bingham: %v axes for %v values of λ", len(axes), len(lambda)))
+	}
+
+	a, e := orthonormal("Bingham", axes...)
+	if e != nil {
+		return nil, e
+	}
+
+	for _, l := range lambda {
+		if math.IsNaN(l) || math.IsInf(l, 0) {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("Bingham: λ = %v is not finite", lambda))
+		}
+	}
+
+	p := len(a[0])
+	r := &Bingham{axes: a, lambda: append([]float64(nil), lambda...), src: src}
+	r.logC = binghamLogC(p, r.lambda)
+	r.envelope(p)
+
+	return r, nil
+}
+
+// The values of λ and the number of each, the p - len(lambda) other directions taking 0.
+func eigenvalues(p int, lambda []float64) ([]float64, []int) {
+	all := append(make([]float64, p-len(lambda)), lambda...)
+	sort.Float64s(all)
+
+	var values []float64
+	var counts []int
+	for i, v := range all {
+		if i > 0 && v == all[i-1] {
+			counts[len(counts)-1]++
+			continue
+		}
+		values = append(values, v)
+		counts = append(counts, 1)
+	}
+
+	return values, counts
+}
+
+// log c(λ) by the series of Kume and Wood, taken from the smallest λ so that every term is positive:
+// with L the spread of λ, c = |Sᵖ⁻¹| e^{λₘᵢₙ} Σₙ Lᴺ b(N) / (p/2)ₙ, where b is the convolution over the
+// distinct λ, of multiplicity m, of rⁿ (m/2)ₙ / n! with r = (λ - λₘᵢₙ)/L. The terms fall as those of a
+// Poisson(L) distribution, and the sum stops far into their tail.
+func binghamLogC(p int, lambda []float64) float64 {
+	values, counts := eigenvalues(p, lambda)
+	low, spread := values[0], values[len(values)-1]-values[0]
+	if spread == 0 {
+		return logSphereArea(p) + low
+	}
+
+	n := int(math.Ceil(spread+10*math.Sqrt(spread+1))) + 30
+	var b []float64
+	for i, v := range values {
+		h := float64(counts[i]) / 2
+		lr := math.Log((v - low) / spread)
+		a := make([]float64, n+1)
+		for j := range a {
+			fj := float64(j)
+			a[j] = specfunc.Lngamma(h+fj) - specfunc.Lngamma(h) - specfunc.Lngamma(fj+1)
+			if j > 0 {
+				a[j] += fj * lr
+			}
+		}
+
+		if b == nil {
+			b = a
+			continue
+		}
+		b = logConvolve(b, a)
+	}
+
+	hp := float64(p) / 2
+	sum, ls := math.Inf(-1), math.Log(spread)
+	for j, v := range b {
+		fj := float64(j)
+		sum = logAdd(sum, fj*ls+v-specfunc.Lngamma(hp+fj)+specfunc.Lngamma(hp))
+	}
+
+	return logSphereArea(p) + low + sum
+}
+
+// The convolution of the sequences of logs a and b, up to their length.
+func logConvolve(a, b []float64) []float64 {
+	c := make([]float64, len(a))
+	for n := range c {
+		c[n] = math.Inf(-1)
+		for i := 0; i <= n; i++ {
+			c[n] = logAdd(c[n], a[i]+b[n-i])
+		}
+	}
+
+	return c
+}
+
+// Sets the angular central Gaussian envelope of Kent, Ganeiber and Mardia (2018), "A new unified
+// approach for the simulation of a wide class of directional distributions". With A = λₘₐₓI - Λ, so
+// that the density is in proportion to exp(-xᵀAx), and Ω = I + 2A/b, the envelope is the direction of
+// a normal of covariance Ω⁻¹, within M = e^{-(p-b)/2}(p/b)^{p/2} of the density, where b solves
+// Σᵢ 1/(b + 2aᵢ) = 1 over the eigenvalues aᵢ of A.
+func (bg *Bingham) envelope(p int) {
+	values, counts := eigenvalues(p, bg.lambda)
+	bg.top = values[len(values)-1]
+
+	f := func(b float64) float64 {
+		var s float64
+		for i, v := range values {
+			s += float64(counts[i]) / (b + 2*(bg.top-v))
+		}
+		return s - 1
+	}
+
+	// f decreases from ∞ at 0, as A has a zero eigenvalue, to at most 0 at p
+	lo, hi := 0., float64(p)
+	for i := 0; i < 200 && hi-lo > 1e-15*hi; i++ {
+		if m := (lo + hi) / 2; f(m) > 0 {
+			lo = m
+		} else {
+			hi = m
+		}
+	}
+
+	fp := float64(p)
+	bg.b = hi
+	bg.logM = -(fp-bg.b)/2 + fp/2*math.Log(fp/bg.b)
+	bg.scale = make([]float64, len(bg.lambda))
+	for i, l := range bg.lambda {
+		bg.scale[i] = 1 / math.Sqrt(1+2*(bg.top-l)/bg.b)
+	}
+	bg.scaleOther = 1 / math.Sqrt(1+2*bg.top/bg.b)
+}
+
+func (bg *Bingham) Dimension() int {
+	return len(bg.axes[0])
+}
+
+func (bg *Bingham) Axes() [][]float64 {
+	r := make([][]float64, len(bg.axes))
+	for i, a := range bg.axes {
+		r[i] = append([]float64(nil), a...)
+	}
+
+	return r
+}
+
+func (bg *Bingham) Lambda() []float64 {
+	return append([]float64(nil), bg.lambda...)
+}
+
+func (bg *Bingham) Probability(x []float64) float64 {
+	return math.Exp(bg.LogProbability(x))
+}
+
+func (bg *Bingham) LogProbability(x []float64) float64 {
+	if !onSphere(x, bg.Dimension()) {
+		return math.Inf(-1)
+	}
+
+	return bg.exponent(x) - bg.logC
+}
+
+// Σₖ λₖ(aₖᵀx)²
+func (bg *Bingham) exponent(x []float64) float64 {
+	var s float64
+	for i, a := range bg.axes {
+		t := dot(a, x)
+		s += bg.lambda[i] * t * t
+	}
+
+	return s
+}
+
+// Rand draws from the angular central Gaussian envelope, keeping a draw in proportion to its density
+// over M times that of the envelope.
+func (bg *Bingham) Rand() []float64 {
+	var rnd *rand.Rand
+	if bg.src != nil {
+		rnd = rand.New(bg.src)
+	}
+
+	p := float64(bg.Dimension())
+	g := make([]float64, bg.Dimension())
+	for {
+		for i := range g {
+			g[i] = randNormal(rnd)
+		}
+
+		z := make([]float64, len(g))
+		for i := range z {
+			z[i] = bg.scaleOther * g[i]
+		}
+
+		for i, a := range bg.axes {
+			t := (bg.scale[i] - bg.scaleOther) * dot(a, g)
+			for j := range z {
+				z[j] += t * a[j]
+			}
+		}
+
+		if dot(z, z) == 0 {
+			continue
+		}
+
+		x := normalize(z)
+		q := bg.top - bg.exponent(x) // xᵀAx
+		if math.Log(randFloat64(rnd)) <= -q+p/2*math.Log(1+2*q/bg.b)-bg.logM {
+			return x
+		}
+	}
+}
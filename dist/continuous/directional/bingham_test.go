@@ -0,0 +1,80 @@
+package directional
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBingham(t *testing.T) {
+	a1 := []float64{0, .6, .8}
+	a2 := []float64{1, 0, 0}
+	a3 := []float64{0, .8, -.6}
+	cases := []struct {
+		axes   [][]float64
+		lambda []float64
+	}{
+		{[][]float64{a1}, []float64{5}},
+		{[][]float64{a1}, []float64{-20}},
+		{[][]float64{a1, a2}, []float64{-3, 2}},
+		{[][]float64{a1, a2}, []float64{4, 4}},
+		{[][]float64{a1, a2, a3}, []float64{-10, -10, -10}},
+		{[][]float64{a1, a2, a3}, []float64{1, 30, -7}},
+	}
+
+	for _, c := range cases {
+		b, _ := NewBingham(c.axes, c.lambda)
+		if got := integrateSphere(b.Probability); !closeTo(got, 1, 1e-8) {
+			t.Errorf("Mismatch. Bingham(%v) mass want: 1, got: %v", c.lambda, got)
+		}
+
+		// antipodal symmetry
+		x, y := []float64{.36, .48, .8}, []float64{-.36, -.48, -.8}
+		if !closeTo(b.Probability(x), b.Probability(y), 1e-15) {
+			t.Errorf("Mismatch. Bingham(%v) Probability(-x) want: %v, got: %v", c.lambda, b.Probability(x), b.Probability(y))
+		}
+	}
+
+	// adding a constant to every λ
+	b1, _ := NewBingham([][]float64{a1, a2, a3}, []float64{1, 30, -7})
+	b2, _ := NewBingham([][]float64{a1, a2}, []float64{8, 37})
+	for _, x := range [][]float64{a1, a3, {.36, .48, .8}} {
+		if !closeTo(b1.Probability(x), b2.Probability(x), 1e-12) {
+			t.Errorf("Mismatch. Bingham shifted Probability(%v) want: %v, got: %v", x, b1.Probability(x), b2.Probability(x))
+		}
+	}
+}
+
+func TestBinghamRand(t *testing.T) {
+	const n = 20000
+	a1 := []float64{0, .6, .8}
+	a2 := []float64{1, 0, 0}
+	for _, lambda := range [][]float64{{5, 0}, {-20, 0}, {-3, 2}, {25, -40}} {
+		b, _ := NewBinghamWithSource([][]float64{a1, a2}, lambda, rand.NewSource(1))
+
+		// E[(a₁ᵀx)²] and E[(a₂ᵀx)²]
+		var want, got [2]float64
+		for i, a := range [][]float64{a1, a2} {
+			want[i] = integrateSphere(func(x []float64) float64 {
+				t := dot(a, x)
+				return t * t * b.Probability(x)
+			})
+		}
+
+		for i := 0; i < n; i++ {
+			x := b.Rand()
+			if !onSphere(x, 3) {
+				t.Fatalf("Mismatch. Bingham Rand want: a unit vector, got: %v", x)
+			}
+
+			for j, a := range [][]float64{a1, a2} {
+				t := dot(a, x)
+				got[j] += t * t / n
+			}
+		}
+
+		if math.Abs(got[0]-want[0]) > .015 || math.Abs(got[1]-want[1]) > .015 {
+			t.Errorf("Mismatch. Bingham(%v) sample moments want: %v, got: %v", lambda, want, got)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package directional
+
+import (
+	"fmt"
+	"github.com/jtejido/stats/dist/continuous"
+	"github.com/jtejido/stats/err"
+	smath "github.com/jtejido/stats/math"
+	"math"
+	"math/rand"
+)
+
+// TorusModel is the form of dependence between the angles of a BivariateVonMises.
+type TorusModel int
+
+const (
+	SineModel   TorusModel = iota // λ sin(φ-μ) sin(ψ-ν), of Singh, Hnizdo and Demchuk (2002)
+	CosineModel                   // -λ cos(φ-μ-ψ+ν), of Mardia, Taylor and Subramaniam (2007)
+)
+
+var torusModelNames = map[TorusModel]string{
+	SineModel:   "sine",
+	CosineModel: "cosine",
+}
+
+func (m TorusModel) String() string {
+	if s, ok := torusModelNames[m]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("TorusModel(%d)", int(m))
+}
+
+// Bivariate von Mises distribution of a pair of angles (φ, ψ) on the torus, of density
+// exp(κ₁ cos(φ-μ) + κ₂ cos(ψ-ν) + the term of its model) / C. Given φ, ψ is von Mises in either model,
+// and angles are taken modulo 2π.
+// https://en.wikipedia.org/wiki/Bivariate_von_Mises_distribution
+type BivariateVonMises struct {
+	model          TorusModel
+	mu, nu         float64 // μ, ν
+	kappa1, kappa2 float64 // κ₁, κ₂
+	lambda         float64 // λ
+	logC           float64 // log C
+
+	// the envelope of the marginal of φ - μ for Rand, over bins of equal width from -π
+	envelope *continuous.PiecewiseConstant
+	logBins  []float64 // log of the height of each bin, as the log marginal is shifted
+	shift    float64
+	src      rand.Source
+}
+
+func NewBivariateVonMises(model TorusModel, mu, nu, kappa1, kappa2, lambda float64) (*BivariateVonMises, error) {
+	return NewBivariateVonMisesWithSource(model, mu, nu, kappa1, kappa2, lambda, nil)
+}
+
+func NewBivariateVonMisesWithSource(model TorusModel, mu, nu, kappa1, kappa2, lambda float64, src rand.Source) (*BivariateVonMises, error) {
+	if _, ok := torusModelNames[model]; !ok {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("BivariateVonMises: unknown model %v", model))
+	}
+
+	for _, v := range []float64{mu, nu, kappa1, kappa2, lambda} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("BivariateVonMises: parameter %v is not finite", v))
+		}
+	}
+
+	if kappa1 < 0 || kappa2 < 0 {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("BivariateVonMises: concentrations (κ₁, κ₂) = (%v, %v) are outside [0, ∞)", kappa1, kappa2))
+	}
+
+	r := &BivariateVonMises{model: model, mu: mu, nu: nu, kappa1: kappa1, kappa2: kappa2, lambda: lambda, src: src}
+
+	// the marginal is smooth and periodic, so the trapezoidal rule converges geometrically
+	n := 64 + 4*int(math.Ceil(kappa1+kappa2+math.Abs(lambda)))
+	h := 2 * math.Pi / float64(n)
+	sum := math.Inf(-1)
+	for i := 0; i < n; i++ {
+		sum = logAdd(sum, r.logMarginal(-math.Pi+float64(i)*h))
+	}
+	r.logC = math.Log(2*math.Pi) + sum + math.Log(h)
+
+	if e := r.setEnvelope(); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+// The concentration and the mean, less ν, of ψ given φ = μ + x.
+func (bvm *BivariateVonMises) conditional(x float64) (float64, float64) {
+	s, c := math.Sincos(x)
+	if bvm.model == SineModel {
+		return math.Hypot(bvm.kappa2, bvm.lambda*s), math.Atan2(bvm.lambda*s, bvm.kappa2)
+	}
+
+	return math.Hypot(bvm.kappa2-bvm.lambda*c, bvm.lambda*s), math.Atan2(-bvm.lambda*s, bvm.kappa2-bvm.lambda*c)
+}
+
+// log of the density of φ at μ + x times C/2π, κ₁ cos x + log I₀(κ(x)), the integral over ψ.
+func (bvm *BivariateVonMises) logMarginal(x float64) float64 {
+	k, _ := bvm.conditional(x)
+	return bvm.kappa1*math.Cos(x) + logBesselI(0, k)
+}
+
+// Bounds the log marginal on each bin by its values at the edges and its Lipschitz constant L: κ₁ + |λ|
+// in the sine model, and κ₁ + min(κ₂, |λ|) in the cosine one, as |dκ/dx| ≤ min(κ₂, |λ|) there and
+// |d log I₀(κ)/dκ| = I₁(κ)/I₀(κ) < 1. Bins of width at most 1/L keep the bound within e^{1/2}.
+func (bvm *BivariateVonMises) setEnvelope() error {
+	l := bvm.kappa1 + math.Abs(bvm.lambda)
+	if bvm.model == CosineModel {
+		l = bvm.kappa1 + math.Min(bvm.kappa2, math.Abs(bvm.lambda))
+	}
+
+	n := int(math.Max(64, math.Ceil(2*math.Pi*l)))
+	h := 2 * math.Pi / float64(n)
+	edges := make([]float64, n+1)
+	for i := range edges {
+		edges[i] = -math.Pi + float64(i)*h
+	}
+	edges[n] = math.Pi
+
+	bvm.logBins = make([]float64, n)
+	bvm.shift = math.Inf(-1)
+	prev := bvm.logMarginal(edges[0])
+	for i := range bvm.logBins {
+		next := bvm.logMarginal(edges[i+1])
+		bvm.logBins[i] = (prev + next + l*h) / 2
+		bvm.shift = math.Max(bvm.shift, bvm.logBins[i])
+		prev = next
+	}
+
+	weights := make([]float64, n)
+	for i, v := range bvm.logBins {
+		weights[i] = math.Exp(v - bvm.shift)
+	}
+
+	var e error
+	bvm.envelope, e = continuous.NewPiecewiseConstantWithSource(edges, weights, bvm.src)
+	return e
+}
+
+func (bvm *BivariateVonMises) Model() TorusModel {
+	return bvm.model
+}
+
+// μ, ν
+func (bvm *BivariateVonMises) Means() (float64, float64) {
+	return bvm.mu, bvm.nu
+}
+
+// κ₁, κ₂
+func (bvm *BivariateVonMises) Concentrations() (float64, float64) {
+	return bvm.kappa1, bvm.kappa2
+}
+
+func (bvm *BivariateVonMises) Lambda() float64 {
+	return bvm.lambda
+}
+
+func (bvm *BivariateVonMises) Probability(φ, ψ float64) float64 {
+	return math.Exp(bvm.LogProbability(φ, ψ))
+}
+
+func (bvm *BivariateVonMises) LogProbability(φ, ψ float64) float64 {
+	x, y := φ-bvm.mu, ψ-bvm.nu
+	v := bvm.kappa1*math.Cos(x) + bvm.kappa2*math.Cos(y)
+	if bvm.model == SineModel {
+		v += bvm.lambda * math.Sin(x) * math.Sin(y)
+	} else {
+		v -= bvm.lambda * math.Cos(x-y)
+	}
+
+	return v - bvm.logC
+}
+
+// MarginalProbability is the density of φ, 2π e^{κ₁ cos(φ-μ)} I₀(κ(φ)) / C, for κ(φ) the
+// concentration of ψ given φ.
+func (bvm *BivariateVonMises) MarginalProbability(φ float64) float64 {
+	return math.Exp(math.Log(2*math.Pi) + bvm.logMarginal(φ-bvm.mu) - bvm.logC)
+}
+
+// Conditional returns the distribution of ψ given φ over [-π, π]: von Mises, or uniform where its
+// concentration κ(φ) is 0.
+func (bvm *BivariateVonMises) Conditional(φ float64) (Circular, error) {
+	k, δ := bvm.conditional(φ - bvm.mu)
+	if k == 0 {
+		return NewCircularUniformWithSource(continuous.DefaultCircularSupport, bvm.src)
+	}
+
+	m := smath.WrapRange(bvm.nu+δ, -math.Pi, math.Pi, false)
+	return continuous.NewVonMisesWithSource(m, k, continuous.DefaultCircularSupport, bvm.src)
+}
+
+// Rand draws φ from its marginal, by rejection from a piecewise constant envelope, then ψ from its
+// conditional, both in [-π, π).
+func (bvm *BivariateVonMises) Rand() (float64, float64) {
+	var rnd *rand.Rand
+	if bvm.src != nil {
+		rnd = rand.New(bvm.src)
+	}
+
+	n := len(bvm.logBins)
+	var x float64
+	for {
+		x = bvm.envelope.Rand()
+		i := int((x + math.Pi) / (2 * math.Pi) * float64(n))
+		if i >= n {
+			i = n - 1
+		} else if i < 0 {
+			i = 0
+		}
+
+		if math.Log(randFloat64(rnd)) <= bvm.logMarginal(x)-bvm.logBins[i] {
+			break
+		}
+	}
+
+	φ := smath.WrapRange(bvm.mu+x, -math.Pi, math.Pi, false)
+	ψ := -math.Pi + 2*math.Pi*randFloat64(rnd)
+	if k, δ := bvm.conditional(x); k > 0 {
+		vm, _ := continuous.NewVonMisesWithSource(0, k, continuous.DefaultCircularSupport, bvm.src)
+		ψ = smath.WrapRange(bvm.nu+δ+vm.Rand(), -math.Pi, math.Pi, false)
+	}
+
+	return φ, ψ
+}
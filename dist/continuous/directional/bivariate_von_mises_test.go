@@ -0,0 +1,110 @@
+package directional
+
+import (
+	"github.com/jtejido/stats/dist/continuous"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// ∫∫f(φ, ψ)dφdψ over the torus by the trapezoidal rule.
+func integrateTorus(f func(φ, ψ float64) float64) float64 {
+	const n = 300
+	h := 2 * math.Pi / n
+	var sum float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			sum += f(-math.Pi+float64(i)*h, -math.Pi+float64(j)*h)
+		}
+	}
+
+	return sum * h * h
+}
+
+var torusCases = []struct {
+	model           TorusModel
+	μ, ν, κ1, κ2, λ float64
+}{
+	{SineModel, 0, 0, 1, 1, 0},
+	{SineModel, 1, -2, 2, 3, 1.5},
+	{SineModel, -1, 2, .5, .3, 6},
+	{SineModel, 0, 0, 0, 0, 2},
+	{CosineModel, 1, -2, 2, 3, 1.5},
+	{CosineModel, 3, 0, 10, 4, -6},
+	{CosineModel, 0, 1, 0, 2, 2},
+}
+
+func TestBivariateVonMises(t *testing.T) {
+	if SineModel.String() != "sine" || TorusModel(5).String() != "TorusModel(5)" {
+		t.Errorf("Mismatch. TorusModel String want: sine, TorusModel(5), got: %v, %v", SineModel, TorusModel(5))
+	}
+
+	for _, c := range torusCases {
+		d, _ := NewBivariateVonMises(c.model, c.μ, c.ν, c.κ1, c.κ2, c.λ)
+		if got := integrateTorus(d.Probability); !closeTo(got, 1, 1e-10) {
+			t.Errorf("Mismatch. BivariateVonMises(%v) mass want: 1, got: %v", c, got)
+		}
+
+		// the density of ψ given φ, the joint density over the marginal one
+		for _, φ := range []float64{-3, 0, 1.2} {
+			cond, e := d.Conditional(φ)
+			if e != nil {
+				t.Fatalf("Mismatch. BivariateVonMises(%v) Conditional want: nil, got: %v", c, e)
+			}
+
+			for _, ψ := range []float64{-2.5, .1, 3} {
+				want := d.Probability(φ, ψ) / d.MarginalProbability(φ)
+				if got := cond.Probability(ψ); !closeTo(got, want, 1e-12) {
+					t.Errorf("Mismatch. BivariateVonMises(%v) Conditional(%v) Probability(%v) want: %v, got: %v", c, φ, ψ, want, got)
+				}
+			}
+		}
+	}
+
+	// the product of von Mises distributions at λ = 0
+	for _, m := range []TorusModel{SineModel, CosineModel} {
+		d, _ := NewBivariateVonMises(m, 1, -2, 2, 5, 0)
+		v1, _ := continuous.NewVonMises(1, 2, continuous.DefaultCircularSupport)
+		v2, _ := continuous.NewVonMises(-2, 5, continuous.DefaultCircularSupport)
+		for _, p := range [][2]float64{{0, 0}, {1, -2}, {-3, 2}} {
+			want := v1.Probability(p[0]) * v2.Probability(p[1])
+			if got := d.Probability(p[0], p[1]); !closeTo(got, want, 1e-12) {
+				t.Errorf("Mismatch. BivariateVonMises(%v, λ = 0) Probability(%v) want: %v, got: %v", m, p, want, got)
+			}
+		}
+	}
+}
+
+func TestBivariateVonMisesRand(t *testing.T) {
+	const n = 20000
+	moments := func(φ, ψ float64) [3]float64 {
+		return [3]float64{math.Cos(φ), math.Sin(ψ), math.Sin(φ) * math.Cos(ψ)}
+	}
+
+	for _, c := range torusCases {
+		d, _ := NewBivariateVonMisesWithSource(c.model, c.μ, c.ν, c.κ1, c.κ2, c.λ, rand.NewSource(1))
+		var want, got [3]float64
+		for k := range want {
+			want[k] = integrateTorus(func(φ, ψ float64) float64 { return moments(φ, ψ)[k] * d.Probability(φ, ψ) })
+		}
+
+		for i := 0; i < n; i++ {
+			φ, ψ := d.Rand()
+			if φ < -math.Pi || φ >= math.Pi || ψ < -math.Pi || ψ >= math.Pi {
+				t.Fatalf("Mismatch. BivariateVonMises Rand want: angles in [-π, π), got: %v, %v", φ, ψ)
+			}
+
+			m := moments(φ, ψ)
+			for k := range got {
+				got[k] += m[k] / n
+			}
+		}
+
+		for k := range got {
+			if math.Abs(got[k]-want[k]) > .02 {
+				t.Errorf("Mismatch. BivariateVonMises(%v) sample moments want: %v, got: %v", c, want, got)
+				break
+			}
+		}
+	}
+}
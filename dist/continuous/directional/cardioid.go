@@ -0,0 +1,141 @@
+package directional
+
+import (
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/dist/continuous"
+	"math"
+	"math/cmplx"
+	"math/rand"
+)
+
+// Cardioid distribution, of density (1 + 2ρ cos(θ - μ))/2π
+// https://en.wikipedia.org/wiki/Cardioid_distribution
+type Cardioid struct {
+	mean, rho float64        // μ, ρ
+	support   stats.Interval // any interval of length 2π
+	src       rand.Source
+}
+
+func NewCardioid(mean, rho float64, support stats.Interval) (*Cardioid, error) {
+	return NewCardioidWithSource(mean, rho, support, nil)
+}
+
+func NewCardioidWithSource(mean, rho float64, support stats.Interval, src rand.Source) (*Cardioid, error) {
+	if e := checkSupport("Cardioid", support); e != nil {
+		return nil, e
+	}
+
+	r := &Cardioid{mean, rho, support, src}
+	if e := validate(r, r.Parameters()); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+func (c *Cardioid) spec() (string, []float64) {
+	return "Cardioid", []float64{c.mean, c.rho, c.support.Lower}
+}
+
+func (c *Cardioid) String() string {
+	return specString(c)
+}
+
+func (c *Cardioid) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *Cardioid) UnmarshalText(text []byte) error {
+	return unmarshalText(text, c, c.src)
+}
+
+// μ ∈ support
+// ρ ∈ [-1/2,1/2]
+func (c *Cardioid) Parameters() stats.Limits {
+	return stats.Limits{
+		"μ": c.support,
+		"ρ": stats.Interval{-.5, .5, false, false},
+	}
+}
+
+// θ ∈ (any interval of length 2π]
+func (c *Cardioid) Support() stats.Interval {
+	return c.support
+}
+
+func (c *Cardioid) Probability(θ float64) float64 {
+	if !c.support.IsWithinInterval(θ) {
+		return 0
+	}
+
+	return (1 + 2*c.rho*math.Cos(θ-c.mean)) / (2 * math.Pi)
+}
+
+// cdf from μ - π, at μ + x
+func (c *Cardioid) relative(x float64) float64 {
+	return (x+math.Pi)/(2*math.Pi) + c.rho*math.Sin(x)/math.Pi
+}
+
+func (c *Cardioid) Distribution(θ float64) float64 {
+	return circularDistribution(c.relative, c.mean, c.support, θ)
+}
+
+func (c *Cardioid) Inverse(p float64) float64 {
+	sup := c.support
+	if p <= 0 {
+		return sup.Lower
+	}
+
+	if p >= 1 {
+		return sup.Upper
+	}
+
+	x, _ := continuous.InverseWithOptions(c.Distribution, sup.Lower, sup.Upper, p, continuous.InverseOptions{Density: c.Probability})
+	return x
+}
+
+// CircularMean returns μ for ρ > 0, μ + π for ρ < 0, wrapped into the support, and NaN for ρ = 0.
+func (c *Cardioid) CircularMean() float64 {
+	return meanDirection(c.TrigonometricMoment(1), c.support)
+}
+
+func (c *Cardioid) ResultantLength() float64 {
+	return math.Abs(c.rho)
+}
+
+func (c *Cardioid) CircularVariance() float64 {
+	return 1 - math.Abs(c.rho)
+}
+
+func (c *Cardioid) CircularStdDev() float64 {
+	return circularStdDev(math.Abs(c.rho))
+}
+
+// φ₁ = ρe^{iμ}, and φₚ = 0 for |p| > 1
+func (c *Cardioid) TrigonometricMoment(p int) complex128 {
+	switch p {
+	case 0:
+		return 1
+	case 1, -1:
+		return complex(c.rho, 0) * cmplx.Exp(complex(0, float64(p)*c.mean))
+	}
+
+	return 0
+}
+
+// Rand draws from the uniform distribution, keeping a draw with probability in proportion to its
+// density.
+func (c *Cardioid) Rand() float64 {
+	var rnd *rand.Rand
+	if c.src != nil {
+		rnd = rand.New(c.src)
+	}
+
+	bound := 1 + 2*math.Abs(c.rho)
+	for {
+		θ := c.support.Lower + 2*math.Pi*randFloat64(rnd)
+		if randFloat64(rnd)*bound <= 1+2*c.rho*math.Cos(θ-c.mean) {
+			return θ
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package directional
+
+import (
+	"github.com/jtejido/stats/dist/continuous"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestCardioid(t *testing.T) {
+	for _, c := range []struct{ μ, ρ float64 }{{0, .25}, {2, .5}, {-1, -.4}, {3, 0}} {
+		cd, _ := NewCardioidWithSource(c.μ, c.ρ, continuous.DefaultCircularSupport, rand.NewSource(1))
+		checkCircular(t, cd)
+	}
+
+	// the mean direction turns round for ρ < 0
+	cd, _ := NewCardioid(-1, -.4, continuous.DefaultCircularSupport)
+	if m := cd.CircularMean(); !closeTo(m, math.Pi-1, 1e-15) {
+		t.Errorf("Mismatch. Cardioid CircularMean want: %v, got: %v", math.Pi-1, m)
+	}
+
+	// the Kato-Jones distribution at r = 0
+	kj, _ := NewKatoJones(2, .3, 1, 0, continuous.DefaultCircularSupport)
+	cd, _ = NewCardioid(2, .3, continuous.DefaultCircularSupport)
+	for _, θ := range []float64{-3, -1, 0, 1, 2.5} {
+		if !closeTo(cd.Distribution(θ), kj.Distribution(θ), 1e-15) {
+			t.Errorf("Mismatch. Cardioid Distribution(%v) want: %v, got: %v", θ, kj.Distribution(θ), cd.Distribution(θ))
+		}
+	}
+}
@@ -0,0 +1,190 @@
+// Package directional holds distributions of angles, on any interval of length 2π, described by their
+// trigonometric moments rather than their linear ones, together with distributions of unit vectors on
+// the sphere Sᵖ⁻¹ and of pairs of angles on the torus, and the statistics and tests of samples of
+// angles.
+//
+// K. V. Mardia and P. E. Jupp, Directional Statistics, 1st ed. Wiley, 1999
+package directional
+
+import (
+	"fmt"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/dist/continuous"
+	"github.com/jtejido/stats/err"
+	smath "github.com/jtejido/stats/math"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var defaultLength = 2 * math.Pi
+
+// Circular is a distribution of angles. continuous.VonMises is one too.
+type Circular interface {
+	continuous.Common
+
+	// An interval of length 2π.
+	Support() stats.Interval
+
+	// The mean direction, arg φ₁, within the support, NaN where φ₁ = 0.
+	CircularMean() float64
+
+	// The mean resultant length R = |φ₁|.
+	ResultantLength() float64
+
+	// 1 - R.
+	CircularVariance() float64
+
+	// √(-2 log R).
+	CircularStdDev() float64
+
+	// The p-th trigonometric moment about the zero direction, φₚ = E[e^{ipθ}].
+	TrigonometricMoment(p int) complex128
+}
+
+// Implemented by every distribution of this package, returning its registered name and its parameters
+// in registry order, as in package continuous.
+type specifier interface {
+	spec() (string, []float64)
+}
+
+func init() {
+	lower := continuous.Param{Name: "lower", Optional: true, Default: continuous.DefaultCircularSupport.Lower}
+	mean := continuous.Param{Name: "mean", Symbol: "μ"}
+
+	for _, e := range []continuous.Entry{
+		{Name: "WrappedCauchy", Params: []continuous.Param{mean, {Name: "rho", Symbol: "ρ"}, lower},
+			New: func(_ []continuous.Common, p []float64, src rand.Source) (continuous.Common, error) {
+				return NewWrappedCauchyWithSource(p[0], p[1], circularSupport(p[2]), src)
+			}},
+		{Name: "WrappedNormal", Params: []continuous.Param{mean, {Name: "sigma", Symbol: "σ"}, lower},
+			New: func(_ []continuous.Common, p []float64, src rand.Source) (continuous.Common, error) {
+				return NewWrappedNormalWithSource(p[0], p[1], circularSupport(p[2]), src)
+			}},
+		{Name: "Cardioid", Params: []continuous.Param{mean, {Name: "rho", Symbol: "ρ"}, lower},
+			New: func(_ []continuous.Common, p []float64, src rand.Source) (continuous.Common, error) {
+				return NewCardioidWithSource(p[0], p[1], circularSupport(p[2]), src)
+			}},
+		{Name: "CircularUniform", Params: []continuous.Param{lower},
+			New: func(_ []continuous.Common, p []float64, src rand.Source) (continuous.Common, error) {
+				return NewCircularUniformWithSource(circularSupport(p[0]), src)
+			}},
+		{Name: "KatoJones", Params: []continuous.Param{mean, {Name: "gamma", Symbol: "γ"}, {Name: "nu", Symbol: "ν"}, {Name: "r", Symbol: "r"}, lower},
+			New: func(_ []continuous.Common, p []float64, src rand.Source) (continuous.Common, error) {
+				return NewKatoJonesWithSource(p[0], p[1], p[2], p[3], circularSupport(p[4]), src)
+			}},
+		{Name: "SineSkewedVonMises", Params: []continuous.Param{mean, {Name: "concentration", Symbol: "κ"}, {Name: "lambda", Symbol: "λ"}, lower},
+			New: func(_ []continuous.Common, p []float64, src rand.Source) (continuous.Common, error) {
+				return NewSineSkewedVonMisesWithSource(p[0], p[1], p[2], circularSupport(p[3]), src)
+			}},
+	} {
+		continuous.Register(e)
+	}
+}
+
+func circularSupport(lower float64) stats.Interval {
+	return stats.Interval{lower, lower + defaultLength, false, false}
+}
+
+func checkSupport(name string, support stats.Interval) error {
+	if support.IsEmpty() || !support.IsEqualLength(defaultLength) {
+		return err.New(err.EINVAL, fmt.Sprintf("%s: support %v is not of length 2π", name, support))
+	}
+
+	return nil
+}
+
+// Checks the parameters of d against the limits it declares for them, as continuous does.
+func validate(d specifier, limits stats.Limits) error {
+	name, values := d.spec()
+	entry, _ := continuous.Lookup(name)
+	for i, par := range entry.Params {
+		lim, ok := limits[par.Symbol]
+		if !ok || lim.IsWithinInterval(values[i]) {
+			continue
+		}
+
+		return err.New(err.EINVAL, fmt.Sprintf("%s: %s (%s) = %v is outside %v", name, par.Name, par.Symbol, values[i], lim))
+	}
+
+	return nil
+}
+
+// The spec of d that continuous.Parse accepts, leaving out parameters at their defaults.
+func specString(d specifier) string {
+	name, values := d.spec()
+	entry, _ := continuous.Lookup(name)
+	args := make([]string, 0, len(values))
+	for i, v := range values {
+		par := entry.Params[i]
+		if par.Optional && v == par.Default {
+			continue
+		}
+		args = append(args, par.Name+"="+strconv.FormatFloat(v, 'g', -1, 64))
+	}
+
+	return name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Decodes the spec text into dst, which points to a distribution of the same type, keeping src.
+func unmarshalText(text []byte, dst interface{}, src rand.Source) error {
+	d, e := continuous.ParseWithSource(string(text), src)
+	if e != nil {
+		return e
+	}
+
+	v := reflect.ValueOf(d)
+	if v.Type() == reflect.TypeOf(dst) {
+		reflect.ValueOf(dst).Elem().Set(v.Elem())
+		return nil
+	}
+
+	return err.New(err.EINVAL, fmt.Sprintf("cannot decode %v into %T", d, dst))
+}
+
+// θ - μ wrapped into [-π, π).
+func relative(θ, μ float64) float64 {
+	return smath.WrapRange(θ-μ, -math.Pi, math.Pi, false)
+}
+
+// The cdf over support of a distribution about μ whose cdf from μ - π is g.
+func circularDistribution(g func(float64) float64, μ float64, support stats.Interval, θ float64) float64 {
+	if θ >= support.Upper {
+		return 1
+	}
+
+	if !(θ > support.Lower) {
+		return 0
+	}
+
+	v := g(relative(θ, μ)) - g(relative(support.Lower, μ))
+	if v < 0 {
+		v++
+	}
+
+	return math.Max(0, math.Min(1, v))
+}
+
+// The angle of φ₁ wrapped into support, NaN if φ₁ is 0.
+func meanDirection(m complex128, support stats.Interval) float64 {
+	if m == 0 {
+		return math.NaN()
+	}
+
+	return smath.WrapRange(cmplx.Phase(m), support.Lower, support.Upper, false)
+}
+
+func circularStdDev(r float64) float64 {
+	return math.Sqrt(-2 * math.Log(r))
+}
+
+func randFloat64(rnd *rand.Rand) float64 {
+	if rnd != nil {
+		return rnd.Float64()
+	}
+
+	return rand.Float64()
+}
@@ -0,0 +1,116 @@
+package directional
+
+import (
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/dist/continuous"
+	stattest "github.com/jtejido/stats/testing"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+var _ Circular = (*continuous.VonMises)(nil)
+
+var shifted = stats.Interval{0, 2 * math.Pi, false, false}
+
+// ∫h(θ)f(θ)dθ over the support of d by Simpson's rule.
+func integrate(d Circular, h func(float64) complex128) complex128 {
+	const n = 20000
+	sup := d.Support()
+	step := (sup.Upper - sup.Lower) / n
+	var sum complex128
+	for i := 0; i <= n; i++ {
+		θ := sup.Lower + float64(i)*step
+		w := 2.
+		if i == 0 || i == n {
+			w = 1
+		} else if i%2 == 1 {
+			w = 4
+		}
+		sum += complex(w*d.Probability(θ), 0) * h(θ)
+	}
+
+	return sum * complex(step/3, 0)
+}
+
+func closeTo(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol*math.Max(1, math.Abs(b)) || math.IsNaN(a) && math.IsNaN(b) || a == b
+}
+
+// checkCircular compares the trigonometric moments of d and the statistics drawn from them with those
+// of its density, and runs the conformance checks.
+func checkCircular(t *testing.T, d Circular) {
+	t.Helper()
+	for p := -1; p <= 3; p++ {
+		fp := float64(p)
+		want := integrate(d, func(θ float64) complex128 { return cmplx.Exp(complex(0, fp*θ)) })
+		if got := d.TrigonometricMoment(p); cmplx.Abs(got-want) > 1e-9 {
+			t.Errorf("Mismatch. %v φ%d want: %v, got: %v", d, p, want, got)
+		}
+	}
+
+	φ := d.TrigonometricMoment(1)
+	r := cmplx.Abs(φ)
+	if !closeTo(d.ResultantLength(), r, 1e-12) || !closeTo(d.CircularVariance(), 1-r, 1e-12) || !closeTo(d.CircularStdDev(), math.Sqrt(-2*math.Log(r)), 1e-12) {
+		t.Errorf("Mismatch. %v want: R %v, got: %v, %v, %v", d, r, d.ResultantLength(), d.CircularVariance(), d.CircularStdDev())
+	}
+
+	if r > 0 {
+		if m := d.CircularMean(); math.Abs(cmplx.Phase(φ*cmplx.Exp(complex(0, -m)))) > 1e-12 {
+			t.Errorf("Mismatch. %v mean direction want: %v, got: %v", d, cmplx.Phase(φ), m)
+		}
+	}
+
+	stattest.Conformance(t, d, stattest.Config{Tol: 1e-7})
+}
+
+func TestParse(t *testing.T) {
+	for _, spec := range []string{
+		"WrappedCauchy(mean=1, rho=0.5)",
+		"WrappedNormal(mean=1, sigma=2, lower=0)",
+		"Cardioid(mean=-1, rho=-0.25)",
+		"CircularUniform()",
+		"KatoJones(mean=0.5, gamma=0.4, nu=1, r=0.3)",
+		"SineSkewedVonMises(mean=0, concentration=2, lambda=0.5)",
+	} {
+		d, e := continuous.Parse(spec)
+		if e != nil {
+			t.Errorf("Mismatch. %s want: no error, got: %v", spec, e)
+			continue
+		}
+
+		if s := d.(interface{ String() string }).String(); s != spec {
+			t.Errorf("Mismatch. String want: %s, got: %s", spec, s)
+		}
+
+		text, _ := d.(interface{ MarshalText() ([]byte, error) }).MarshalText()
+		if e := d.(interface{ UnmarshalText([]byte) error }).UnmarshalText(text); e != nil {
+			t.Errorf("Mismatch. UnmarshalText(%s) want: no error, got: %v", text, e)
+		}
+	}
+
+	var wc WrappedCauchy
+	if e := wc.UnmarshalText([]byte("Cardioid(0, 0.25)")); e == nil {
+		t.Errorf("Mismatch. decoding a Cardioid into a WrappedCauchy want: error, got: nil")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	short := stats.Interval{0, math.Pi, false, false}
+	cases := []func() error{
+		func() error { _, e := NewWrappedCauchy(0, 1, continuous.DefaultCircularSupport); return e },
+		func() error { _, e := NewWrappedCauchy(4, .5, continuous.DefaultCircularSupport); return e },
+		func() error { _, e := NewWrappedNormal(0, 0, continuous.DefaultCircularSupport); return e },
+		func() error { _, e := NewCardioid(0, .6, continuous.DefaultCircularSupport); return e },
+		func() error { _, e := NewCircularUniform(short); return e },
+		func() error { _, e := NewKatoJones(0, .5, 0, 1, continuous.DefaultCircularSupport); return e },
+		func() error { _, e := NewKatoJones(0, .5, math.Pi/2, .6, continuous.DefaultCircularSupport); return e },
+		func() error { _, e := NewSineSkewedVonMises(0, 1, 1.5, continuous.DefaultCircularSupport); return e },
+	}
+
+	for i, c := range cases {
+		if c() == nil {
+			t.Errorf("Mismatch. case %d want: error, got: nil", i)
+		}
+	}
+}
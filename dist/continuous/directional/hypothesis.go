@@ -0,0 +1,338 @@
+package directional
+
+import (
+	"context"
+	"github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/dist/continuous"
+	"github.com/jtejido/stats/err"
+	"math"
+	"sort"
+)
+
+// RayleighTest tests the angles x within support for uniformity against a unimodal alternative,
+// returning Rayleigh's Z = nR̄² and its p-value by the approximation of Greenwood and Durand,
+// exp(√(1 + 4n + 4(n² - R²)) - (1 + 2n)) for R = nR̄, which holds to within 1% from n = 5.
+func RayleighTest(x []float64, support stats.Interval) (Z, p float64) {
+	return RayleighTestContext(context.Background(), x, support)
+}
+
+// RayleighTestContext is RayleighTest raising the errors of the sample under the err.Config carried by
+// ctx, if any.
+func RayleighTestContext(ctx context.Context, x []float64, support stats.Interval) (Z, p float64) {
+	if !checkSample(err.FromContext(ctx), "RayleighTest", x, 1, support) {
+		return math.NaN(), math.NaN()
+	}
+
+	n := float64(len(x))
+	c, s := resultant(x)
+	r2 := c*c + s*s
+	p = math.Exp(math.Sqrt(1+4*n+4*(n*n-r2)) - (1 + 2*n))
+	return r2 / n, math.Max(0, math.Min(1, p))
+}
+
+// The cdf of d, over its support, at each of the angles x, sorted.
+func transform(x []float64, d Circular) []float64 {
+	u := make([]float64, len(x))
+	for i, v := range x {
+		u[i] = d.Distribution(v)
+	}
+	sort.Float64s(u)
+
+	return u
+}
+
+// KuiperTest tests whether the angles x, within the support of d, are drawn from d, returning Kuiper's
+// V = D⁺ + D⁻, the largest distances above and below d of the empirical cdf, and its asymptotic p-value
+// with Stephens' correction for finite n. Unlike D of Kolmogorov and Smirnov, V does not depend on where
+// the support starts. Test uniformity with a CircularUniform d.
+func KuiperTest(x []float64, d Circular) (V, p float64) {
+	return KuiperTestContext(context.Background(), x, d)
+}
+
+// KuiperTestContext is KuiperTest raising the errors of the sample under the err.Config carried by ctx,
+// if any.
+func KuiperTestContext(ctx context.Context, x []float64, d Circular) (V, p float64) {
+	if !checkSample(err.FromContext(ctx), "KuiperTest", x, 1, d.Support()) {
+		return math.NaN(), math.NaN()
+	}
+
+	n := float64(len(x))
+	var above, below float64
+	for i, u := range transform(x, d) {
+		above = math.Max(above, float64(i+1)/n-u)
+		below = math.Max(below, u-float64(i)/n)
+	}
+
+	V = above + below
+	sn := math.Sqrt(n)
+	return V, kuiperPValue(V * (sn + .155 + .24/sn))
+}
+
+// Probability that the limit of √n V exceeds l, 2 Σⱼ (4j²l² - 1) exp(-2j²l²).
+func kuiperPValue(l float64) float64 {
+	if l < .4 {
+		return 1
+	}
+
+	var sum float64
+	for j := 1.; j <= 100; j++ {
+		a := 2 * j * j * l * l
+		term := (2*a - 1) * math.Exp(-a)
+		sum += term
+		if math.Abs(term) <= 1e-12*sum {
+			break
+		}
+	}
+
+	return math.Max(0, math.Min(1, 2*sum))
+}
+
+// WatsonU2Test tests whether the angles x, within the support of d, are drawn from d, returning Watson's
+// U² = Σ(uᵢ - (2i-1)/2n)² - n(ū - 1/2)² + 1/12n of the sorted cdfs uᵢ of x, the Cramér–von Mises
+// statistic made independent of where the support starts, and its asymptotic p-value with Stephens'
+// correction for finite n. Test uniformity with a CircularUniform d.
+func WatsonU2Test(x []float64, d Circular) (U2, p float64) {
+	return WatsonU2TestContext(context.Background(), x, d)
+}
+
+// WatsonU2TestContext is WatsonU2Test raising the errors of the sample under the err.Config carried by
+// ctx, if any.
+func WatsonU2TestContext(ctx context.Context, x []float64, d Circular) (U2, p float64) {
+	if !checkSample(err.FromContext(ctx), "WatsonU2Test", x, 1, d.Support()) {
+		return math.NaN(), math.NaN()
+	}
+
+	n := float64(len(x))
+	var sum, mean float64
+	for i, u := range transform(x, d) {
+		e := u - (2*float64(i)+1)/(2*n)
+		sum += e * e
+		mean += u / n
+	}
+
+	U2 = sum - n*(mean-.5)*(mean-.5) + 1/(12*n)
+	return U2, watsonU2PValue((U2 - .1/n + .1/(n*n)) * (1 + .8/n))
+}
+
+// Probability that the limit of U² exceeds u, 2 Σⱼ (-1)ʲ⁻¹ exp(-2j²π²u).
+func watsonU2PValue(u float64) float64 {
+	if u < .02 {
+		return 1
+	}
+
+	var sum float64
+	sign := 1.
+	for j := 1.; j <= 100; j++ {
+		term := sign * math.Exp(-2*j*j*math.Pi*math.Pi*u)
+		sum += term
+		if math.Abs(term) <= 1e-12*math.Abs(sum) {
+			break
+		}
+		sign = -sign
+	}
+
+	return math.Max(0, math.Min(1, 2*sum))
+}
+
+// RaoSpacingTest tests the angles x within support, at least 2, for uniformity, returning Rao's spacing
+// statistic U = Σ|Tᵢ - 2π/n|/2 over the n arcs Tᵢ between neighbouring angles, in radians, and its
+// p-value. Up to 300 angles the p-value is exact, and beyond it is that of the normal limit of
+// U/2π, of mean (1 - 1/n)ⁿ and variance (2e - 5)/e²n.
+//
+// J. S. Rao, "Some tests based on arc-lengths for the circle," Sankhyā B, vol. 38, pp. 329-338, 1976.
+func RaoSpacingTest(x []float64, support stats.Interval) (U, p float64) {
+	return RaoSpacingTestContext(context.Background(), x, support)
+}
+
+// RaoSpacingTestContext is RaoSpacingTest raising the errors of the sample under the err.Config carried
+// by ctx, if any.
+func RaoSpacingTestContext(ctx context.Context, x []float64, support stats.Interval) (U, p float64) {
+	if !checkSample(err.FromContext(ctx), "RaoSpacingTest", x, 2, support) {
+		return math.NaN(), math.NaN()
+	}
+
+	a := append([]float64(nil), x...)
+	sort.Float64s(a)
+	n := len(a)
+	arc := 2 * math.Pi / float64(n)
+	for i := range a {
+		t := 2*math.Pi - a[n-1] + a[0]
+		if i > 0 {
+			t = a[i] - a[i-1]
+		}
+		U += math.Abs(t-arc) / 2
+	}
+
+	return U, raoSpacingSurvival(n, U/(2*math.Pi))
+}
+
+// Largest sample for which RaoSpacingTest integrates the exact density of its statistic.
+const raoSpacingExact = 300
+
+// Probability that U/2π of n uniform angles is at least w.
+func raoSpacingSurvival(n int, w float64) float64 {
+	fn := float64(n)
+	mean := math.Pow(1-1/fn, fn)
+	sd := math.Sqrt((2*math.E - 5) / (math.E * math.E * fn))
+	if n > raoSpacingExact {
+		return math.Max(0, math.Min(1, math.Erfc((w-mean)/(sd*math.Sqrt2))/2))
+	}
+
+	// U/2π lies within [0, 1 - 1/n], and its density is a polynomial between multiples of 1/n, so
+	// Gauss–Legendre rules between them are exact for small n and accurate beyond, where the mass
+	// far from the mean is also left out
+	lo, hi := math.Max(w, 0), 1-1/fn
+	if n > 50 {
+		if w <= mean-20*sd {
+			return 1
+		}
+		hi = math.Min(hi, mean+20*sd)
+	}
+
+	if lo >= hi {
+		return 0
+	}
+
+	q := (n + 1) / 2
+	if q > 10 {
+		q = 10
+	}
+
+	nodes, weights := gaussLegendre(q)
+	m := make([]float64, n)
+	b := make([]float64, n)
+	var sum float64
+	for k := math.Floor(lo * fn); k/fn < hi; k++ {
+		a, c := math.Max(lo, k/fn), math.Min(hi, (k+1)/fn)
+		if a >= c {
+			continue
+		}
+
+		for i, t := range nodes {
+			sum += weights[i] * (c - a) / 2 * raoSpacingDensity(n, (a+c)/2+(c-a)/2*t, m, b)
+		}
+	}
+
+	return math.Max(0, math.Min(1, sum))
+}
+
+// Density at w of U/2π for n uniform angles. With j of the n arcs longer than 2π/n, their excess and
+// the shortfall of the others are both w, which gives
+// (n-1)! Σⱼ C(n, j) w^{j-1}/(j-1)! n^{-(n-j-1)} Mₙ₋ⱼ(nw) for Mₖ the Irwin–Hall density of the sum of k
+// standard uniforms. m and b are of length n, for the values of Mₖ, from the recursion of Cox and
+// de Boor that keeps them positive.
+func raoSpacingDensity(n int, w float64, m, b []float64) float64 {
+	x := float64(n) * w
+	for i := range b {
+		b[i] = 0
+		if y := x - float64(i); y >= 0 && y < 1 {
+			b[i] = 1
+		}
+	}
+
+	m[1] = b[0]
+	for k := 2; k < n; k++ {
+		fk := float64(k)
+		for i := 0; i < n-k; i++ {
+			y := x - float64(i)
+			b[i] = (y*b[i] + (fk-y)*b[i+1]) / (fk - 1)
+		}
+		m[k] = b[0]
+	}
+
+	fn := float64(n)
+	lw, ln := math.Log(w), math.Log(fn)
+	var f float64
+	for j := 1; j < n; j++ {
+		k := n - j
+		if m[k] == 0 {
+			continue
+		}
+
+		fj := float64(j)
+		l := specfunc.Lngamma(fn+1) - specfunc.Lngamma(fj+1) - specfunc.Lngamma(float64(k)+1) - specfunc.Lngamma(fj) - float64(k-1)*ln + specfunc.Lngamma(fn)
+		if j > 1 {
+			l += (fj - 1) * lw
+		}
+		f += math.Exp(l) * m[k]
+	}
+
+	return f
+}
+
+// Nodes and weights of the q-point Gauss–Legendre rule on [-1, 1], by Newton's method on P_q.
+func gaussLegendre(q int) ([]float64, []float64) {
+	x, w := make([]float64, q), make([]float64, q)
+	fq := float64(q)
+	for i := 0; i < (q+1)/2; i++ {
+		z := math.Cos(math.Pi * (float64(i) + .75) / (fq + .5))
+		var dp float64
+		for it := 0; it < 100; it++ {
+			p0, p1 := 1., z
+			for k := 2.; k <= fq; k++ {
+				p0, p1 = p1, ((2*k-1)*z*p1-(k-1)*p0)/k
+			}
+
+			dp = fq * (z*p1 - p0) / (z*z - 1)
+			dz := p1 / dp
+			z -= dz
+			if math.Abs(dz) <= 1e-15 {
+				break
+			}
+		}
+
+		x[i], x[q-1-i] = -z, z
+		w[i] = 2 / ((1 - z*z) * dp * dp)
+		w[q-1-i] = w[i]
+	}
+
+	return x, w
+}
+
+// WatsonWilliamsTest tests whether samples of angles within support, at least two, share their mean
+// direction, returning the statistic F = K(n - k)(Σ Rᵢ - R)/((k - 1)(n - Σ Rᵢ)) of the k samples of n
+// angles in all, with resultant lengths Rᵢ and R of the pooled sample, and its p-value from the F
+// distribution of k - 1 and n - k degrees of freedom. The correction K = 1 + 3/8κ is that of Stephens,
+// for κ estimated by maximum likelihood from Σ Rᵢ/n. The test assumes von Mises samples of a common
+// concentration, of about 1 or more.
+//
+// K. V. Mardia and P. E. Jupp, Directional Statistics, 1st ed. Wiley, 1999, §7.3.1
+func WatsonWilliamsTest(support stats.Interval, samples ...[]float64) (F, p float64) {
+	return WatsonWilliamsTestContext(context.Background(), support, samples...)
+}
+
+// WatsonWilliamsTestContext is WatsonWilliamsTest raising the errors of the sample under the err.Config
+// carried by ctx, if any.
+func WatsonWilliamsTestContext(ctx context.Context, support stats.Interval, samples ...[]float64) (F, p float64) {
+	k := len(samples)
+	var n, sumR, c, s float64
+	for _, x := range samples {
+		if !checkSample(err.FromContext(ctx), "WatsonWilliamsTest", x, 1, support) {
+			return math.NaN(), math.NaN()
+		}
+
+		ci, si := resultant(x)
+		n += float64(len(x))
+		sumR += math.Hypot(ci, si)
+		c += ci
+		s += si
+	}
+
+	if k < 2 || n <= float64(k) || !(sumR < n) {
+		return math.NaN(), math.NaN()
+	}
+
+	κ := inverseBesselRatio(2, sumR/n)
+	if κ == 0 {
+		return math.NaN(), math.NaN()
+	}
+
+	F = (1 + 3/(8*κ)) * (n - float64(k)) * (sumR - math.Hypot(c, s)) / (float64(k-1) * (n - sumR))
+	f, e := continuous.NewF(k-1, int(n)-k)
+	if e != nil {
+		return math.NaN(), math.NaN()
+	}
+
+	return F, 1 - f.Distribution(F)
+}
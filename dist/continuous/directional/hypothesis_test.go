@@ -0,0 +1,207 @@
+package directional
+
+import (
+	"context"
+	"github.com/jtejido/stats/dist/continuous"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// n angles drawn from d
+func draw(d Circular, n int) []float64 {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = d.Rand()
+	}
+
+	return x
+}
+
+func TestRayleighTest(t *testing.T) {
+	// the p-value against the expansion of the exact one to 1/n²
+	vm, _ := continuous.NewVonMisesWithSource(0, .3, continuous.DefaultCircularSupport, rand.NewSource(1))
+	x := draw(vm, 60)
+	Z, p := RayleighTest(x, continuous.DefaultCircularSupport)
+	n := float64(len(x))
+	if r := MeanResultantLength(x, continuous.DefaultCircularSupport); !closeTo(Z, n*r*r, 1e-14) {
+		t.Errorf("Mismatch. RayleighTest Z want: %v, got: %v", n*r*r, Z)
+	}
+
+	want := math.Exp(-Z) * (1 + (2*Z-Z*Z)/(4*n) - (24*Z-132*Z*Z+76*Z*Z*Z-9*Z*Z*Z*Z)/(288*n*n))
+	if math.Abs(p/want-1) > .01 {
+		t.Errorf("Mismatch. RayleighTest p want: %v, got: %v", want, p)
+	}
+}
+
+// Checks that the p-values of test over draws from d are uniform, rejecting at each level about as
+// often as they should.
+func checkCalibration(t *testing.T, name string, d Circular, n int, test func([]float64) float64) {
+	t.Helper()
+	const reps = 2000
+	var at1, at5, at50 int
+	for i := 0; i < reps; i++ {
+		p := test(draw(d, n))
+		if p < .01 {
+			at1++
+		}
+		if p < .05 {
+			at5++
+		}
+		if p < .5 {
+			at50++
+		}
+	}
+
+	got := [3]float64{float64(at1) / reps, float64(at5) / reps, float64(at50) / reps}
+	if math.Abs(got[0]-.01) > .01 || math.Abs(got[1]-.05) > .02 || math.Abs(got[2]-.5) > .05 {
+		t.Errorf("Mismatch. %s rejection rates at 1%%, 5%%, 50%% want: .01, .05, .5, got: %v", name, got)
+	}
+}
+
+func TestUniformityTests(t *testing.T) {
+	src := rand.NewSource(1)
+	u, _ := NewCircularUniformWithSource(shifted, src)
+	vm, _ := continuous.NewVonMisesWithSource(2, 1, shifted, src)
+
+	tests := []struct {
+		name string
+		test func([]float64) float64
+	}{
+		{"Rayleigh", func(x []float64) float64 { _, p := RayleighTest(x, shifted); return p }},
+		{"Kuiper", func(x []float64) float64 { _, p := KuiperTest(x, u); return p }},
+		{"WatsonU2", func(x []float64) float64 { _, p := WatsonU2Test(x, u); return p }},
+		{"RaoSpacing", func(x []float64) float64 { _, p := RaoSpacingTest(x, shifted); return p }},
+	}
+
+	for _, c := range tests {
+		checkCalibration(t, c.name, u, 30, c.test)
+
+		// and their power against a von Mises sample
+		if p := c.test(draw(vm, 100)); p > .01 {
+			t.Errorf("Mismatch. %s p-value of a von Mises sample want: below .01, got: %v", c.name, p)
+		}
+	}
+
+	// against a von Mises null, which the transformed angles make uniform
+	checkCalibration(t, "Kuiper von Mises", vm, 30, func(x []float64) float64 { _, p := KuiperTest(x, vm); return p })
+	checkCalibration(t, "WatsonU2 von Mises", vm, 30, func(x []float64) float64 { _, p := WatsonU2Test(x, vm); return p })
+
+	// Stephens' critical values of the modified statistics, to their 3 or 4 digits
+	for _, c := range []struct {
+		name    string
+		f       func(float64) float64
+		x, want float64
+	}{
+		{"Kuiper", kuiperPValue, 1.747, .05},
+		{"Kuiper", kuiperPValue, 2.001, .01},
+		{"WatsonU2", watsonU2PValue, .187, .05},
+		{"WatsonU2", watsonU2PValue, .267, .01},
+	} {
+		if got := c.f(c.x); math.Abs(got/c.want-1) > .05 {
+			t.Errorf("Mismatch. %s p-value at %v want: %v, got: %v", c.name, c.x, c.want, got)
+		}
+	}
+}
+
+func TestRaoSpacingDistribution(t *testing.T) {
+	// U/2π is uniform on [0, 1/2] for 2 angles
+	for _, w := range []float64{0, .1, .3, .5} {
+		if got := raoSpacingSurvival(2, w); !closeTo(got, 1-2*w, 1e-14) {
+			t.Errorf("Mismatch. raoSpacingSurvival(2, %v) want: %v, got: %v", w, 1-2*w, got)
+		}
+	}
+
+	// of mean (1 - 1/n)ⁿ, the integral of the survival function
+	for _, n := range []int{3, 5, 12, 40} {
+		const k = 400
+		var mean float64
+		for i := 0; i < k; i++ {
+			mean += raoSpacingSurvival(n, (float64(i)+.5)/k) / k
+		}
+
+		fn := float64(n)
+		if want := math.Pow(1-1/fn, fn); math.Abs(mean-want) > 1e-5 {
+			t.Errorf("Mismatch. Rao spacing mean of %v angles want: %v, got: %v", n, want, mean)
+		}
+	}
+
+	// and near its normal limit at the largest exact size
+	n := float64(raoSpacingExact)
+	mean, sd := math.Pow(1-1/n, n), math.Sqrt((2*math.E-5)/(math.E*math.E*n))
+	for _, z := range []float64{-1, 0, 1} {
+		want := math.Erfc(z/math.Sqrt2) / 2
+		if got := raoSpacingSurvival(raoSpacingExact, mean+z*sd); math.Abs(got-want) > .02 {
+			t.Errorf("Mismatch. Rao spacing survival at %v sd want: near %v, got: %v", z, want, got)
+		}
+	}
+}
+
+func TestWatsonWilliamsTest(t *testing.T) {
+	src := rand.NewSource(1)
+	vms := make([]*continuous.VonMises, 3)
+	for i, μ := range []float64{1, 1, 1.8} {
+		vms[i], _ = continuous.NewVonMisesWithSource(μ, 4, continuous.DefaultCircularSupport, src)
+	}
+
+	const reps = 2000
+	var at5 int
+	for i := 0; i < reps; i++ {
+		if _, p := WatsonWilliamsTest(continuous.DefaultCircularSupport, draw(vms[0], 15), draw(vms[1], 10), draw(vms[0], 20)); p < .05 {
+			at5++
+		}
+	}
+
+	if got := float64(at5) / reps; math.Abs(got-.05) > .02 {
+		t.Errorf("Mismatch. WatsonWilliamsTest rejection rate at 5%% want: .05, got: %v", got)
+	}
+
+	if _, p := WatsonWilliamsTest(continuous.DefaultCircularSupport, draw(vms[0], 30), draw(vms[2], 30)); p > .001 {
+		t.Errorf("Mismatch. WatsonWilliamsTest p-value of different means want: below .001, got: %v", p)
+	}
+
+	// F of two samples, from the resultant lengths
+	x, y := []float64{.1, .4, .2, 6.2}, []float64{.9, 1.2, .7}
+	r1, r2 := 4*MeanResultantLength(x, shifted), 3*MeanResultantLength(y, shifted)
+	r := 7 * MeanResultantLength(append(append([]float64(nil), x...), y...), shifted)
+	κ := inverseBesselRatio(2, (r1+r2)/7)
+	want := (1 + 3/(8*κ)) * 5 * (r1 + r2 - r) / (7 - r1 - r2)
+	if F, _ := WatsonWilliamsTest(shifted, x, y); !closeTo(F, want, 1e-12) {
+		t.Errorf("Mismatch. WatsonWilliamsTest F want: %v, got: %v", want, F)
+	}
+
+	if F, p := WatsonWilliamsTest(shifted, x); !math.IsNaN(F) || !math.IsNaN(p) {
+		t.Errorf("Mismatch. WatsonWilliamsTest of one sample want: NaN, got: %v, %v", F, p)
+	}
+}
+
+func TestHypothesisErrors(t *testing.T) {
+	var d err.Diagnostics
+	ctx := err.NewContext(context.Background(), &err.Config{Policy: err.PolicyCollect, Diagnostics: &d})
+
+	u, _ := NewCircularUniform(continuous.DefaultCircularSupport)
+	x := []float64{.5, 4}
+	for _, f := range []func() (float64, float64){
+		func() (float64, float64) { return RayleighTestContext(ctx, x, continuous.DefaultCircularSupport) },
+		func() (float64, float64) { return KuiperTestContext(ctx, x, u) },
+		func() (float64, float64) { return WatsonU2TestContext(ctx, x, u) },
+		func() (float64, float64) { return RaoSpacingTestContext(ctx, x, continuous.DefaultCircularSupport) },
+		func() (float64, float64) {
+			return RaoSpacingTestContext(ctx, []float64{1}, continuous.DefaultCircularSupport)
+		},
+		func() (float64, float64) {
+			return WatsonWilliamsTestContext(ctx, continuous.DefaultCircularSupport, []float64{1, 2}, x)
+		},
+	} {
+		if s, p := f(); !math.IsNaN(s) || !math.IsNaN(p) {
+			t.Errorf("Mismatch. invalid sample want: NaN, got: %v, %v", s, p)
+		}
+	}
+
+	// one for each sample with the angle 4 outside the support; a single angle is too few for
+	// RaoSpacingTest, but not an error
+	if d.Len() != 5 {
+		t.Errorf("Mismatch. errors collected want: 5, got: %v", d.Len())
+	}
+}
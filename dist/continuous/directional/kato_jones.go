@@ -0,0 +1,180 @@
+package directional
+
+import (
+	"fmt"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/dist/continuous"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/cmplx"
+	"math/rand"
+)
+
+// Kato-Jones distribution, a four-parameter family, skewed and peaked or flat at will, whose trigonometric
+// moments φₚ = γ(re^{iν})ᵖ⁻¹e^{ipμ} for p ≥ 1 have the mean direction μ and resultant length γ, with
+// re^{iν} setting the others. Its density is
+// (1 + 2γ(cos(θ - μ) - r cos ν)/(1 + r² - 2r cos(θ - μ - ν)))/2π, nonnegative when
+// (r cos ν - γ)² + (r sin ν)² ≤ (1 - γ)². It includes the wrapped Cauchy, at re^{iν} = γ, and the
+// cardioid, at r = 0.
+//
+// S. Kato and M. C. Jones, "A family of distributions on the circle with links to, and applications
+// arising from, Möbius transformation," Journal of the American Statistical Association, vol. 105,
+// no. 489, pp. 249-262, 2010.
+type KatoJones struct {
+	mean, gamma, nu, r float64        // μ, γ, ν, r
+	support            stats.Interval // any interval of length 2π
+	src                rand.Source
+}
+
+func NewKatoJones(mean, gamma, nu, r float64, support stats.Interval) (*KatoJones, error) {
+	return NewKatoJonesWithSource(mean, gamma, nu, r, support, nil)
+}
+
+func NewKatoJonesWithSource(mean, gamma, nu, r float64, support stats.Interval, src rand.Source) (*KatoJones, error) {
+	if e := checkSupport("KatoJones", support); e != nil {
+		return nil, e
+	}
+
+	kj := &KatoJones{mean, gamma, nu, r, support, src}
+	if e := validate(kj, kj.Parameters()); e != nil {
+		return nil, e
+	}
+
+	// a little room for rounding on the boundary, as at re^{iν} = γ
+	if a, b := r*math.Cos(nu)-gamma, r*math.Sin(nu); a*a+b*b > (1-gamma)*(1-gamma)*(1+1e-12) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("KatoJones: (r cos ν - γ)² + (r sin ν)² exceeds (1 - γ)² for γ = %v, ν = %v, r = %v", gamma, nu, r))
+	}
+
+	return kj, nil
+}
+
+func (kj *KatoJones) spec() (string, []float64) {
+	return "KatoJones", []float64{kj.mean, kj.gamma, kj.nu, kj.r, kj.support.Lower}
+}
+
+func (kj *KatoJones) String() string {
+	return specString(kj)
+}
+
+func (kj *KatoJones) MarshalText() ([]byte, error) {
+	return []byte(kj.String()), nil
+}
+
+func (kj *KatoJones) UnmarshalText(text []byte) error {
+	return unmarshalText(text, kj, kj.src)
+}
+
+// μ ∈ support
+// γ ∈ [0,1)
+// ν ∈ (-∞,∞)
+// r ∈ [0,1)
+func (kj *KatoJones) Parameters() stats.Limits {
+	return stats.Limits{
+		"μ": kj.support,
+		"γ": stats.Interval{0, 1, false, true},
+		"ν": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
+		"r": stats.Interval{0, 1, false, true},
+	}
+}
+
+// θ ∈ (any interval of length 2π]
+func (kj *KatoJones) Support() stats.Interval {
+	return kj.support
+}
+
+func (kj *KatoJones) Probability(θ float64) float64 {
+	if !kj.support.IsWithinInterval(θ) {
+		return 0
+	}
+
+	x, r := θ-kj.mean, kj.r
+	f := 1 + 2*kj.gamma*(math.Cos(x)-r*math.Cos(kj.nu))/(1+r*r-2*r*math.Cos(x-kj.nu))
+
+	return math.Max(0, f) / (2 * math.Pi)
+}
+
+// cdf from μ - π, at μ + x, as (x + π)/2π + γ(g(x) - g(-π))/π for the antiderivative
+// g(x) = Re[-i log(1 - ae^{-ix})/a] of Re[e^{-ix}/(1 - ae^{-ix})], a = re^{iν}
+func (kj *KatoJones) relative(x float64) float64 {
+	return (x+math.Pi)/(2*math.Pi) + kj.gamma*(kj.antiderivative(x)-kj.antiderivative(-math.Pi))/math.Pi
+}
+
+func (kj *KatoJones) antiderivative(x float64) float64 {
+	a := cmplx.Rect(kj.r, kj.nu)
+	w := cmplx.Exp(complex(0, -x))
+	if kj.r > .1 {
+		return real(-1i * cmplx.Log(1-a*w) / a)
+	}
+
+	// Re[i Σ aᵏ⁻¹wᵏ/k], where the logarithm loses the digits of a small a
+	var sum complex128
+	t := w
+	for k := 1.; cmplx.Abs(t) > 1e-17*k; k++ {
+		sum += t / complex(k, 0)
+		t *= a * w
+	}
+
+	return real(1i * sum)
+}
+
+func (kj *KatoJones) Distribution(θ float64) float64 {
+	return circularDistribution(kj.relative, kj.mean, kj.support, θ)
+}
+
+func (kj *KatoJones) Inverse(p float64) float64 {
+	sup := kj.support
+	if p <= 0 {
+		return sup.Lower
+	}
+
+	if p >= 1 {
+		return sup.Upper
+	}
+
+	x, _ := continuous.InverseWithOptions(kj.Distribution, sup.Lower, sup.Upper, p, continuous.InverseOptions{Density: kj.Probability})
+	return x
+}
+
+// CircularMean returns μ, or NaN for γ = 0, the uniform distribution.
+func (kj *KatoJones) CircularMean() float64 {
+	if kj.gamma == 0 {
+		return math.NaN()
+	}
+
+	return kj.mean
+}
+
+func (kj *KatoJones) ResultantLength() float64 {
+	return kj.gamma
+}
+
+func (kj *KatoJones) CircularVariance() float64 {
+	return 1 - kj.gamma
+}
+
+func (kj *KatoJones) CircularStdDev() float64 {
+	return circularStdDev(kj.gamma)
+}
+
+// φₚ = γ(re^{iν})ᵖ⁻¹e^{ipμ} for p ≥ 1, and φ₋ₚ its conjugate
+func (kj *KatoJones) TrigonometricMoment(p int) complex128 {
+	switch {
+	case p == 0:
+		return 1
+	case p < 0:
+		return cmplx.Conj(kj.TrigonometricMoment(-p))
+	}
+
+	fp := float64(p)
+	return cmplx.Rect(kj.gamma*math.Pow(kj.r, fp-1), (fp-1)*kj.nu+fp*kj.mean)
+}
+
+// Rand inverts the cdf at a uniform variate.
+func (kj *KatoJones) Rand() float64 {
+	var rnd *rand.Rand
+	if kj.src != nil {
+		rnd = rand.New(kj.src)
+	}
+
+	return kj.Inverse(randFloat64(rnd))
+}
@@ -0,0 +1,34 @@
+package directional
+
+import (
+	"github.com/jtejido/stats/dist/continuous"
+	"math/rand"
+	"testing"
+)
+
+func TestKatoJones(t *testing.T) {
+	cases := []struct{ μ, γ, ν, r float64 }{
+		{0, .4, 1, .3},
+		{2, .6, -.5, .55},
+		{-1, .3, 2.5, .4},
+		{1, .3, 2, .05}, // by the series for a small r
+		{0, .5, 0, .5},
+	}
+
+	for _, c := range cases {
+		kj, e := NewKatoJonesWithSource(c.μ, c.γ, c.ν, c.r, continuous.DefaultCircularSupport, rand.NewSource(1))
+		if e != nil {
+			t.Fatal(e)
+		}
+		checkCircular(t, kj)
+	}
+
+	// the wrapped Cauchy distribution at re^{iν} = γ
+	kj, _ := NewKatoJones(1, .5, 0, .5, continuous.DefaultCircularSupport)
+	wc, _ := NewWrappedCauchy(1, .5, continuous.DefaultCircularSupport)
+	for _, θ := range []float64{-3, -1, 0, 1, 2.5} {
+		if !closeTo(kj.Probability(θ), wc.Probability(θ), 1e-15) || !closeTo(kj.Distribution(θ), wc.Distribution(θ), 1e-14) {
+			t.Errorf("Mismatch. KatoJones at θ = %v want: %v, %v, got: %v, %v", θ, wc.Probability(θ), wc.Distribution(θ), kj.Probability(θ), kj.Distribution(θ))
+		}
+	}
+}
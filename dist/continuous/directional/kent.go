@@ -0,0 +1,187 @@
+package directional
+
+import (
+	"fmt"
+	"github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+)
+
+// Kent distribution, or five-parameter Fisher–Bingham (FB5), on S², of density
+// exp(κ γ₁ᵀx + β((γ₂ᵀx)² - (γ₃ᵀx)²)) / c(κ, β), with the mean direction γ₁, the major and minor axes
+// γ₂ and γ₃ = γ₁ × γ₂, and 0 ≤ 2β < κ so that it is unimodal with oval contours.
+// https://en.wikipedia.org/wiki/Kent_distribution
+type Kent struct {
+	mean, major, minor []float64 // γ₁, γ₂, γ₃
+	concentration      float64   // κ
+	ovalness           float64   // β
+	logC               float64   // log c(κ, β)
+	src                rand.Source
+}
+
+func NewKent(mean, major []float64, concentration, ovalness float64) (*Kent, error) {
+	return NewKentWithSource(mean, major, concentration, ovalness, nil)
+}
+
+func NewKentWithSource(mean, major []float64, concentration, ovalness float64, src rand.Source) (*Kent, error) {
+	if len(mean) != 3 {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("Kent: mean %v is not of dimension 3", mean))
+	}
+
+	axes, e := orthonormal("Kent", mean, major)
+	if e != nil {
+		return nil, e
+	}
+
+	if !(concentration >= 0) || math.IsInf(concentration, 1) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("Kent: concentration (κ) = %v is outside [0, ∞)", concentration))
+	}
+
+	if !(ovalness >= 0) || (ovalness > 0 && !(2*ovalness < concentration)) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("Kent: ovalness (β) = %v is outside [0, κ/2)", ovalness))
+	}
+
+	γ1, γ2 := axes[0], axes[1]
+	γ3 := []float64{
+		γ1[1]*γ2[2] - γ1[2]*γ2[1],
+		γ1[2]*γ2[0] - γ1[0]*γ2[2],
+		γ1[0]*γ2[1] - γ1[1]*γ2[0],
+	}
+
+	return &Kent{γ1, γ2, γ3, concentration, ovalness, kentLogC(concentration, ovalness), src}, nil
+}
+
+// log c(κ, β) = log 2π Σⱼ Γ(j+½)/Γ(j+1) β²ʲ (κ/2)^{-2j-½} I_{2j+½}(κ), summed in logs.
+func kentLogC(κ, β float64) float64 {
+	if κ == 0 {
+		return math.Log(4 * math.Pi)
+	}
+
+	lb, lk := math.Log(β), math.Log(κ/2)
+	sum := math.Inf(-1)
+	for j := 0; j < 100000; j++ {
+		fj := float64(j)
+		t := specfunc.Lngamma(fj+.5) - specfunc.Lngamma(fj+1) - (2*fj+.5)*lk + logBesselI(2*fj+.5, κ)
+		if j > 0 {
+			t += 2 * fj * lb
+		}
+
+		if math.IsInf(t, -1) || (j > 0 && t < sum-40) {
+			break
+		}
+		sum = logAdd(sum, t)
+	}
+
+	return math.Log(2*math.Pi) + sum
+}
+
+// log(eᵃ + eᵇ)
+func logAdd(a, b float64) float64 {
+	if a < b {
+		a, b = b, a
+	}
+
+	if math.IsInf(b, -1) {
+		return a
+	}
+
+	return a + math.Log1p(math.Exp(b-a))
+}
+
+func (k *Kent) Dimension() int {
+	return 3
+}
+
+// γ₁
+func (k *Kent) Mean() []float64 {
+	return append([]float64(nil), k.mean...)
+}
+
+// γ₂
+func (k *Kent) Major() []float64 {
+	return append([]float64(nil), k.major...)
+}
+
+// γ₃
+func (k *Kent) Minor() []float64 {
+	return append([]float64(nil), k.minor...)
+}
+
+func (k *Kent) Concentration() float64 {
+	return k.concentration
+}
+
+func (k *Kent) Ovalness() float64 {
+	return k.ovalness
+}
+
+func (k *Kent) Probability(x []float64) float64 {
+	return math.Exp(k.LogProbability(x))
+}
+
+func (k *Kent) LogProbability(x []float64) float64 {
+	if !onSphere(x, 3) {
+		return math.Inf(-1)
+	}
+
+	return k.exponent(dot(k.mean, x), dot(k.major, x), dot(k.minor, x)) - k.logC
+}
+
+func (k *Kent) exponent(x1, x2, x3 float64) float64 {
+	return k.concentration*x1 + k.ovalness*(x2*x2-x3*x3)
+}
+
+// Rand follows Kent, Ganeiber and Mardia (2018), "A new unified approach for the simulation of a wide
+// class of directional distributions". Below κ = 1 it draws uniformly from the sphere and keeps a draw
+// in proportion to its density over its bound e^κ. Otherwise it draws y from the Lambert equal-area
+// projection about γ₁, in which the density is exp(-(κ-2β)y₁²/2 - βy₁⁴/4 - (κ+2β)y₂²/2 + βy₂⁴/4) on
+// |y| ≤ 2, from normal envelopes of y₁ and y₂.
+func (k *Kent) Rand() []float64 {
+	var rnd *rand.Rand
+	if k.src != nil {
+		rnd = rand.New(k.src)
+	}
+
+	κ, β := k.concentration, k.ovalness
+	if κ < 1 {
+		x := make([]float64, 3)
+		for {
+			for i := range x {
+				x[i] = randNormal(rnd)
+			}
+
+			if dot(x, x) > 0 {
+				x = normalize(x)
+				if math.Log(randFloat64(rnd)) <= k.exponent(dot(k.mean, x), dot(k.major, x), dot(k.minor, x))-κ {
+					return x
+				}
+			}
+		}
+	}
+
+	a := κ - 2*β
+	c := (math.Sqrt(a*a+4*β) - a) / 4
+	s1, s2 := 1/math.Sqrt(a+2*c), 1/math.Sqrt(κ)
+	bound := c / (2*a + 4*c)
+	for {
+		y1, y2 := s1*randNormal(rnd), s2*randNormal(rnd)
+		r2 := y1*y1 + y2*y2
+		if r2 > 4 {
+			continue
+		}
+
+		q1, q2 := y1*y1, y2*y2
+		if math.Log(randFloat64(rnd)) > c*q1-β*q1*q1/4-bound-β*q2+β*q2*q2/4 {
+			continue
+		}
+
+		w, s := 1-r2/2, math.Sqrt(1-r2/4)
+		x := make([]float64, 3)
+		for i := range x {
+			x[i] = w*k.mean[i] + s*(y1*k.major[i]+y2*k.minor[i])
+		}
+
+		return x
+	}
+}
@@ -0,0 +1,66 @@
+package directional
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestKent(t *testing.T) {
+	γ1 := []float64{0, .6, .8}
+	γ2 := []float64{1, 0, 0}
+
+	// the von Mises–Fisher distribution at β = 0
+	for _, κ := range []float64{0, 3, 50} {
+		k, _ := NewKent(γ1, γ2, κ, 0)
+		vmf, _ := NewVonMisesFisher(γ1, κ)
+		for _, x := range [][]float64{{1, 0, 0}, {0, .8, -.6}, γ1} {
+			if want, got := vmf.Probability(x), k.Probability(x); !closeTo(got, want, 1e-12) {
+				t.Errorf("Mismatch. Kent(%v, 0) Probability(%v) want: %v, got: %v", κ, x, want, got)
+			}
+		}
+	}
+
+	if k, _ := NewKent(γ1, γ2, 4, 1); !closeTo(dot(k.Minor(), []float64{0, .8, -.6}), 1, 1e-15) {
+		t.Errorf("Mismatch. Kent minor axis want: %v, got: %v", []float64{0, .8, -.6}, k.Minor())
+	}
+
+	for _, c := range []struct{ κ, β float64 }{{.5, .2}, {2, .5}, {10, 4}, {60, 29}} {
+		k, _ := NewKent(γ1, γ2, c.κ, c.β)
+		if got := integrateSphere(k.Probability); !closeTo(got, 1, 1e-8) {
+			t.Errorf("Mismatch. Kent(%v, %v) mass want: 1, got: %v", c.κ, c.β, got)
+		}
+	}
+}
+
+func TestKentRand(t *testing.T) {
+	const n = 20000
+	γ1 := []float64{0, .6, .8}
+	γ2 := []float64{1, 0, 0}
+	for _, c := range []struct{ κ, β float64 }{{.5, .2}, {2, .5}, {10, 4}, {60, 29}} {
+		k, _ := NewKentWithSource(γ1, γ2, c.κ, c.β, rand.NewSource(1))
+
+		// E[γ₁ᵀx] and E[(γ₂ᵀx)² - (γ₃ᵀx)²]
+		want1 := integrateSphere(func(x []float64) float64 { return dot(γ1, x) * k.Probability(x) })
+		want2 := integrateSphere(func(x []float64) float64 {
+			a, b := dot(γ2, x), dot(k.minor, x)
+			return (a*a - b*b) * k.Probability(x)
+		})
+
+		var got1, got2 float64
+		for i := 0; i < n; i++ {
+			x := k.Rand()
+			if !onSphere(x, 3) {
+				t.Fatalf("Mismatch. Kent Rand want: a unit vector, got: %v", x)
+			}
+
+			a, b := dot(γ2, x), dot(k.minor, x)
+			got1 += dot(γ1, x) / n
+			got2 += (a*a - b*b) / n
+		}
+
+		if math.Abs(got1-want1) > .015 || math.Abs(got2-want2) > .015 {
+			t.Errorf("Mismatch. Kent(%v, %v) sample moments want: %v, %v, got: %v, %v", c.κ, c.β, want1, want2, got1, got2)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package directional
+
+import (
+	"github.com/jtejido/stats/dist/continuous"
+	"math/rand"
+	"testing"
+)
+
+func TestSineSkewedVonMises(t *testing.T) {
+	cases := []struct{ μ, κ, λ float64 }{
+		{0, 2, .5},
+		{2, .5, -1},
+		{-1, 8, .9},
+		{3, 1, 0},
+	}
+
+	for _, c := range cases {
+		ss, _ := NewSineSkewedVonMisesWithSource(c.μ, c.κ, c.λ, continuous.DefaultCircularSupport, rand.NewSource(1))
+		checkCircular(t, ss)
+	}
+
+	// the von Mises distribution at λ = 0
+	ss, _ := NewSineSkewedVonMises(1, 2, 0, shifted)
+	vm, _ := continuous.NewVonMises(1, 2, shifted)
+	checkCircular(t, vm)
+	for _, θ := range []float64{.5, 1, 3, 6} {
+		if !closeTo(ss.Distribution(θ), vm.Distribution(θ), 1e-15) {
+			t.Errorf("Mismatch. SineSkewedVonMises Distribution(%v) want: %v, got: %v", θ, vm.Distribution(θ), ss.Distribution(θ))
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package directional
+
+import (
+	"fmt"
+	"github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+)
+
+// Spherical is a distribution of unit vectors of ℝᵖ, on the sphere Sᵖ⁻¹, whose density is taken with
+// respect to its surface measure. Vectors off the sphere have density 0.
+type Spherical interface {
+	// p
+	Dimension() int
+
+	Probability(x []float64) float64
+	LogProbability(x []float64) float64
+	Rand() []float64
+}
+
+// How far off 1 the length of a vector of the sphere may be.
+const unitTol = 1e-9
+
+func dot(x, y []float64) float64 {
+	var s float64
+	for i := range x {
+		s += x[i] * y[i]
+	}
+
+	return s
+}
+
+// Whether x is a unit vector of ℝᵖ.
+func onSphere(x []float64, p int) bool {
+	return len(x) == p && math.Abs(math.Sqrt(dot(x, x))-1) <= unitTol
+}
+
+// Copies of the vectors axes of ℝᵖ, p > 1, checked to be orthonormal.
+func orthonormal(name string, axes ...[]float64) ([][]float64, error) {
+	p := len(axes[0])
+	if p < 2 {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("%s: dimension %v is below 2", name, p))
+	}
+
+	r := make([][]float64, len(axes))
+	for i, a := range axes {
+		if len(a) != p {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s: axis %v is not of dimension %v", name, a, p))
+		}
+
+		for _, v := range a {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return nil, err.New(err.EINVAL, fmt.Sprintf("%s: axis %v is not finite", name, a))
+			}
+		}
+
+		for j := 0; j <= i; j++ {
+			want := 0.
+			if j == i {
+				want = 1
+			}
+
+			if math.Abs(dot(a, axes[j])-want) > unitTol {
+				return nil, err.New(err.EINVAL, fmt.Sprintf("%s: axes %v are not orthonormal", name, axes))
+			}
+		}
+		r[i] = append([]float64(nil), a...)
+	}
+
+	return r, nil
+}
+
+// log of the area 2π^{p/2}/Γ(p/2) of Sᵖ⁻¹
+func logSphereArea(p int) float64 {
+	h := float64(p) / 2
+	return math.Log(2) + h*math.Log(math.Pi) - specfunc.Lngamma(h)
+}
+
+// log Iᵥ(x) for x > 0, by Debye's uniform expansion to the fourth term where √(ν² + x²) is large,
+// which holds for a small ν as well as a large one, and from Iᵥ itself elsewhere.
+func logBesselI(ν, x float64) float64 {
+	if x == 0 {
+		if ν == 0 {
+			return 0
+		}
+		return math.Inf(-1)
+	}
+
+	if ν < 50 && x < 500 {
+		if v := specfunc.Bessel_Inu(ν, x); v > 0 && !math.IsInf(v, 1) {
+			return math.Log(v)
+		}
+	}
+
+	// with t = ν/s and q = 1/s, uₖ(t)/νᵏ is qᵏ times a polynomial in t²
+	s := math.Hypot(ν, x)
+	t2, q := ν*ν/(s*s), 1/s
+	u1 := q * (3 - 5*t2) / 24
+	u2 := q * q * (81 + t2*(-462+t2*385)) / 1152
+	u3 := q * q * q * (30375 + t2*(-369603+t2*(765765-t2*425425))) / 414720
+	u4 := q * q * q * q * (4465125 + t2*(-94121676+t2*(349922430+t2*(-446185740+t2*185910725)))) / 39813120
+
+	return s + ν*math.Log(x/(ν+s)) - math.Log(2*math.Pi*s)/2 + math.Log1p(u1+u2+u3+u4)
+}
+
+func randNormal(rnd *rand.Rand) float64 {
+	if rnd != nil {
+		return rnd.NormFloat64()
+	}
+
+	return rand.NormFloat64()
+}
+
+// x/|x|
+func normalize(x []float64) []float64 {
+	n := math.Sqrt(dot(x, x))
+	for i := range x {
+		x[i] /= n
+	}
+
+	return x
+}
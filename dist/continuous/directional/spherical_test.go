@@ -0,0 +1,110 @@
+package directional
+
+import (
+	"github.com/jtejido/ggsl/specfunc"
+	"math"
+	"testing"
+)
+
+var (
+	_ Spherical = (*VonMisesFisher)(nil)
+	_ Spherical = (*Kent)(nil)
+	_ Spherical = (*Bingham)(nil)
+	_ Spherical = (*Watson)(nil)
+)
+
+// ∫f(x)dx over S² by Simpson's rule in the polar angle and the trapezoidal rule in the azimuth.
+func integrateSphere(f func(x []float64) float64) float64 {
+	const n = 400
+	h, k := math.Pi/n, 2*math.Pi/n
+	var sum float64
+	for i := 0; i <= n; i++ {
+		w := 2.
+		if i == 0 || i == n {
+			w = 1
+		} else if i%2 == 1 {
+			w = 4
+		}
+
+		s, c := math.Sincos(float64(i) * h)
+		for j := 0; j < n; j++ {
+			sa, ca := math.Sincos(float64(j) * k)
+			sum += w * s * f([]float64{s * ca, s * sa, c})
+		}
+	}
+
+	return sum * h / 3 * k
+}
+
+// ∫f(t)dx over Sᵖ⁻¹ for f of t = μᵀx alone, which is |Sᵖ⁻²| ∫f(t)(1-t²)^{(p-3)/2}dt, by Simpson's rule in
+// t = cos θ.
+func integrateAxial(p int, f func(t float64) float64) float64 {
+	const n = 20000
+	h := math.Pi / n
+	var sum float64
+	for i := 0; i <= n; i++ {
+		w := 2.
+		if i == 0 || i == n {
+			w = 1
+		} else if i%2 == 1 {
+			w = 4
+		}
+
+		s, c := math.Sincos(float64(i) * h)
+		sum += w * f(c) * math.Pow(s, float64(p-2))
+	}
+
+	return math.Exp(logSphereArea(p-1)) * sum * h / 3
+}
+
+func TestLogBesselI(t *testing.T) {
+	cases := []struct{ ν, x float64 }{
+		{0, 1}, {.5, 30}, {3, 200}, {49, 60}, {50, 60}, {80, 10}, {200, 300}, {10.5, 499}, {10.5, 501},
+	}
+
+	for _, c := range cases {
+		want := math.Log(specfunc.Bessel_Inu(c.ν, c.x))
+		if got := logBesselI(c.ν, c.x); !closeTo(got, want, 1e-9) {
+			t.Errorf("Mismatch. logBesselI(%v, %v) want: %v, got: %v", c.ν, c.x, want, got)
+		}
+	}
+
+	// I_½(x) = √(2/πx) sinh x
+	for _, x := range []float64{600, 1e4} {
+		want := math.Log(2/(math.Pi*x))/2 + x - math.Log(2) + math.Log1p(-math.Exp(-2*x))
+		if got := logBesselI(.5, x); !closeTo(got, want, 1e-12) {
+			t.Errorf("Mismatch. logBesselI(.5, %v) want: %v, got: %v", x, want, got)
+		}
+	}
+}
+
+func TestSphericalErrors(t *testing.T) {
+	x, y := []float64{1, 0, 0}, []float64{0, 1, 0}
+	for i, c := range []func() (interface{}, error){
+		func() (interface{}, error) { return NewVonMisesFisher([]float64{1}, 1) },
+		func() (interface{}, error) { return NewVonMisesFisher([]float64{1, 1}, 1) },
+		func() (interface{}, error) { return NewVonMisesFisher(x, -1) },
+		func() (interface{}, error) { return NewVonMisesFisher(x, math.Inf(1)) },
+		func() (interface{}, error) { return NewVonMisesFisherFromSample(nil) },
+		func() (interface{}, error) { return NewVonMisesFisherFromSample([][]float64{x, {-1, 0, 0}}) },
+		func() (interface{}, error) { return NewVonMisesFisherFromSample([][]float64{x, x}) },
+		func() (interface{}, error) { return NewVonMisesFisherFromSample([][]float64{x, {1, 0}}) },
+		func() (interface{}, error) { return NewKent([]float64{1, 0}, []float64{0, 1}, 2, .5) },
+		func() (interface{}, error) { return NewKent(x, x, 2, .5) },
+		func() (interface{}, error) { return NewKent(x, y, 2, 1) },
+		func() (interface{}, error) { return NewKent(x, y, 2, -1) },
+		func() (interface{}, error) { return NewBingham(nil, nil) },
+		func() (interface{}, error) { return NewBingham([][]float64{x, y}, []float64{1}) },
+		func() (interface{}, error) { return NewBingham([][]float64{x, {0, 1}}, []float64{1, 2}) },
+		func() (interface{}, error) { return NewBingham([][]float64{x}, []float64{math.NaN()}) },
+		func() (interface{}, error) { return NewWatson(x, math.Inf(-1)) },
+		func() (interface{}, error) { return NewWatson([]float64{.6, .6}, 1) },
+		func() (interface{}, error) { return NewBivariateVonMises(TorusModel(2), 0, 0, 1, 1, 1) },
+		func() (interface{}, error) { return NewBivariateVonMises(SineModel, 0, 0, -1, 1, 1) },
+		func() (interface{}, error) { return NewBivariateVonMises(CosineModel, 0, math.NaN(), 1, 1, 1) },
+	} {
+		if _, e := c(); e == nil {
+			t.Errorf("Mismatch. case %d want: error, got: nil", i)
+		}
+	}
+}
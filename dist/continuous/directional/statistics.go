@@ -0,0 +1,130 @@
+package directional
+
+import (
+	"context"
+	"fmt"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/err"
+	"math"
+)
+
+// Whether x is a sample of at least min angles within support, an interval of length 2π. Angles
+// outside it raise EDOM, and a support of another length EINVAL, under c.
+func checkSample(c *err.Config, name string, x []float64, min int, support stats.Interval) bool {
+	if e := checkSupport(name, support); e != nil {
+		c.Error(e.Error(), err.EINVAL)
+		return false
+	}
+
+	for _, v := range x {
+		if !support.IsWithinInterval(v) {
+			c.Error(fmt.Sprintf("%s: angle %v is outside %v", name, v, support), err.EDOM)
+			return false
+		}
+	}
+
+	return len(x) >= min
+}
+
+// Σ cos xᵢ and Σ sin xᵢ
+func resultant(x []float64) (float64, float64) {
+	var c, s float64
+	for _, v := range x {
+		sv, cv := math.Sincos(v)
+		c += cv
+		s += sv
+	}
+
+	return c, s
+}
+
+// The angle of the resultant (c, s) of n angles in support, NaN if it is within rounding error of 0.
+func direction(c, s float64, n int, support stats.Interval) float64 {
+	if math.Hypot(c, s) <= 1e-12*float64(n) {
+		return math.NaN()
+	}
+
+	return meanDirection(complex(c, s), support)
+}
+
+// MeanDirection returns the direction of the resultant of the angles x within support, the angle of
+// (Σ cos xᵢ, Σ sin xᵢ), in support, and NaN if the resultant is 0.
+func MeanDirection(x []float64, support stats.Interval) float64 {
+	return MeanDirectionContext(context.Background(), x, support)
+}
+
+// MeanDirectionContext is MeanDirection raising the errors of the sample under the err.Config carried by
+// ctx, if any.
+func MeanDirectionContext(ctx context.Context, x []float64, support stats.Interval) float64 {
+	if !checkSample(err.FromContext(ctx), "MeanDirection", x, 1, support) {
+		return math.NaN()
+	}
+
+	c, s := resultant(x)
+	return direction(c, s, len(x), support)
+}
+
+// MeanResultantLength returns R̄ = |(Σ cos xᵢ, Σ sin xᵢ)|/n of the angles x within support, from 0 for
+// angles spread evenly to 1 for a single direction.
+func MeanResultantLength(x []float64, support stats.Interval) float64 {
+	return MeanResultantLengthContext(context.Background(), x, support)
+}
+
+// MeanResultantLengthContext is MeanResultantLength raising the errors of the sample under the err.Config
+// carried by ctx, if any.
+func MeanResultantLengthContext(ctx context.Context, x []float64, support stats.Interval) float64 {
+	if !checkSample(err.FromContext(ctx), "MeanResultantLength", x, 1, support) {
+		return math.NaN()
+	}
+
+	c, s := resultant(x)
+	return math.Hypot(c, s) / float64(len(x))
+}
+
+// AngularDeviation returns √(2(1 - R̄)) of the angles x within support, the circular analogue of the
+// standard deviation of Batschelet, within [0, √2].
+func AngularDeviation(x []float64, support stats.Interval) float64 {
+	return AngularDeviationContext(context.Background(), x, support)
+}
+
+// AngularDeviationContext is AngularDeviation raising the errors of the sample under the err.Config
+// carried by ctx, if any.
+func AngularDeviationContext(ctx context.Context, x []float64, support stats.Interval) float64 {
+	return math.Sqrt(2 * (1 - MeanResultantLengthContext(ctx, x, support)))
+}
+
+// CircularMedian returns the angle of x, within support, minimising the mean arc length Σ|xᵢ - θ|/n to
+// the others. This splits the sample in two by a diameter, with at most half of it on either side. When
+// several angles minimise it, as the middle two of an even sample do, it returns their mean direction.
+//
+// N. I. Fisher, Statistical Analysis of Circular Data. Cambridge University Press, 1993, §2.3.2
+func CircularMedian(x []float64, support stats.Interval) float64 {
+	return CircularMedianContext(context.Background(), x, support)
+}
+
+// CircularMedianContext is CircularMedian raising the errors of the sample under the err.Config carried
+// by ctx, if any.
+func CircularMedianContext(ctx context.Context, x []float64, support stats.Interval) float64 {
+	if !checkSample(err.FromContext(ctx), "CircularMedian", x, 1, support) {
+		return math.NaN()
+	}
+
+	d := make([]float64, len(x))
+	best := math.Inf(1)
+	for i, θ := range x {
+		for _, v := range x {
+			d[i] += math.Abs(relative(v, θ))
+		}
+		best = math.Min(best, d[i])
+	}
+
+	var tied []float64
+	for i, v := range x {
+		if d[i] <= best+1e-12*float64(len(x)) {
+			tied = append(tied, v)
+		}
+	}
+
+	c, s := resultant(tied)
+	return direction(c, s, len(tied), support)
+}
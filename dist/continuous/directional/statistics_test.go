@@ -0,0 +1,98 @@
+package directional
+
+import (
+	"context"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/dist/continuous"
+	"github.com/jtejido/stats/err"
+	"math"
+	"testing"
+)
+
+func TestSampleStatistics(t *testing.T) {
+	def := continuous.DefaultCircularSupport
+	cases := []struct {
+		x                 []float64
+		support           stats.Interval
+		mean, r, dev, med float64
+	}{
+		{[]float64{0, math.Pi / 2}, def, math.Pi / 4, math.Sqrt2 / 2, math.Sqrt(2 - math.Sqrt2), math.Pi / 4},
+		{[]float64{.1, .3, .2}, def, .2, (1 + 2*math.Cos(.1)) / 3, math.Sqrt(2 * (1 - (1+2*math.Cos(.1))/3)), .2},
+		{[]float64{0, 1, 2, 3}, def, 1.5, math.Hypot(1+math.Cos(1)+math.Cos(2)+math.Cos(3), math.Sin(1)+math.Sin(2)+math.Sin(3)) / 4, math.NaN(), 1.5},
+		{[]float64{6, .2, .3}, shifted, math.NaN(), math.NaN(), math.NaN(), .2},
+		{[]float64{-3, 3}, def, -math.Pi, -math.Cos(3), math.Sqrt(2 * (1 + math.Cos(3))), -math.Pi},
+	}
+
+	for _, c := range cases {
+		if got := MeanDirection(c.x, c.support); !math.IsNaN(c.mean) && !closeTo(got, c.mean, 1e-14) {
+			t.Errorf("Mismatch. MeanDirection(%v) want: %v, got: %v", c.x, c.mean, got)
+		}
+
+		if got := MeanResultantLength(c.x, c.support); !math.IsNaN(c.r) && !closeTo(got, c.r, 1e-14) {
+			t.Errorf("Mismatch. MeanResultantLength(%v) want: %v, got: %v", c.x, c.r, got)
+		}
+
+		if got := AngularDeviation(c.x, c.support); !math.IsNaN(c.dev) && !closeTo(got, c.dev, 1e-14) {
+			t.Errorf("Mismatch. AngularDeviation(%v) want: %v, got: %v", c.x, c.dev, got)
+		}
+
+		if got := CircularMedian(c.x, c.support); !math.IsNaN(c.med) && !closeTo(got, c.med, 1e-14) {
+			t.Errorf("Mismatch. CircularMedian(%v) want: %v, got: %v", c.x, c.med, got)
+		}
+	}
+
+	// the same angles from another start of the support
+	x := []float64{-2.5, -.4, .3, .9, 1.1, 3}
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = math.Mod(v+2*math.Pi, 2*math.Pi)
+	}
+
+	for _, f := range []func([]float64, stats.Interval) float64{MeanDirection, CircularMedian} {
+		a, b := f(x, def), f(y, shifted)
+		if math.Abs(relative(a, b)) > 1e-14 || !shifted.IsWithinInterval(b) {
+			t.Errorf("Mismatch. shifted support want: %v, got: %v", a, b)
+		}
+	}
+
+	if a, b := MeanResultantLength(x, def), MeanResultantLength(y, shifted); !closeTo(a, b, 1e-14) {
+		t.Errorf("Mismatch. shifted MeanResultantLength want: %v, got: %v", a, b)
+	}
+
+	// no resultant, and no sample
+	if got := MeanDirection([]float64{0, math.Pi / 2, math.Pi, -math.Pi / 2}, def); !math.IsNaN(got) {
+		t.Errorf("Mismatch. MeanDirection without resultant want: NaN, got: %v", got)
+	}
+
+	if got := CircularMedian(nil, def); !math.IsNaN(got) {
+		t.Errorf("Mismatch. CircularMedian(nil) want: NaN, got: %v", got)
+	}
+}
+
+func TestSampleStatisticsErrors(t *testing.T) {
+	var d err.Diagnostics
+	ctx := err.NewContext(context.Background(), &err.Config{Policy: err.PolicyCollect, Diagnostics: &d})
+
+	bad := stats.Interval{0, 1, false, false}
+	for _, f := range []func(context.Context, []float64, stats.Interval) float64{
+		MeanDirectionContext, MeanResultantLengthContext, AngularDeviationContext, CircularMedianContext,
+	} {
+		if got := f(ctx, []float64{4}, continuous.DefaultCircularSupport); !math.IsNaN(got) {
+			t.Errorf("Mismatch. angle outside the support want: NaN, got: %v", got)
+		}
+
+		if got := f(ctx, []float64{.5}, bad); !math.IsNaN(got) {
+			t.Errorf("Mismatch. support of length 1 want: NaN, got: %v", got)
+		}
+	}
+
+	if d.Len() != 8 {
+		t.Errorf("Mismatch. errors collected want: 8, got: %v", d.Len())
+	}
+
+	for _, r := range d.List() {
+		if r.Errno != err.EDOM && r.Errno != err.EINVAL {
+			t.Errorf("Mismatch. errno of %q want: EDOM or EINVAL, got: %v", r.Reason, r.Errno)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package directional
+
+import (
+	"github.com/jtejido/stats"
+	"math"
+	"math/rand"
+)
+
+// Uniform distribution on the circle, of no mean direction
+// https://en.wikipedia.org/wiki/Circular_uniform_distribution
+type CircularUniform struct {
+	support stats.Interval // any interval of length 2π
+	src     rand.Source
+}
+
+func NewCircularUniform(support stats.Interval) (*CircularUniform, error) {
+	return NewCircularUniformWithSource(support, nil)
+}
+
+func NewCircularUniformWithSource(support stats.Interval, src rand.Source) (*CircularUniform, error) {
+	if e := checkSupport("CircularUniform", support); e != nil {
+		return nil, e
+	}
+
+	return &CircularUniform{support, src}, nil
+}
+
+func (u *CircularUniform) spec() (string, []float64) {
+	return "CircularUniform", []float64{u.support.Lower}
+}
+
+func (u *CircularUniform) String() string {
+	return specString(u)
+}
+
+func (u *CircularUniform) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *CircularUniform) UnmarshalText(text []byte) error {
+	return unmarshalText(text, u, u.src)
+}
+
+// θ ∈ (any interval of length 2π]
+func (u *CircularUniform) Support() stats.Interval {
+	return u.support
+}
+
+func (u *CircularUniform) Probability(θ float64) float64 {
+	if !u.support.IsWithinInterval(θ) {
+		return 0
+	}
+
+	return 1 / (2 * math.Pi)
+}
+
+func (u *CircularUniform) Distribution(θ float64) float64 {
+	switch {
+	case θ >= u.support.Upper:
+		return 1
+	case θ > u.support.Lower:
+		return (θ - u.support.Lower) / (2 * math.Pi)
+	}
+
+	return 0
+}
+
+func (u *CircularUniform) Inverse(p float64) float64 {
+	if p <= 0 {
+		return u.support.Lower
+	}
+
+	if p >= 1 {
+		return u.support.Upper
+	}
+
+	return u.support.Lower + 2*math.Pi*p
+}
+
+// CircularMean returns NaN, as no direction is preferred.
+func (u *CircularUniform) CircularMean() float64 {
+	return math.NaN()
+}
+
+func (u *CircularUniform) ResultantLength() float64 {
+	return 0
+}
+
+func (u *CircularUniform) CircularVariance() float64 {
+	return 1
+}
+
+func (u *CircularUniform) CircularStdDev() float64 {
+	return math.Inf(1)
+}
+
+// φ₀ = 1, and φₚ = 0 for p ≠ 0
+func (u *CircularUniform) TrigonometricMoment(p int) complex128 {
+	if p == 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// log 2π
+func (u *CircularUniform) Entropy() float64 {
+	return math.Log(2 * math.Pi)
+}
+
+func (u *CircularUniform) Rand() float64 {
+	var rnd *rand.Rand
+	if u.src != nil {
+		rnd = rand.New(u.src)
+	}
+
+	return u.support.Lower + 2*math.Pi*randFloat64(rnd)
+}
@@ -0,0 +1,22 @@
+package directional
+
+import (
+	"github.com/jtejido/stats/dist/continuous"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestCircularUniform(t *testing.T) {
+	u, _ := NewCircularUniformWithSource(shifted, rand.NewSource(1))
+	checkCircular(t, u)
+
+	if !math.IsNaN(u.CircularMean()) || u.ResultantLength() != 0 || !math.IsInf(u.CircularStdDev(), 1) {
+		t.Errorf("Mismatch. CircularUniform want: no mean direction, got: %v, %v, %v", u.CircularMean(), u.ResultantLength(), u.CircularStdDev())
+	}
+
+	u, _ = NewCircularUniform(continuous.DefaultCircularSupport)
+	if x := u.Distribution(0); x != .5 {
+		t.Errorf("Mismatch. CircularUniform Distribution(0) want: 0.5, got: %v", x)
+	}
+}
@@ -0,0 +1,203 @@
+package directional
+
+import (
+	"fmt"
+	"github.com/jtejido/stats/dist/continuous"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+)
+
+// von Mises–Fisher distribution on Sᵖ⁻¹, of density Cₚ(κ) exp(κ μᵀx), with
+// Cₚ(κ) = κ^{p/2-1} / ((2π)^{p/2} I_{p/2-1}(κ)). On S¹ it is continuous.VonMises.
+// https://en.wikipedia.org/wiki/Von_Mises%E2%80%93Fisher_distribution
+type VonMisesFisher struct {
+	mean          []float64 // μ, a unit vector
+	concentration float64   // κ
+	logC          float64   // log Cₚ(κ)
+	w             *continuous.Beta
+	src           rand.Source
+}
+
+func NewVonMisesFisher(mean []float64, concentration float64) (*VonMisesFisher, error) {
+	return NewVonMisesFisherWithSource(mean, concentration, nil)
+}
+
+func NewVonMisesFisherWithSource(mean []float64, concentration float64, src rand.Source) (*VonMisesFisher, error) {
+	axes, e := orthonormal("VonMisesFisher", mean)
+	if e != nil {
+		return nil, e
+	}
+
+	if !(concentration >= 0) || math.IsInf(concentration, 1) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("VonMisesFisher: concentration (κ) = %v is outside [0, ∞)", concentration))
+	}
+
+	p := float64(len(mean))
+	w, e := continuous.NewBetaWithSource((p-1)/2, (p-1)/2, src)
+	if e != nil {
+		return nil, e
+	}
+
+	r := &VonMisesFisher{mean: axes[0], concentration: concentration, w: w, src: src}
+	r.logC = -logSphereArea(len(mean))
+	if concentration > 0 {
+		h := p/2 - 1
+		r.logC = h*math.Log(concentration) - p/2*math.Log(2*math.Pi) - logBesselI(h, concentration)
+	}
+
+	return r, nil
+}
+
+// NewVonMisesFisherFromSample fits the distribution to the unit vectors x by maximum likelihood. μ is
+// the direction of their sum, and κ solves Aₚ(κ) = R̄, their mean resultant length.
+func NewVonMisesFisherFromSample(x [][]float64) (*VonMisesFisher, error) {
+	return NewVonMisesFisherFromSampleWithSource(x, nil)
+}
+
+func NewVonMisesFisherFromSampleWithSource(x [][]float64, src rand.Source) (*VonMisesFisher, error) {
+	if len(x) == 0 {
+		return nil, err.New(err.EINVAL, "VonMisesFisher: sample is empty")
+	}
+
+	p := len(x[0])
+	sum := make([]float64, p)
+	for _, v := range x {
+		if !onSphere(v, p) {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("VonMisesFisher: %v is not a unit vector of dimension %v", v, p))
+		}
+
+		for i := range v {
+			sum[i] += v[i]
+		}
+	}
+
+	r := math.Sqrt(dot(sum, sum))
+	rbar := r / float64(len(x))
+	if !(r > 0) {
+		return nil, err.New(err.EDOM, "VonMisesFisher: sample has no mean direction")
+	}
+
+	if rbar >= 1-1e-12 {
+		return nil, err.New(err.EDOM, "VonMisesFisher: sample is a single point, its concentration is unbounded")
+	}
+
+	for i := range sum {
+		sum[i] /= r
+	}
+
+	return NewVonMisesFisherWithSource(sum, inverseBesselRatio(float64(p), rbar), src)
+}
+
+// The κ at which Aₚ(κ) = r, for 0 ≤ r < 1, by Newton's method from the approximation of Banerjee et al.,
+// with A'ₚ(κ) = 1 - Aₚ² - (p-1)Aₚ/κ.
+func inverseBesselRatio(p, r float64) float64 {
+	if r == 0 {
+		return 0
+	}
+
+	κ := r * (p - r*r) / (1 - r*r)
+	for i := 0; i < 100; i++ {
+		a := besselRatio(p, κ)
+		next := κ - (a-r)/(1-a*a-(p-1)*a/κ)
+		if !(next > 0) {
+			next = κ / 2
+		}
+
+		if math.Abs(next-κ) <= 1e-14*κ {
+			return next
+		}
+		κ = next
+	}
+
+	return κ
+}
+
+// Aₚ(κ) = I_{p/2}(κ)/I_{p/2-1}(κ)
+func besselRatio(p, κ float64) float64 {
+	if κ == 0 {
+		return 0
+	}
+
+	return math.Exp(logBesselI(p/2, κ) - logBesselI(p/2-1, κ))
+}
+
+func (vmf *VonMisesFisher) Dimension() int {
+	return len(vmf.mean)
+}
+
+func (vmf *VonMisesFisher) Mean() []float64 {
+	return append([]float64(nil), vmf.mean...)
+}
+
+func (vmf *VonMisesFisher) Concentration() float64 {
+	return vmf.concentration
+}
+
+// E[μᵀx] = Aₚ(κ), the mean resultant length.
+func (vmf *VonMisesFisher) ResultantLength() float64 {
+	return besselRatio(float64(len(vmf.mean)), vmf.concentration)
+}
+
+func (vmf *VonMisesFisher) Probability(x []float64) float64 {
+	return math.Exp(vmf.LogProbability(x))
+}
+
+func (vmf *VonMisesFisher) LogProbability(x []float64) float64 {
+	if !onSphere(x, len(vmf.mean)) {
+		return math.Inf(-1)
+	}
+
+	return vmf.logC + vmf.concentration*dot(vmf.mean, x)
+}
+
+// Rand draws w = μᵀx by the rejection scheme of Wood (1994), "Simulation of the von Mises Fisher
+// distribution", and the rest of x uniformly from the directions orthogonal to μ.
+func (vmf *VonMisesFisher) Rand() []float64 {
+	var rnd *rand.Rand
+	if vmf.src != nil {
+		rnd = rand.New(vmf.src)
+	}
+
+	κ, p := vmf.concentration, float64(len(vmf.mean))
+	b := (p - 1) / (2*κ + math.Sqrt(4*κ*κ+(p-1)*(p-1)))
+	x0 := (1 - b) / (1 + b)
+	c := κ*x0 + (p-1)*math.Log(1-x0*x0)
+
+	var w float64
+	for {
+		z := vmf.w.Rand()
+		w = (1 - (1+b)*z) / (1 - (1-b)*z)
+		if κ*w+(p-1)*math.Log(1-x0*w)-c >= math.Log(randFloat64(rnd)) {
+			break
+		}
+	}
+
+	return tangent(rnd, vmf.mean, w)
+}
+
+// wμ + √(1 - w²)v, for v drawn uniformly from the unit vectors orthogonal to the unit vector μ.
+func tangent(rnd *rand.Rand, μ []float64, w float64) []float64 {
+	v := make([]float64, len(μ))
+	for {
+		for i := range v {
+			v[i] = randNormal(rnd)
+		}
+
+		m := dot(μ, v)
+		for i := range v {
+			v[i] -= m * μ[i]
+		}
+
+		if dot(v, v) > 0 {
+			break
+		}
+	}
+
+	s := math.Sqrt(math.Max(0, 1-w*w)) / math.Sqrt(dot(v, v))
+	for i := range v {
+		v[i] = w*μ[i] + s*v[i]
+	}
+
+	return v
+}
@@ -0,0 +1,110 @@
+package directional
+
+import (
+	"github.com/jtejido/stats/dist/continuous"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestVonMisesFisher(t *testing.T) {
+	// the von Mises distribution on S¹
+	vm, _ := continuous.NewVonMises(1, 3, continuous.DefaultCircularSupport)
+	vmf, _ := NewVonMisesFisher([]float64{math.Cos(1), math.Sin(1)}, 3)
+	for _, θ := range []float64{-2, 0, 1, 2.5} {
+		want := vm.Probability(θ)
+		if got := vmf.Probability([]float64{math.Cos(θ), math.Sin(θ)}); !closeTo(got, want, 1e-12) {
+			t.Errorf("Mismatch. VonMisesFisher p = 2 Probability(%v) want: %v, got: %v", θ, want, got)
+		}
+	}
+
+	// κ e^{κμᵀx} / 4π sinh κ on S²
+	μ := []float64{0, .6, .8}
+	for _, κ := range []float64{0, .01, 2, 40} {
+		vmf, _ := NewVonMisesFisher(μ, κ)
+		for _, x := range [][]float64{{1, 0, 0}, {0, .8, -.6}, μ} {
+			want := 1 / (4 * math.Pi)
+			if κ > 0 {
+				want = κ * math.Exp(κ*dot(μ, x)) / (4 * math.Pi * math.Sinh(κ))
+			}
+
+			if got := vmf.Probability(x); !closeTo(got, want, 1e-12) {
+				t.Errorf("Mismatch. VonMisesFisher(%v) Probability(%v) want: %v, got: %v", κ, x, want, got)
+			}
+		}
+
+		if got := vmf.Probability([]float64{1, 1, 0}); got != 0 {
+			t.Errorf("Mismatch. VonMisesFisher Probability off the sphere want: 0, got: %v", got)
+		}
+	}
+
+	// normalised, with the mean resultant length of its density, in higher dimensions too
+	for _, c := range []struct {
+		p int
+		κ float64
+	}{{3, 5}, {5, .5}, {10, 30}, {120, 80}} {
+		μ := make([]float64, c.p)
+		μ[c.p-1] = 1
+		vmf, _ := NewVonMisesFisher(μ, c.κ)
+		x := make([]float64, c.p)
+		density := func(t float64) float64 {
+			x[0], x[c.p-1] = math.Sqrt(1-t*t), t
+			return vmf.Probability(x)
+		}
+
+		if got := integrateAxial(c.p, density); !closeTo(got, 1, 1e-9) {
+			t.Errorf("Mismatch. VonMisesFisher(p = %v, %v) mass want: 1, got: %v", c.p, c.κ, got)
+		}
+
+		want := integrateAxial(c.p, func(t float64) float64 { return t * density(t) })
+		if got := vmf.ResultantLength(); !closeTo(got, want, 1e-9) {
+			t.Errorf("Mismatch. VonMisesFisher(p = %v, %v) ResultantLength want: %v, got: %v", c.p, c.κ, want, got)
+		}
+	}
+}
+
+func TestVonMisesFisherRand(t *testing.T) {
+	const n = 20000
+	for _, c := range []struct {
+		p int
+		κ float64
+	}{{2, 1}, {3, 0}, {3, 10}, {5, 10}, {50, 200}} {
+		μ := make([]float64, c.p)
+		for i := range μ {
+			μ[i] = 1 / math.Sqrt(float64(c.p))
+		}
+
+		vmf, _ := NewVonMisesFisherWithSource(μ, c.κ, rand.NewSource(1))
+		x := make([][]float64, n)
+		var mean float64
+		for i := range x {
+			x[i] = vmf.Rand()
+			if !onSphere(x[i], c.p) {
+				t.Fatalf("Mismatch. VonMisesFisher Rand want: a unit vector, got: %v", x[i])
+			}
+			mean += dot(μ, x[i]) / n
+		}
+
+		if want := vmf.ResultantLength(); math.Abs(mean-want) > .01 {
+			t.Errorf("Mismatch. VonMisesFisher(p = %v, %v) sample E[μᵀx] want: %v, got: %v", c.p, c.κ, want, mean)
+		}
+
+		if c.κ == 0 {
+			continue
+		}
+
+		// and the estimates from the sample
+		fit, e := NewVonMisesFisherFromSample(x)
+		if e != nil {
+			t.Fatalf("Mismatch. NewVonMisesFisherFromSample want: nil, got: %v", e)
+		}
+
+		if got := fit.Concentration(); math.Abs(got/c.κ-1) > .03 {
+			t.Errorf("Mismatch. VonMisesFisher(p = %v) fit κ want: %v, got: %v", c.p, c.κ, got)
+		}
+
+		if got := dot(fit.Mean(), μ); got < 1-2/c.κ/math.Sqrt(n) {
+			t.Errorf("Mismatch. VonMisesFisher(p = %v, %v) fit μᵀμ̂ want: near 1, got: %v", c.p, c.κ, got)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package directional
+
+import (
+	"fmt"
+	"github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+)
+
+// Watson distribution on Sᵖ⁻¹, of density exp(κ(μᵀx)²) / (|Sᵖ⁻¹| ₁F₁(½; p/2; κ)), bipolar about ±μ
+// for κ > 0 and a girdle about the great circle orthogonal to μ for κ < 0. It is the Bingham
+// distribution with the single axis μ and λ = κ.
+// https://en.wikipedia.org/wiki/Watson_distribution
+type Watson struct {
+	mean          []float64 // μ, a unit vector
+	concentration float64   // κ
+	logC          float64
+	bingham       *Bingham
+}
+
+func NewWatson(mean []float64, concentration float64) (*Watson, error) {
+	return NewWatsonWithSource(mean, concentration, nil)
+}
+
+func NewWatsonWithSource(mean []float64, concentration float64, src rand.Source) (*Watson, error) {
+	if math.IsNaN(concentration) || math.IsInf(concentration, 0) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("Watson: concentration (κ) = %v is not finite", concentration))
+	}
+
+	b, e := NewBinghamWithSource([][]float64{mean}, []float64{concentration}, src)
+	if e != nil {
+		return nil, e
+	}
+
+	// Kummer's ₁F₁(a; b; κ) = e^κ ₁F₁(b-a; b; -κ) keeps the argument of ₁F₁ at or below 0
+	p := float64(len(mean))
+	logF := math.Log(specfunc.Hyperg_1F1(.5, p/2, concentration))
+	if concentration > 0 {
+		logF = concentration + math.Log(specfunc.Hyperg_1F1((p-1)/2, p/2, -concentration))
+	}
+
+	return &Watson{b.axes[0], concentration, logSphereArea(len(mean)) + logF, b}, nil
+}
+
+func (w *Watson) Dimension() int {
+	return len(w.mean)
+}
+
+func (w *Watson) Mean() []float64 {
+	return append([]float64(nil), w.mean...)
+}
+
+func (w *Watson) Concentration() float64 {
+	return w.concentration
+}
+
+func (w *Watson) Probability(x []float64) float64 {
+	return math.Exp(w.LogProbability(x))
+}
+
+func (w *Watson) LogProbability(x []float64) float64 {
+	if !onSphere(x, len(w.mean)) {
+		return math.Inf(-1)
+	}
+
+	t := dot(w.mean, x)
+	return w.concentration*t*t - w.logC
+}
+
+// Rand is that of the Bingham distribution.
+func (w *Watson) Rand() []float64 {
+	return w.bingham.Rand()
+}
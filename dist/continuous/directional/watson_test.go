@@ -0,0 +1,60 @@
+package directional
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestWatson(t *testing.T) {
+	for _, c := range []struct {
+		p int
+		κ float64
+	}{{3, 4}, {3, -6}, {4, .5}, {6, 8}, {6, -2}} {
+		μ := make([]float64, c.p)
+		μ[0] = 1
+		w, _ := NewWatson(μ, c.κ)
+		x := make([]float64, c.p)
+		density := func(t float64) float64 {
+			x[0], x[1] = t, math.Sqrt(1-t*t)
+			return w.Probability(x)
+		}
+
+		if got := integrateAxial(c.p, density); !closeTo(got, 1, 1e-9) {
+			t.Errorf("Mismatch. Watson(p = %v, %v) mass want: 1, got: %v", c.p, c.κ, got)
+		}
+
+		// the Bingham distribution with the single axis μ
+		b, _ := NewBingham([][]float64{μ}, []float64{c.κ})
+		for _, t0 := range []float64{-1, -.3, 0, .9} {
+			x[0], x[1] = t0, math.Sqrt(1-t0*t0)
+			if want, got := b.LogProbability(x), w.LogProbability(x); !closeTo(got, want, 1e-10) {
+				t.Errorf("Mismatch. Watson(p = %v, %v) LogProbability want: %v, got: %v", c.p, c.κ, want, got)
+			}
+		}
+	}
+}
+
+func TestWatsonRand(t *testing.T) {
+	const n = 20000
+	μ := []float64{.5, .5, .5, .5}
+	for _, κ := range []float64{-10, 1, 10} {
+		w, _ := NewWatsonWithSource(μ, κ, rand.NewSource(1))
+		want := integrateAxial(4, func(t float64) float64 {
+			return t * t * math.Exp(κ*t*t-w.logC)
+		})
+
+		var got float64
+		for i := 0; i < n; i++ {
+			x := w.Rand()
+			if !onSphere(x, 4) {
+				t.Fatalf("Mismatch. Watson Rand want: a unit vector, got: %v", x)
+			}
+			got += dot(μ, x) * dot(μ, x) / n
+		}
+
+		if math.Abs(got-want) > .015 {
+			t.Errorf("Mismatch. Watson(%v) sample E[(μᵀx)²] want: %v, got: %v", κ, want, got)
+		}
+	}
+}
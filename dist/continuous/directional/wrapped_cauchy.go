@@ -0,0 +1,154 @@
+package directional
+
+import (
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/dist/continuous"
+	smath "github.com/jtejido/stats/math"
+	"math"
+	"math/cmplx"
+	"math/rand"
+)
+
+// Wrapped Cauchy distribution
+// https://en.wikipedia.org/wiki/Wrapped_Cauchy_distribution
+type WrappedCauchy struct {
+	mean, rho float64        // μ, ρ
+	support   stats.Interval // any interval of length 2π
+	src       rand.Source
+}
+
+func NewWrappedCauchy(mean, rho float64, support stats.Interval) (*WrappedCauchy, error) {
+	return NewWrappedCauchyWithSource(mean, rho, support, nil)
+}
+
+func NewWrappedCauchyWithSource(mean, rho float64, support stats.Interval, src rand.Source) (*WrappedCauchy, error) {
+	if e := checkSupport("WrappedCauchy", support); e != nil {
+		return nil, e
+	}
+
+	r := &WrappedCauchy{mean, rho, support, src}
+	if e := validate(r, r.Parameters()); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+func (wc *WrappedCauchy) spec() (string, []float64) {
+	return "WrappedCauchy", []float64{wc.mean, wc.rho, wc.support.Lower}
+}
+
+func (wc *WrappedCauchy) String() string {
+	return specString(wc)
+}
+
+func (wc *WrappedCauchy) MarshalText() ([]byte, error) {
+	return []byte(wc.String()), nil
+}
+
+func (wc *WrappedCauchy) UnmarshalText(text []byte) error {
+	return unmarshalText(text, wc, wc.src)
+}
+
+// μ ∈ support
+// ρ ∈ [0,1)
+func (wc *WrappedCauchy) Parameters() stats.Limits {
+	return stats.Limits{
+		"μ": wc.support,
+		"ρ": stats.Interval{0, 1, false, true},
+	}
+}
+
+// θ ∈ (any interval of length 2π]
+func (wc *WrappedCauchy) Support() stats.Interval {
+	return wc.support
+}
+
+func (wc *WrappedCauchy) Probability(θ float64) float64 {
+	if !wc.support.IsWithinInterval(θ) {
+		return 0
+	}
+
+	return continuous.WrappedCauchyProbability(relative(θ, wc.mean), wc.rho)
+}
+
+// cdf from μ - π, at μ + x
+func (wc *WrappedCauchy) relative(x float64) float64 {
+	return continuous.WrappedCauchyDistribution(x, wc.rho)
+}
+
+func (wc *WrappedCauchy) Distribution(θ float64) float64 {
+	return circularDistribution(wc.relative, wc.mean, wc.support, θ)
+}
+
+// Inverse inverts the cdf in closed form.
+func (wc *WrappedCauchy) Inverse(p float64) float64 {
+	if p <= 0 {
+		return wc.support.Lower
+	}
+
+	if p >= 1 {
+		return wc.support.Upper
+	}
+
+	q := p + wc.relative(relative(wc.support.Lower, wc.mean))
+	if q >= 1 {
+		q--
+	}
+
+	x := continuous.WrappedCauchyInverse(q, wc.rho)
+	θ := smath.WrapRange(wc.mean+x, wc.support.Lower, wc.support.Upper, false)
+	if θ == wc.support.Lower && p > .5 {
+		return wc.support.Upper
+	}
+
+	return θ
+}
+
+// CircularMean returns μ, or NaN for ρ = 0, the uniform distribution.
+func (wc *WrappedCauchy) CircularMean() float64 {
+	if wc.rho == 0 {
+		return math.NaN()
+	}
+
+	return wc.mean
+}
+
+func (wc *WrappedCauchy) ResultantLength() float64 {
+	return wc.rho
+}
+
+func (wc *WrappedCauchy) CircularVariance() float64 {
+	return 1 - wc.rho
+}
+
+func (wc *WrappedCauchy) CircularStdDev() float64 {
+	return circularStdDev(wc.rho)
+}
+
+// φₚ = ρ^|p| e^{ipμ}
+func (wc *WrappedCauchy) TrigonometricMoment(p int) complex128 {
+	return complex(math.Pow(wc.rho, math.Abs(float64(p))), 0) * cmplx.Exp(complex(0, float64(p)*wc.mean))
+}
+
+func (wc *WrappedCauchy) Median() float64 {
+	return wc.mean
+}
+
+func (wc *WrappedCauchy) Mode() float64 {
+	return wc.mean
+}
+
+// -log(1 - ρ²) + log 2π
+func (wc *WrappedCauchy) Entropy() float64 {
+	return math.Log(2*math.Pi) - math.Log1p(-wc.rho*wc.rho)
+}
+
+func (wc *WrappedCauchy) Rand() float64 {
+	var rnd *rand.Rand
+	if wc.src != nil {
+		rnd = rand.New(wc.src)
+	}
+
+	return wc.Inverse(randFloat64(rnd))
+}
@@ -0,0 +1,42 @@
+package directional
+
+import (
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/dist/continuous"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestWrappedCauchy(t *testing.T) {
+	cases := []struct {
+		μ, ρ    float64
+		support stats.Interval
+	}{
+		{0, .5, continuous.DefaultCircularSupport},
+		{-2.5, .9, continuous.DefaultCircularSupport},
+		{3, 0, continuous.DefaultCircularSupport},
+		{1, .2, shifted},
+		{5, .7, shifted},
+	}
+
+	for _, c := range cases {
+		wc, _ := NewWrappedCauchyWithSource(c.μ, c.ρ, c.support, rand.NewSource(1))
+		checkCircular(t, wc)
+	}
+
+	// the Cauchy density of scale -log ρ, summed over the windings
+	wc, _ := NewWrappedCauchy(1, .6, continuous.DefaultCircularSupport)
+	γ := -math.Log(.6)
+	for _, θ := range []float64{-3, -1, 0, 1, 2, 3.1} {
+		var want float64
+		for k := -100000.; k <= 100000; k++ {
+			x := θ - 1 + 2*math.Pi*k
+			want += γ / (math.Pi * (γ*γ + x*x))
+		}
+
+		if got := wc.Probability(θ); !closeTo(got, want, 1e-6) {
+			t.Errorf("Mismatch. WrappedCauchy Probability(%v) want: %v, got: %v", θ, want, got)
+		}
+	}
+}
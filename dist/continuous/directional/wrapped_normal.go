@@ -0,0 +1,147 @@
+package directional
+
+import (
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/dist/continuous"
+	smath "github.com/jtejido/stats/math"
+	"math"
+	"math/cmplx"
+	"math/rand"
+)
+
+// Wrapped normal distribution, whose density is the Jacobi theta function
+// ϑ₃((θ-μ)/2, e^{-σ²/2})/2π. It is summed as the Fourier series of ϑ₃ for σ² ≥ 2π, and as the wrapped
+// normal densities, the series Jacobi's imaginary transformation turns it into, below, either taking a
+// handful of terms, by continuous.WrappedNormalProbability and WrappedNormalDistribution.
+// https://en.wikipedia.org/wiki/Wrapped_normal_distribution
+type WrappedNormal struct {
+	mean, sigma float64        // μ, σ
+	support     stats.Interval // any interval of length 2π
+	src         rand.Source
+}
+
+func NewWrappedNormal(mean, sigma float64, support stats.Interval) (*WrappedNormal, error) {
+	return NewWrappedNormalWithSource(mean, sigma, support, nil)
+}
+
+func NewWrappedNormalWithSource(mean, sigma float64, support stats.Interval, src rand.Source) (*WrappedNormal, error) {
+	if e := checkSupport("WrappedNormal", support); e != nil {
+		return nil, e
+	}
+
+	r := &WrappedNormal{mean, sigma, support, src}
+	if e := validate(r, r.Parameters()); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+func (wn *WrappedNormal) spec() (string, []float64) {
+	return "WrappedNormal", []float64{wn.mean, wn.sigma, wn.support.Lower}
+}
+
+func (wn *WrappedNormal) String() string {
+	return specString(wn)
+}
+
+func (wn *WrappedNormal) MarshalText() ([]byte, error) {
+	return []byte(wn.String()), nil
+}
+
+func (wn *WrappedNormal) UnmarshalText(text []byte) error {
+	return unmarshalText(text, wn, wn.src)
+}
+
+// μ ∈ support
+// σ ∈ (0,∞)
+func (wn *WrappedNormal) Parameters() stats.Limits {
+	return stats.Limits{
+		"μ": wn.support,
+		"σ": stats.Interval{0, math.Inf(1), true, true},
+	}
+}
+
+// θ ∈ (any interval of length 2π]
+func (wn *WrappedNormal) Support() stats.Interval {
+	return wn.support
+}
+
+func (wn *WrappedNormal) Probability(θ float64) float64 {
+	if !wn.support.IsWithinInterval(θ) {
+		return 0
+	}
+
+	return continuous.WrappedNormalProbability(relative(θ, wn.mean), wn.sigma)
+}
+
+// cdf from μ - π, at μ + x
+func (wn *WrappedNormal) relative(x float64) float64 {
+	return continuous.WrappedNormalDistribution(x, wn.sigma)
+}
+
+func (wn *WrappedNormal) Distribution(θ float64) float64 {
+	return circularDistribution(wn.relative, wn.mean, wn.support, θ)
+}
+
+func (wn *WrappedNormal) Inverse(p float64) float64 {
+	sup := wn.support
+	if p <= 0 {
+		return sup.Lower
+	}
+
+	if p >= 1 {
+		return sup.Upper
+	}
+
+	x, _ := continuous.InverseWithOptions(wn.Distribution, sup.Lower, sup.Upper, p, continuous.InverseOptions{Density: wn.Probability})
+	return x
+}
+
+func (wn *WrappedNormal) CircularMean() float64 {
+	return wn.mean
+}
+
+// e^{-σ²/2}
+func (wn *WrappedNormal) ResultantLength() float64 {
+	return math.Exp(-wn.sigma * wn.sigma / 2)
+}
+
+func (wn *WrappedNormal) CircularVariance() float64 {
+	return -math.Expm1(-wn.sigma * wn.sigma / 2)
+}
+
+// σ, as √(-2 log R) = σ
+func (wn *WrappedNormal) CircularStdDev() float64 {
+	return wn.sigma
+}
+
+// φₚ = e^{-p²σ²/2} e^{ipμ}
+func (wn *WrappedNormal) TrigonometricMoment(p int) complex128 {
+	fp := float64(p)
+	return complex(math.Exp(-fp*fp*wn.sigma*wn.sigma/2), 0) * cmplx.Exp(complex(0, fp*wn.mean))
+}
+
+func (wn *WrappedNormal) Median() float64 {
+	return wn.mean
+}
+
+func (wn *WrappedNormal) Mode() float64 {
+	return wn.mean
+}
+
+func (wn *WrappedNormal) Rand() float64 {
+	var rnd *rand.Rand
+	if wn.src != nil {
+		rnd = rand.New(wn.src)
+	}
+
+	var z float64
+	if rnd != nil {
+		z = rnd.NormFloat64()
+	} else {
+		z = rand.NormFloat64()
+	}
+
+	return smath.WrapRange(wn.mean+wn.sigma*z, wn.support.Lower, wn.support.Upper, false)
+}
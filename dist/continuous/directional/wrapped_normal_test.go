@@ -0,0 +1,47 @@
+package directional
+
+import (
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/dist/continuous"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestWrappedNormal(t *testing.T) {
+	// either side of σ² = 2π, where the sum switches to the Fourier series
+	cases := []struct {
+		μ, σ    float64
+		support stats.Interval
+	}{
+		{0, .3, continuous.DefaultCircularSupport},
+		{-2, 1, continuous.DefaultCircularSupport},
+		{3, 2.5, continuous.DefaultCircularSupport},
+		{1, 2.52, shifted},
+		{5, 4, shifted},
+	}
+
+	for _, c := range cases {
+		wn, _ := NewWrappedNormalWithSource(c.μ, c.σ, c.support, rand.NewSource(1))
+		checkCircular(t, wn)
+
+		// the normal density and cdf, summed over the windings
+		sup := wn.Support()
+		for _, θ := range []float64{sup.Lower + .1, sup.Lower + 2, sup.Lower + 3.5, sup.Upper - .01} {
+			var f, F float64
+			for k := -60.; k <= 60; k++ {
+				x := (θ - c.μ + 2*math.Pi*k) / c.σ
+				f += math.Exp(-x*x/2) / (c.σ * math.Sqrt(2*math.Pi))
+				F += (math.Erf(x/math.Sqrt2) - math.Erf((sup.Lower-c.μ+2*math.Pi*k)/c.σ/math.Sqrt2)) / 2
+			}
+
+			if got := wn.Probability(θ); !closeTo(got, f, 1e-14) {
+				t.Errorf("Mismatch. %v Probability(%v) want: %v, got: %v", wn, θ, f, got)
+			}
+
+			if got := wn.Distribution(θ); !closeTo(got, F, 1e-13) {
+				t.Errorf("Mismatch. %v Distribution(%v) want: %v, got: %v", wn, θ, F, got)
+			}
+		}
+	}
+}
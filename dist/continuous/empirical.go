@@ -0,0 +1,289 @@
+package continuous
+
+import (
+	"fmt"
+	gsl "github.com/jtejido/ggsl"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/err"
+	"github.com/jtejido/stats/sample"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// EmpiricalCDF selects the cdf an Empirical distribution puts on its sample.
+type EmpiricalCDF int
+
+const (
+	StepCDF   EmpiricalCDF = iota // the empirical cdf, rising by 1/n at each observation
+	LinearCDF                     // piecewise linear through the k-th smallest observation at (k-1)/(n-1)
+)
+
+// Empirical distribution of a sample, with either the step cdf of the sample or its linear interpolation,
+// whose quantiles are those of sample.InverseCDF and sample.Linear respectively. Its moments are those
+// of the chosen cdf. Rand draws from the smoothed bootstrap: an observation picked at random plus
+// normal noise of Silverman's bandwidth, shrunk towards the mean to keep the variance of the sample.
+// https://en.wikipedia.org/wiki/Empirical_distribution_function
+// B. W. Silverman, Density Estimation for Statistics and Data Analysis, Chapman and Hall, 1986, §6.4
+type Empirical struct {
+	baseContinuousWithSource
+	x                []float64 // sorted
+	cdf              EmpiricalCDF
+	mean, m2, m3, m4 float64 // mean and central moments of the cdf
+	xbar, sd, h      float64 // mean and standard deviation of the sample, bandwidth
+}
+
+func NewEmpirical(x []float64, cdf EmpiricalCDF) (*Empirical, error) {
+	return NewEmpiricalWithSource(x, cdf, nil)
+}
+
+// NewEmpiricalWithSource returns the empirical distribution of x, which needs two finite observations at
+// least. x is copied.
+func NewEmpiricalWithSource(x []float64, cdf EmpiricalCDF, src rand.Source) (*Empirical, error) {
+	if cdf != StepCDF && cdf != LinearCDF {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("Empirical: unknown cdf %v", cdf))
+	}
+
+	if len(x) < 2 {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("Empirical: %v observations are fewer than 2", len(x)))
+	}
+
+	r := new(Empirical)
+	r.x = append([]float64(nil), x...)
+	for _, v := range r.x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("Empirical: observation %v is not finite", v))
+		}
+	}
+	sort.Float64s(r.x)
+	r.cdf = cdf
+	r.src = src
+
+	n := float64(len(r.x))
+	r.xbar = sample.Mean(r.x, nil)
+	r.sd = math.Sqrt(sample.Variance(r.x, nil) * (n - 1) / n)
+	if r.sd > 0 {
+		r.h = silverman(r.x)
+	}
+	r.moments()
+
+	return r, nil
+}
+
+// Mean and central moments of the cdf, taken about the sample mean first for accuracy.
+func (e *Empirical) moments() {
+	var mu [5]float64 // raw moments about the sample mean
+	switch e.cdf {
+	case StepCDF:
+		for _, v := range e.x {
+			d := v - e.xbar
+			mu[1] += d
+			mu[2] += d * d
+			mu[3] += d * d * d
+			mu[4] += d * d * d * d
+		}
+
+		for r := range mu {
+			mu[r] /= float64(len(e.x))
+		}
+	case LinearCDF:
+		// a mixture of uniforms on the gaps, E[Yʳ] = Σ aʲbʳ⁻ʲ/(r+1) on [a,b]
+		for i := 1; i < len(e.x); i++ {
+			a, b := e.x[i-1]-e.xbar, e.x[i]-e.xbar
+			mu[1] += (a + b) / 2
+			mu[2] += (a*a + a*b + b*b) / 3
+			mu[3] += (a + b) * (a*a + b*b) / 4
+			mu[4] += (a*a*a*a + a*a*a*b + a*a*b*b + a*b*b*b + b*b*b*b) / 5
+		}
+
+		for r := range mu {
+			mu[r] /= float64(len(e.x) - 1)
+		}
+	}
+
+	m := mu[1]
+	e.mean = e.xbar + m
+	e.m2 = mu[2] - m*m
+	e.m3 = mu[3] - 3*m*mu[2] + 2*m*m*m
+	e.m4 = mu[4] - 4*m*mu[3] + 6*m*m*mu[2] - 3*m*m*m*m
+}
+
+// String describes e, but as its sample is not part of it, it is not a spec that Parse accepts.
+func (e *Empirical) String() string {
+	name := "step"
+	if e.cdf == LinearCDF {
+		name = "linear"
+	}
+
+	return fmt.Sprintf("Empirical(%d observations, %s cdf)", len(e.x), name)
+}
+
+// CDF returns the cdf e puts on its sample.
+func (e *Empirical) CDF() EmpiricalCDF {
+	return e.cdf
+}
+
+// Bandwidth returns the standard deviation of the noise Rand adds to a resampled observation, before
+// shrinking.
+func (e *Empirical) Bandwidth() float64 {
+	return e.h
+}
+
+// x ∈ [x₍₁₎,x₍ₙ₎]
+func (e *Empirical) Support() stats.Interval {
+	return stats.Interval{e.x[0], e.x[len(e.x)-1], false, false}
+}
+
+// Probability returns the density of the linearly interpolated cdf, whichever cdf e has, as the step cdf
+// has none. Tied observations, where the interpolated cdf jumps, add nothing to it.
+func (e *Empirical) Probability(x float64) float64 {
+	n := len(e.x)
+	if !e.Support().IsWithinInterval(x) || e.x[0] == e.x[n-1] {
+		return 0
+	}
+
+	i := e.below(x)
+	if i == n-1 {
+		i = sort.SearchFloat64s(e.x, x) - 1
+	}
+
+	return 1 / (float64(n-1) * (e.x[i+1] - e.x[i]))
+}
+
+// Index of the last observation at or below x, -1 if there is none.
+func (e *Empirical) below(x float64) int {
+	return sort.Search(len(e.x), func(i int) bool { return e.x[i] > x }) - 1
+}
+
+func (e *Empirical) Distribution(x float64) float64 {
+	n := len(e.x)
+	i := e.below(x)
+	switch {
+	case i < 0:
+		return 0
+	case i == n-1:
+		return 1
+	case e.cdf == StepCDF:
+		return float64(i+1) / float64(n)
+	}
+
+	return (float64(i) + (x-e.x[i])/(e.x[i+1]-e.x[i])) / float64(n-1)
+}
+
+func (e *Empirical) Inverse(p float64) float64 {
+	n := len(e.x)
+	if p <= 0 {
+		return e.x[0]
+	}
+
+	if p >= 1 {
+		return e.x[n-1]
+	}
+
+	if e.cdf == StepCDF {
+		// the first observation at which the cdf reaches p
+		k := int(math.Ceil(float64(n)*p - 4*gsl.Float64Eps*float64(n)))
+		return e.x[int(math.Max(1, float64(k)))-1]
+	}
+
+	h := float64(n-1) * p
+	i := int(h)
+	if i == n-1 {
+		return e.x[i]
+	}
+
+	return e.x[i] + (h-float64(i))*(e.x[i+1]-e.x[i])
+}
+
+// InverseSurvival is Inverse(1 - q) counted down from the largest observation, so that q keeps its digits.
+func (e *Empirical) InverseSurvival(q float64) float64 {
+	n := len(e.x)
+	if q >= 1 {
+		return e.x[0]
+	}
+
+	if q <= 0 {
+		return e.x[n-1]
+	}
+
+	if e.cdf == StepCDF {
+		// the first observation at which the survival function falls to q
+		k := int(math.Floor(float64(n)*q + 4*gsl.Float64Eps*float64(n)))
+		return e.x[int(math.Max(0, float64(n-1-k)))]
+	}
+
+	h := float64(n-1) * q
+	i := n - 1 - int(h)
+	if i == 0 {
+		return e.x[0]
+	}
+
+	return e.x[i] - (h-float64(n-1-i))*(e.x[i]-e.x[i-1])
+}
+
+func (e *Empirical) Mean() float64 {
+	return e.mean
+}
+
+func (e *Empirical) Median() float64 {
+	return e.Inverse(.5)
+}
+
+// Mode returns the half-sample mode of the sample, see sample.Mode.
+func (e *Empirical) Mode() float64 {
+	return sample.Mode(e.x, nil)
+}
+
+func (e *Empirical) Variance() float64 {
+	return e.m2
+}
+
+func (e *Empirical) Skewness() float64 {
+	return e.m3 / math.Pow(e.m2, 1.5)
+}
+
+func (e *Empirical) ExKurtosis() float64 {
+	return e.m4/(e.m2*e.m2) - 3
+}
+
+// Bootstrap returns an observation of the sample picked at random.
+func (e *Empirical) Bootstrap() float64 {
+	var rnd *rand.Rand
+	if e.src != nil {
+		rnd = rand.New(e.src)
+	}
+
+	return e.x[intn(rnd, len(e.x))]
+}
+
+// Rand returns a draw from the smoothed bootstrap, x̄ + (xᵢ - x̄ + hZ)/√(1 + h²/σ²) for an observation xᵢ
+// picked at random, a standard normal Z and the bandwidth h, which has the mean x̄ and variance σ² of the
+// sample.
+func (e *Empirical) Rand() float64 {
+	var rnd *rand.Rand
+	if e.src != nil {
+		rnd = rand.New(e.src)
+	}
+
+	x := e.x[intn(rnd, len(e.x))]
+	if e.h == 0 {
+		return x
+	}
+
+	var z float64
+	if rnd != nil {
+		z = rnd.NormFloat64()
+	} else {
+		z = rand.NormFloat64()
+	}
+
+	return e.xbar + (x-e.xbar+e.h*z)/math.Sqrt(1+e.h*e.h/(e.sd*e.sd))
+}
+
+func intn(rnd *rand.Rand, n int) int {
+	if rnd != nil {
+		return rnd.Intn(n)
+	}
+
+	return rand.Intn(n)
+}
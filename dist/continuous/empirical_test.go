@@ -0,0 +1,150 @@
+package continuous
+
+import (
+	"github.com/jtejido/stats/err"
+	"github.com/jtejido/stats/sample"
+	stattest "github.com/jtejido/stats/testing"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+var empiricalData = []float64{2.1, 5.3, 0.7, 3.9, 9.2, 4.4, 6.8, 1.5, 7.7, 3.3}
+
+func TestEmpiricalInverse(t *testing.T) {
+	step, _ := NewEmpirical(empiricalData, StepCDF)
+	linear, _ := NewEmpirical(empiricalData, LinearCDF)
+	for _, p := range []float64{0, .05, .1, .3, .35, .5, .72, .9, .99, 1} {
+		run_test(t, step.Inverse(p), sample.Quantile(p, sample.InverseCDF, empiricalData, nil), 1e-15, "Empirical step Inverse")
+		run_test(t, linear.Inverse(p), sample.Quantile(p, sample.Linear, empiricalData, nil), 1e-14, "Empirical linear Inverse")
+
+		if x := linear.Inverse(p); p > 0 && p < 1 {
+			run_test(t, linear.Distribution(x), p, 1e-14, "Empirical linear Distribution")
+		}
+
+		run_test(t, step.InverseSurvival(1-p), step.Inverse(p), 1e-15, "Empirical step InverseSurvival")
+		run_test(t, linear.InverseSurvival(1-p), linear.Inverse(p), 1e-14, "Empirical linear InverseSurvival")
+	}
+
+	// within the last gap, 7.7 to 9.2, and on either side of the survival 1/n of 7.7
+	run_test(t, linear.InverseSurvival(1e-12), 9.2-9e-12*1.5, 1e-15, "Empirical linear InverseSurvival")
+	run_test(t, step.InverseSurvival(1e-12), 9.2, 1e-15, "Empirical step InverseSurvival")
+	run_test(t, step.InverseSurvival(.0999999), 9.2, 1e-15, "Empirical step InverseSurvival")
+	run_test(t, step.InverseSurvival(.1), 7.7, 1e-15, "Empirical step InverseSurvival")
+
+	cases := []struct {
+		x, step, linear float64
+	}{
+		{0, 0, 0},
+		{.7, .1, 0},
+		{1.1, .1, 1. / 18},
+		{4.4, .6, 5. / 9},
+		{9.2, 1, 1},
+		{10, 1, 1},
+	}
+
+	for _, c := range cases {
+		run_test(t, step.Distribution(c.x), c.step, 1e-15, "Empirical step Distribution")
+		run_test(t, linear.Distribution(c.x), c.linear, 1e-15, "Empirical linear Distribution")
+	}
+}
+
+func TestEmpiricalMoments(t *testing.T) {
+	n := float64(len(empiricalData))
+	step, _ := NewEmpirical(empiricalData, StepCDF)
+	run_test(t, step.Mean(), 4.49, 1e-15, "Empirical step Mean")
+	run_test(t, step.Variance(), 7.6965555555555545*(n-1)/n, 1e-14, "Empirical step Variance")
+
+	// the adjusted skewness and kurtosis of the sample, unadjusted
+	g1 := sample.Skewness(empiricalData, nil) * (n - 2) / math.Sqrt(n*(n-1))
+	g2 := (sample.ExKurtosis(empiricalData, nil)*(n-2)*(n-3)/(n-1) - 6) / (n + 1)
+	run_test(t, step.Skewness(), g1, 1e-14, "Empirical step Skewness")
+	run_test(t, step.ExKurtosis(), g2, 1e-14, "Empirical step ExKurtosis")
+
+	// against the moments of the density, by Simpson's rule over each gap between observations
+	linear, _ := NewEmpirical(empiricalData, LinearCDF)
+	x := append([]float64(nil), empiricalData...)
+	sort.Float64s(x)
+	var m [5]float64
+	const steps = 100
+	for i := 1; i < len(x); i++ {
+		dx := (x[i] - x[i-1]) / steps
+		p := linear.Probability((x[i-1] + x[i]) / 2)
+		for k := 0; k <= steps; k++ {
+			w := 2. + 2*float64(k%2)
+			if k == 0 || k == steps {
+				w = 1
+			}
+			for r := range m {
+				m[r] += w * dx / 3 * p * math.Pow(x[i-1]+float64(k)*dx-linear.Mean(), float64(r))
+			}
+		}
+	}
+
+	run_test(t, m[0], 1, 1e-14, "Empirical linear normalization")
+	run_test(t, m[1], 0, 1e-14, "Empirical linear Mean")
+	run_test(t, m[2], linear.Variance(), 1e-12, "Empirical linear Variance")
+	run_test(t, m[3]/math.Pow(m[2], 1.5), linear.Skewness(), 1e-12, "Empirical linear Skewness")
+	run_test(t, m[4]/(m[2]*m[2])-3, linear.ExKurtosis(), 1e-12, "Empirical linear ExKurtosis")
+}
+
+func TestEmpiricalRand(t *testing.T) {
+	const n = 100000
+	e, _ := NewEmpiricalWithSource(empiricalData, StepCDF, rand.NewSource(1))
+	var m sample.Moments
+	for i := 0; i < n; i++ {
+		m.Add(e.Rand())
+	}
+
+	// the smoothed bootstrap keeps the mean and variance of the sample
+	if math.Abs(m.Mean()-e.Mean()) > 4*math.Sqrt(e.Variance()/n) || math.Abs(m.Variance()/e.Variance()-1) > .02 {
+		t.Errorf("Mismatch. Rand mean, variance want: %v, %v, got: %v, %v", e.Mean(), e.Variance(), m.Mean(), m.Variance())
+	}
+
+	for i := 0; i < 100; i++ {
+		if x := e.Bootstrap(); sample.Quantile(e.Distribution(x), sample.InverseCDF, empiricalData, nil) != x {
+			t.Errorf("Mismatch. Bootstrap() = %v is not an observation", x)
+		}
+	}
+}
+
+// The linear cdf of a larger sample passes the conformance checks, its smoothed bootstrap included.
+func TestEmpiricalConformance(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	x := make([]float64, 2000)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+
+	// the adaptive quadrature of the checks only resolves the 2000 jumps of the density to about 1e-5
+	e, _ := NewEmpiricalWithSource(x, LinearCDF, rand.NewSource(3))
+	stattest.Conformance(t, e, stattest.Config{Tol: 1e-4})
+}
+
+func TestEmpiricalTruncatedWrapped(t *testing.T) {
+	e, _ := NewEmpirical(empiricalData, LinearCDF)
+	tr, _ := NewTruncated(e, 2, 8)
+	run_test(t, tr.Distribution(5.3), (e.Distribution(5.3)-e.Distribution(2))/(e.Distribution(8)-e.Distribution(2)), 1e-15, "Truncated Empirical Distribution")
+	run_test(t, tr.Inverse(.5), e.Inverse((e.Distribution(2)+e.Distribution(8))/2), 1e-15, "Truncated Empirical Inverse")
+
+	w, _ := NewWrapped(e, 10, DefaultCircularSupport)
+	var want float64
+	for k := -3.; k <= 3; k++ {
+		want += e.Probability(1 + 2*math.Pi*k)
+	}
+	run_test(t, w.Probability(1), want, 1e-15, "Wrapped Empirical Probability")
+}
+
+func TestEmpiricalErrors(t *testing.T) {
+	cases := [][]float64{nil, {1}, {1, math.NaN()}, {1, math.Inf(1)}}
+	for _, x := range cases {
+		if _, e := NewEmpirical(x, StepCDF); e == nil || e.(err.StatsError).Status() != err.EINVAL {
+			t.Errorf("Mismatch. NewEmpirical(%v) want: EINVAL, got: %v", x, e)
+		}
+	}
+
+	if _, e := NewEmpirical(empiricalData, EmpiricalCDF(2)); e == nil {
+		t.Errorf("Mismatch. unknown cdf want: error, got: nil")
+	}
+}
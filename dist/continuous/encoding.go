@@ -0,0 +1,226 @@
+package continuous
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+var (
+	unmarshalSourceMu sync.RWMutex
+	unmarshalSource   func(name string) rand.Source
+)
+
+// SetUnmarshalSource sets f to supply the random source of distributions decoded by ParseJSON and by the
+// UnmarshalJSON and UnmarshalText methods, and returns the previous one; nil leaves them without one. f is
+// given the registered name of the outermost distribution, nested ones share its source. A distribution
+// decoded in place keeps the source it already had, and ParseJSONWithSource uses the source it is given.
+func SetUnmarshalSource(f func(name string) rand.Source) func(name string) rand.Source {
+	unmarshalSourceMu.Lock()
+	defer unmarshalSourceMu.Unlock()
+	previous := unmarshalSource
+	unmarshalSource = f
+	return previous
+}
+
+// Source for a distribution called name from the function set by SetUnmarshalSource, if any.
+func sourceFor(name string) rand.Source {
+	unmarshalSourceMu.RLock()
+	f := unmarshalSource
+	unmarshalSourceMu.RUnlock()
+	if f == nil {
+		return nil
+	}
+
+	return f(name)
+}
+
+// Implemented by every distribution of this package but those built from a sample (Empirical, KDE,
+// PiecewiseConstant and PiecewiseLinear), which have no JSON form, returning its registered name, its
+// distribution arguments and its parameters in registry order.
+type specifier interface {
+	spec() (string, []Common, []float64)
+}
+
+// JSON form of a distribution, e.g. {"type":"Truncated","dists":[{"type":"Normal",...}],"params":{"min":-2,"max":2}}
+type specJSON struct {
+	Type   string               `json:"type"`
+	Dists  []json.RawMessage    `json:"dists,omitempty"`
+	Params map[string]jsonFloat `json:"params,omitempty"`
+}
+
+// float64 that encodes ±Inf and NaN as the strings "+Inf", "-Inf" and "NaN", which JSON numbers cannot hold.
+type jsonFloat float64
+
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	s := strconv.FormatFloat(float64(f), 'g', -1, 64)
+	if math.IsInf(float64(f), 0) || math.IsNaN(float64(f)) {
+		return []byte(strconv.Quote(s)), nil
+	}
+
+	return []byte(s), nil
+}
+
+func (f *jsonFloat) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if u, e := strconv.Unquote(s); e == nil {
+		s = u
+	}
+
+	v, e := strconv.ParseFloat(s, 64)
+	if e != nil {
+		// reported by parseJSON, which wraps the decoding error
+		return err.New(err.EINVAL, fmt.Sprintf("%s is not a number", b))
+	}
+
+	*f = jsonFloat(v)
+	return nil
+}
+
+// ParseJSON builds a distribution from the JSON written by the MarshalJSON method of any registered
+// distribution, running the same validation as Parse.
+func ParseJSON(b []byte) (Common, error) {
+	return ParseJSONWithSource(b, nil)
+}
+
+// ParseJSONWithSource is ParseJSON with src handed to every distribution built, nested ones included.
+func ParseJSONWithSource(b []byte, src rand.Source) (Common, error) {
+	return parseJSON(b, src, nil)
+}
+
+// ParseJSONContext is ParseJSON under the err.Config carried by ctx, if any, which the distributions
+// built keep as those of ParseContext do. Errors in the JSON are returned like those of ParseJSON, and
+// never raised.
+func ParseJSONContext(ctx context.Context, b []byte) (Common, error) {
+	return parseJSON(b, nil, err.FromContext(ctx))
+}
+
+func parseJSON(b []byte, src rand.Source, errs *err.Config) (Common, error) {
+	var s specJSON
+	if e := json.Unmarshal(b, &s); e != nil {
+		return nil, err.New(err.EINVAL, e.Error())
+	}
+
+	entry, ok := Lookup(s.Type)
+	if !ok {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("unknown distribution %q", s.Type))
+	}
+
+	if len(s.Dists) != entry.Dists {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("%s needs %d distribution arguments", s.Type, entry.Dists))
+	}
+
+	if src == nil {
+		src = sourceFor(s.Type)
+	}
+
+	dists := make([]Common, len(s.Dists))
+	for i, raw := range s.Dists {
+		d, e := parseJSON(raw, src, errs)
+		if e != nil {
+			return nil, e
+		}
+		dists[i] = d
+	}
+
+	params := make(map[string]float64, len(s.Params))
+	for k, v := range s.Params {
+		params[k] = float64(v)
+	}
+
+	return entry.fromMap(dists, params, src, errs)
+}
+
+func marshalJSON(d specifier) ([]byte, error) {
+	name, dists, params := d.spec()
+	entry, ok := Lookup(name)
+	if !ok {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("unknown distribution %q", name))
+	}
+
+	s := specJSON{Type: name}
+	for _, inner := range dists {
+		if _, ok := inner.(json.Marshaler); !ok {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s: %v has no JSON form", name, inner))
+		}
+
+		b, e := json.Marshal(inner)
+		if e != nil {
+			return nil, e
+		}
+		s.Dists = append(s.Dists, b)
+	}
+
+	if len(params) > 0 {
+		s.Params = make(map[string]jsonFloat, len(params))
+		for i, v := range params {
+			s.Params[entry.Params[i].Name] = jsonFloat(v)
+		}
+	}
+
+	return json.Marshal(s)
+}
+
+// The spec of d, for the MarshalText of distributions of distributions, which fails where one of them
+// has no spec, as those built from a sample.
+func marshalText(d specifier) ([]byte, error) {
+	name, dists, params := d.spec()
+	for _, inner := range dists {
+		m, ok := inner.(encoding.TextMarshaler)
+		if !ok {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s: %v has no spec", name, inner))
+		}
+
+		if _, e := m.MarshalText(); e != nil {
+			return nil, e
+		}
+	}
+
+	return []byte(specString(name, dists, params)), nil
+}
+
+// Decodes b into dst, which must point to a distribution of the same type, keeping src if it is set.
+func unmarshalJSON(b []byte, dst interface{}, src rand.Source) error {
+	d, e := parseJSON(b, src, nil)
+	if e != nil {
+		return e
+	}
+
+	return assign(dst, d)
+}
+
+func unmarshalText(b []byte, dst interface{}, src rand.Source) error {
+	if src == nil {
+		src = sourceFor(reflect.TypeOf(dst).Elem().Name())
+	}
+
+	d, e := ParseWithSource(string(b), src)
+	if e != nil {
+		return e
+	}
+
+	return assign(dst, d)
+}
+
+func assign(dst interface{}, d Common) error {
+	v := reflect.ValueOf(d)
+	if v.Type() != reflect.TypeOf(dst) {
+		return err.New(err.EINVAL, fmt.Sprintf("cannot decode %v into %T", d, dst))
+	}
+
+	// the decoded distribution keeps reporting where dst did
+	errs := configOf(dst)
+	reflect.ValueOf(dst).Elem().Set(v.Elem())
+	if c, ok := dst.(configured); ok {
+		c.setConfig(errs)
+	}
+
+	return nil
+}
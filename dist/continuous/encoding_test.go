@@ -0,0 +1,200 @@
+package continuous
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"github.com/jtejido/stats/err"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			d, e := Parse(registrySpecs[name])
+			if e != nil {
+				t.Fatalf("Parse(%q): %v", registrySpecs[name], e)
+			}
+
+			b, e := json.Marshal(d)
+			if e != nil {
+				t.Fatalf("Marshal(%v): %v", d, e)
+			}
+
+			d2, e := ParseJSON(b)
+			if e != nil {
+				t.Fatalf("ParseJSON(%s): %v", b, e)
+			}
+
+			if fmt.Sprint(d2) != fmt.Sprint(d) {
+				t.Errorf("Mismatch. want: %v, got: %v", d, d2)
+			}
+
+			// decode in place through the type's own UnmarshalJSON
+			d3 := reflect.New(reflect.TypeOf(d).Elem()).Interface()
+			if e := json.Unmarshal(b, d3); e != nil {
+				t.Fatalf("Unmarshal(%s): %v", b, e)
+			}
+
+			if fmt.Sprint(d3) != fmt.Sprint(d) {
+				t.Errorf("Mismatch. want: %v, got: %v", d, d3)
+			}
+		})
+	}
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			d, e := Parse(registrySpecs[name])
+			if e != nil {
+				t.Fatalf("Parse(%q): %v", registrySpecs[name], e)
+			}
+
+			text, e := d.(encoding.TextMarshaler).MarshalText()
+			if e != nil {
+				t.Fatalf("MarshalText(%v): %v", d, e)
+			}
+
+			d2 := reflect.New(reflect.TypeOf(d).Elem()).Interface().(encoding.TextUnmarshaler)
+			if e := d2.UnmarshalText(text); e != nil {
+				t.Fatalf("UnmarshalText(%s): %v", text, e)
+			}
+
+			if fmt.Sprint(d2) != string(text) {
+				t.Errorf("Mismatch. want: %s, got: %v", text, d2)
+			}
+		})
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	cases := []struct {
+		spec, expected string
+	}{
+		{"Weibull(2, 3)", `{"type":"Weibull","params":{"scale":2,"shape":3}}`},
+		{"Arcsine", `{"type":"Arcsine"}`},
+		{"Truncated(Normal(0, 1), -Inf, 2)", `{"type":"Truncated","dists":[{"type":"Normal","params":{"location":0,"scale":1}}],"params":{"max":2,"min":"-Inf"}}`},
+		{"Wrapped(Cauchy(0, 1), 10)", `{"type":"Wrapped","dists":[{"type":"Cauchy","params":{"location":0,"scale":1}}],"params":{"k":10,"lower":-3.141592653589793}}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.spec, func(t *testing.T) {
+			d, e := Parse(c.spec)
+			if e != nil {
+				t.Fatalf("Parse(%q): %v", c.spec, e)
+			}
+
+			b, e := json.Marshal(d)
+			if e != nil {
+				t.Fatalf("Marshal(%v): %v", d, e)
+			}
+
+			if string(b) != c.expected {
+				t.Errorf("Mismatch. want: %s, got: %s", c.expected, b)
+			}
+		})
+	}
+}
+
+// A distribution built from a sample has no spec, so neither has one that wraps it, which rather than
+// writing what cannot be read back is an error.
+func TestMarshalSampleBuilt(t *testing.T) {
+	emp, _ := NewEmpirical(empiricalData, LinearCDF)
+	trunc, _ := NewTruncated(emp, 2, 5)
+	wrapped, _ := NewWrapped(emp, 0, DefaultCircularSupport)
+	nested, _ := NewTruncated(wrapped, -1, 1)
+	for _, d := range []Common{trunc, wrapped, nested} {
+		if b, e := json.Marshal(d); e == nil {
+			t.Errorf("Marshal(%v) want error, got: %s", d, b)
+		}
+
+		if b, e := d.(interface{ MarshalText() ([]byte, error) }).MarshalText(); e == nil {
+			t.Errorf("MarshalText(%v) want error, got: %s", d, b)
+		} else if se, ok := e.(err.StatsError); !ok || se.Status() != err.EINVAL {
+			t.Errorf("MarshalText(%v) want EINVAL, got: %v", d, e)
+		}
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	// returned without raising them, so that none of these panics under the default handler
+	cases := []string{
+		`{"type":"Gamma","params":{"shape":2}}`,
+		`{"type":"Gamma","params":{"shape":-2,"rate":1}}`,
+		`{"type":"Gamma","params":{"shape":2,"k":3,"rate":1}}`,
+		`{"type":"Gamma","params":{"shape":2,"rate":1,"scale":1}}`,
+		`{"type":"Gamma","params":{"shape":"two","rate":1}}`,
+		`{"type":"Gama","params":{"shape":2,"rate":1}}`,
+		`{"type":"Truncated","params":{"min":0,"max":1}}`,
+		`{"type":"Truncated","dists":[{"type":"Normal","params":{"location":0}}],"params":{"min":0,"max":1}}`,
+		`[]`,
+	}
+
+	for _, c := range cases {
+		t.Run(c, func(t *testing.T) {
+			if d, e := ParseJSON([]byte(c)); e == nil {
+				t.Errorf("ParseJSON(%s) want error, got: %v", c, d)
+			}
+		})
+	}
+
+	// a valid spec of another type cannot be decoded in place
+	var g Gamma
+	if e := json.Unmarshal([]byte(`{"type":"Normal","params":{"location":0,"scale":1}}`), &g); e == nil {
+		t.Errorf("Unmarshal of a Normal into a Gamma want error, got: %v", &g)
+	}
+
+	// nor do they reach the policy of a context
+	d := new(err.Diagnostics)
+	ctx := err.NewContext(context.Background(), &err.Config{Policy: err.PolicyCollect, Diagnostics: d})
+	if dist, e := ParseJSONContext(ctx, []byte(cases[4])); e == nil || d.Len() != 0 {
+		t.Errorf("ParseJSONContext want an error and none collected, got: %v, %v", dist, d.List())
+	}
+}
+
+func TestUnmarshalSource(t *testing.T) {
+	var names []string
+	defer SetUnmarshalSource(SetUnmarshalSource(func(name string) rand.Source {
+		names = append(names, name)
+		return rand.NewSource(1)
+	}))
+
+	var tr Truncated
+	if e := json.Unmarshal([]byte(`{"type":"Truncated","dists":[{"type":"Normal","params":{"location":0,"scale":1}}],"params":{"min":-1,"max":1}}`), &tr); e != nil {
+		t.Fatalf("Unmarshal: %v", e)
+	}
+
+	if len(names) != 1 || names[0] != "Truncated" {
+		t.Errorf("the unmarshal source was called with %v, want [Truncated]", names)
+	}
+
+	if tr.src == nil || tr.dist.(*Normal).src == nil {
+		t.Errorf("source not restored")
+	}
+
+	// a distribution decoded in place keeps its source
+	names = nil
+	src := rand.NewSource(2)
+	n, _ := NewNormalWithSource(0, 1, src)
+	if e := n.UnmarshalText([]byte("Normal(1, 2)")); e != nil {
+		t.Fatalf("UnmarshalText: %v", e)
+	}
+
+	if n.src != src || len(names) != 0 || n.location != 1 || n.scale != 2 {
+		t.Errorf("Mismatch. want: Normal(location=1, scale=2) with its source, got: %v", n)
+	}
+
+	// as does one given its source
+	d, e := ParseJSONWithSource([]byte(`{"type":"Truncated","dists":[{"type":"Normal","params":{"location":0,"scale":1}}],"params":{"min":-1,"max":1}}`), src)
+	if e != nil {
+		t.Fatalf("ParseJSONWithSource: %v", e)
+	}
+
+	if tr := d.(*Truncated); tr.src != src || tr.dist.(*Normal).src != src || len(names) != 0 {
+		t.Errorf("source not passed to %v", d)
+	}
+}
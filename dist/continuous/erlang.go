@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -22,20 +21,48 @@ func NewErlang(shape int, rate float64) (*Erlang, error) {
 }
 
 func NewErlangWithSource(shape int, rate float64, src rand.Source) (*Erlang, error) {
-	if shape <= 0 || rate <= 0 {
-		return nil, err.Invalid()
-	}
-
 	r := new(Erlang)
 	r.shape = shape
 	r.rate = rate
 	r.src = src
 
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
 	return r, nil
 }
 
+func (e *Erlang) spec() (string, []Common, []float64) {
+	return "Erlang", nil, []float64{float64(e.shape), e.rate}
+}
+
 func (e *Erlang) String() string {
-	return "Erlang: Parameters - " + e.Parameters().String() + ", Support(x) - " + e.Support().String()
+	return specString(e.spec())
+}
+
+func (e *Erlang) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *Erlang) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e, e.src)
+}
+
+func (e *Erlang) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Erlang) UnmarshalText(text []byte) error {
+	return unmarshalText(text, e, e.src)
+}
+
+func (e *Erlang) ParameterValues() map[string]float64 {
+	return parameterValues(e)
+}
+
+func (e *Erlang) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(e, params, e.src)
 }
 
 // k ∈ (0,∞)
@@ -80,6 +107,18 @@ func (e *Erlang) Inverse(p float64) float64 {
 	return smath.InverseRegularizedLowerIncompleteGamma(float64(e.shape), p) / e.rate
 }
 
+func (e *Erlang) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return smath.InverseRegularizedUpperIncompleteGamma(float64(e.shape), q) / e.rate
+}
+
 func (e *Erlang) Entropy() float64 {
 	return (1.0-float64(e.shape))*specfunc.Psi(float64(e.shape)) + math.Log(specfunc.Gamma(float64(e.shape))/e.rate) + float64(e.shape)
 }
@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/linear"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -21,19 +20,47 @@ func NewExponential(rate float64) (*Exponential, error) {
 }
 
 func NewExponentialWithSource(rate float64, src rand.Source) (*Exponential, error) {
-	if rate <= 0 {
-		return nil, err.Invalid()
-	}
-
 	r := new(Exponential)
 	r.rate = rate
 	r.src = src
 
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
 	return r, nil
 }
 
+func (e *Exponential) spec() (string, []Common, []float64) {
+	return "Exponential", nil, []float64{e.rate}
+}
+
 func (e *Exponential) String() string {
-	return "Exponential: Parameters - " + e.Parameters().String() + ", Support(x) - " + e.Support().String()
+	return specString(e.spec())
+}
+
+func (e *Exponential) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *Exponential) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e, e.src)
+}
+
+func (e *Exponential) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Exponential) UnmarshalText(text []byte) error {
+	return unmarshalText(text, e, e.src)
+}
+
+func (e *Exponential) ParameterValues() map[string]float64 {
+	return parameterValues(e)
+}
+
+func (e *Exponential) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(e, params, e.src)
 }
 
 // λ ∈ (0,∞)
@@ -89,6 +116,18 @@ func (e *Exponential) Inverse(p float64) float64 {
 
 }
 
+func (e *Exponential) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return -math.Log(q) / e.rate
+}
+
 func (e *Exponential) Mean() float64 {
 	return 1 / e.rate
 }
@@ -105,6 +144,11 @@ func (e *Exponential) Variance() float64 {
 	return 1 / (e.rate * e.rate)
 }
 
+// λ/(λ - it)
+func (e *Exponential) CharacteristicFunction(t float64) complex128 {
+	return complex(e.rate, 0) / complex(e.rate, -t)
+}
+
 func (e *Exponential) Rand() float64 {
 	// T = F^-1(U)
 	// U is uniform on (0, 1), so is 1 − U.
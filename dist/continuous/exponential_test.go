@@ -304,3 +304,28 @@ func TestExponentialInverseDistribution(t *testing.T) {
 		})
 	}
 }
+
+func TestExponentialInverseSurvival(t *testing.T) {
+	tol := 1e-15
+	cases := []struct {
+		λ, q, expected float64
+	}{
+		{1, 0.5, 0.6931471805599453},
+		{1, 0.001, 6.907755278982137},
+		{1, 1e-12, 27.631021115928547},
+		{1, 1e-100, 230.25850929940458},
+		{2.5, 0.5, 0.2772588722239781},
+		{2.5, 0.001, 2.763102111592855},
+		{2.5, 1e-12, 11.05240844637142},
+		{2.5, 1e-100, 92.10340371976183},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			e := Exponential{rate: c.λ}
+
+			res := e.InverseSurvival(c.q)
+			run_test(t, res, c.expected, tol, "ExponentialInverseSurvival")
+		})
+	}
+}
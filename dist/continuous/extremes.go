@@ -0,0 +1,787 @@
+package continuous
+
+import (
+	"context"
+	"fmt"
+	"github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// FitMethod selects how FitGEV and FitGPD estimate their parameters.
+type FitMethod int
+
+const (
+	// maximum likelihood, by the Nelder-Mead simplex from the probability weighted moment estimate, of
+	// covariance the inverse of the observed information. It is regular, efficient and asymptotically
+	// normal for ξ > -1/2. For ξ < -1 the likelihood is unbounded, and the fit fails to converge.
+	MaximumLikelihood FitMethod = iota
+
+	// probability weighted moments, of Hosking, Wallis and Wood for the GEV and Hosking and Wallis for the
+	// GPD, of covariance by parametric bootstrap. They are less variable than maximum likelihood in small
+	// samples, for |ξ| < 1/2.
+	ProbabilityWeightedMoments
+)
+
+var fitMethodNames = map[FitMethod]string{
+	MaximumLikelihood:          "MaximumLikelihood",
+	ProbabilityWeightedMoments: "ProbabilityWeightedMoments",
+}
+
+func (m FitMethod) String() string {
+	if s, ok := fitMethodNames[m]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("FitMethod(%d)", int(m))
+}
+
+// Replicates of the parametric bootstrap giving the covariance of probability weighted moment estimates.
+const pwmReplicates = 500
+
+// BlockMaxima returns the maximum of each consecutive block of size observations of x, such as the annual
+// maxima of a daily series, dropping an incomplete last block.
+func BlockMaxima(x []float64, size int) ([]float64, error) {
+	if size < 1 {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("BlockMaxima: block size %v is not positive", size))
+	}
+
+	if len(x) < size {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("BlockMaxima: %v observations are fewer than a block of %v", len(x), size))
+	}
+
+	m := make([]float64, len(x)/size)
+	for i := range m {
+		m[i] = math.Inf(-1)
+		for _, v := range x[i*size : (i+1)*size] {
+			if math.IsNaN(v) {
+				return nil, err.New(err.EINVAL, "BlockMaxima: observation NaN")
+			}
+
+			m[i] = math.Max(m[i], v)
+		}
+	}
+
+	return m, nil
+}
+
+// MeanExcess is the mean of the excesses x - u of the observations x exceeding a threshold u.
+type MeanExcess struct {
+	Threshold   float64
+	Mean        float64
+	StdErr      float64 // of Mean, s/√k for excesses of sample standard deviation s
+	Exceedances int     // k
+}
+
+// MeanResidualLife returns the mean excesses of x over each threshold leaving at least 2 exceedances,
+// or over each distinct observation but the 2 largest if thresholds is nil. Where the excesses follow a
+// GPD of shape ξ < 1, the mean excess is linear in u, of slope ξ/(1 - ξ), so the lowest threshold above
+// which the plot of Mean against Threshold is linear, within its errors, is the one to fit FitGPD at.
+//
+// S. Coles, An Introduction to Statistical Modeling of Extreme Values. Springer, 2001, §4.3.1.
+func MeanResidualLife(x, thresholds []float64) ([]MeanExcess, error) {
+	xs, e := extremeSample("MeanResidualLife", x, 3)
+	if e != nil {
+		return nil, e
+	}
+
+	if thresholds == nil {
+		for i, v := range xs[:len(xs)-2] {
+			if i == 0 || v != xs[i-1] {
+				thresholds = append(thresholds, v)
+			}
+		}
+	}
+
+	r := make([]MeanExcess, 0, len(thresholds))
+	for _, u := range thresholds {
+		ex := xs[sort.Search(len(xs), func(i int) bool { return xs[i] > u }):]
+		if len(ex) < 2 {
+			continue
+		}
+
+		// Welford's, about u
+		var mean, ss float64
+		for i, v := range ex {
+			d := v - u - mean
+			mean += d / float64(i+1)
+			ss += d * (v - u - mean)
+		}
+
+		k := float64(len(ex))
+		r = append(r, MeanExcess{u, mean, math.Sqrt(ss / (k - 1) / k), len(ex)})
+	}
+
+	return r, nil
+}
+
+// GEVFit is a GEV fitted to a sample of block maxima, with the covariance of its estimates of (μ, σ, ξ).
+type GEVFit struct {
+	*GEV
+	method FitMethod
+	loglik float64
+	cov    [][]float64
+	errs   *err.Config
+}
+
+// FitGEV fits a GEV to the block maxima x, of which there must be at least 3.
+//
+// J. R. M. Hosking, J. R. Wallis and E. F. Wood, "Estimation of the generalized extreme-value
+// distribution by the method of probability-weighted moments," Technometrics, vol. 27, no. 3,
+// pp. 251-261, 1985.
+// S. Coles, An Introduction to Statistical Modeling of Extreme Values. Springer, 2001, ch. 3.
+func FitGEV(x []float64, method FitMethod) (*GEVFit, error) {
+	return FitGEVWithSource(x, method, nil)
+}
+
+// FitGEVWithSource is FitGEV, of which the bootstrap of ProbabilityWeightedMoments and the fitted GEV draw
+// from src.
+func FitGEVWithSource(x []float64, method FitMethod, src rand.Source) (*GEVFit, error) {
+	return fitGEV(x, method, src, nil)
+}
+
+// FitGEVContext is FitGEVWithSource, of which the return levels raise their errors under the err.Config
+// carried by ctx, if any.
+func FitGEVContext(ctx context.Context, x []float64, method FitMethod, src rand.Source) (*GEVFit, error) {
+	return fitGEV(x, method, src, err.FromContext(ctx))
+}
+
+func fitGEV(x []float64, method FitMethod, src rand.Source, errs *err.Config) (*GEVFit, error) {
+	xs, e := extremeSample("FitGEV", x, 3)
+	if e != nil {
+		return nil, e
+	}
+
+	μ, σ, ξ := gevPWM(xs)
+	if !(σ > 0) || math.IsInf(σ, 1) || math.IsNaN(μ) || math.IsNaN(ξ) {
+		return nil, err.New(err.EINVAL, "FitGEV: no estimate for a sample without spread")
+	}
+
+	var cov [][]float64
+	switch method {
+	case MaximumLikelihood:
+		if math.IsInf(gevLogLikelihood(xs, μ, σ, ξ), -1) {
+			// the moment estimate of a Gumbel, whose support covers any sample
+			σ = math.Sqrt(6*sampleVariance(xs)) / math.Pi
+			μ, ξ = sampleMean(xs)-σ*gevMean(0), 0
+		}
+
+		nll := func(θ []float64) float64 {
+			return -gevLogLikelihood(xs, θ[0], math.Exp(θ[1]), θ[2])
+		}
+
+		θ, ok := nelderMead(nll, []float64{μ, math.Log(σ), ξ}, []float64{σ / 5, .2, .1})
+		if !ok {
+			return nil, err.New(err.EMAXITER, "FitGEV: maximum likelihood did not converge")
+		}
+
+		μ, σ, ξ = θ[0], math.Exp(θ[1]), θ[2]
+		cov = observedCovariance(func(θ []float64) float64 {
+			return -gevLogLikelihood(xs, θ[0], θ[1], θ[2])
+		}, []float64{μ, σ, ξ}, []float64{1e-4 * σ, 1e-4 * σ, 1e-4})
+	case ProbabilityWeightedMoments:
+	default:
+		return nil, err.New(err.EINVAL, fmt.Sprintf("FitGEV: unknown method %v", method))
+	}
+
+	g, e := NewGEVWithSource(μ, σ, ξ, src)
+	if e != nil {
+		return nil, e
+	}
+
+	if method == ProbabilityWeightedMoments {
+		cov = bootstrapCovariance(len(xs), g.Rand, func(y []float64) []float64 {
+			μ, σ, ξ := gevPWM(y)
+			return []float64{μ, σ, ξ}
+		})
+	}
+
+	return &GEVFit{g, method, gevLogLikelihood(xs, μ, σ, ξ), cov, errs}, nil
+}
+
+func (f *GEVFit) Method() FitMethod {
+	return f.method
+}
+
+// LogLikelihood returns the log-likelihood of the sample at the estimate, -∞ where it is outside the
+// support of a probability weighted moment fit.
+func (f *GEVFit) LogLikelihood() float64 {
+	return f.loglik
+}
+
+// Covariance returns the estimated covariance of (μ, σ, ξ), NaN where the observed information of a
+// maximum likelihood fit is not positive definite.
+func (f *GEVFit) Covariance() [][]float64 {
+	return copyMatrix(f.cov)
+}
+
+// ReturnLevel returns the level exceeded on average once every period blocks, the 1 - 1/period
+// quantile.
+func (f *GEVFit) ReturnLevel(period float64) float64 {
+	if !(period > 1) {
+		f.errs.Error(fmt.Sprintf("GEVFit.ReturnLevel: period %v is not above 1", period), err.EDOM)
+		return math.NaN()
+	}
+
+	return f.InverseSurvival(1 / period)
+}
+
+// ReturnLevelInterval returns the confidence interval, of coverage level, of the return level of period
+// blocks, by the delta method, normal about the estimate.
+func (f *GEVFit) ReturnLevelInterval(period, level float64) (lower, upper float64) {
+	z := f.ReturnLevel(period)
+	if math.IsNaN(z) {
+		return math.NaN(), math.NaN()
+	}
+
+	// z = μ - σ(e^(-ξL) - 1)/(-ξ) for L = log y, y = -log(1 - 1/period)
+	l := math.Log(-math.Log1p(-1 / period))
+	grad := []float64{1, -expm1Over(-f.shape, l), f.scale * expm1OverDerivative(-f.shape, l)}
+	return deltaInterval(f.errs, "GEVFit.ReturnLevelInterval", z, grad, f.cov, level)
+}
+
+// GPDFit is a GPD fitted to the excesses of a sample over a threshold, its location, with the rate ζ at
+// which the sample exceeds the threshold and the covariance of the estimates of (σ, ξ).
+type GPDFit struct {
+	*GPD
+	method FitMethod
+	n, k   int
+	loglik float64
+	cov    [][]float64
+	errs   *err.Config
+}
+
+// FitGPD fits a GPD to the excesses x - threshold of the observations x exceeding threshold, of which
+// there must be at least 3. The peaks over threshold should be declustered, so about independent.
+//
+// J. R. M. Hosking and J. R. Wallis, "Parameter and quantile estimation for the generalized Pareto
+// distribution," Technometrics, vol. 29, no. 3, pp. 339-349, 1987.
+// S. Coles, An Introduction to Statistical Modeling of Extreme Values. Springer, 2001, ch. 4.
+func FitGPD(x []float64, threshold float64, method FitMethod) (*GPDFit, error) {
+	return FitGPDWithSource(x, threshold, method, nil)
+}
+
+// FitGPDWithSource is FitGPD, of which the bootstrap of ProbabilityWeightedMoments and the fitted GPD draw
+// from src.
+func FitGPDWithSource(x []float64, threshold float64, method FitMethod, src rand.Source) (*GPDFit, error) {
+	return fitGPD(x, threshold, method, src, nil)
+}
+
+// FitGPDContext is FitGPDWithSource, of which the return levels raise their errors under the err.Config
+// carried by ctx, if any.
+func FitGPDContext(ctx context.Context, x []float64, threshold float64, method FitMethod, src rand.Source) (*GPDFit, error) {
+	return fitGPD(x, threshold, method, src, err.FromContext(ctx))
+}
+
+func fitGPD(x []float64, threshold float64, method FitMethod, src rand.Source, errs *err.Config) (*GPDFit, error) {
+	if math.IsNaN(threshold) || math.IsInf(threshold, 0) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("FitGPD: threshold %v is not finite", threshold))
+	}
+
+	xs, e := extremeSample("FitGPD", x, 1)
+	if e != nil {
+		return nil, e
+	}
+
+	var ys []float64
+	for _, v := range xs[sort.Search(len(xs), func(i int) bool { return xs[i] > threshold }):] {
+		ys = append(ys, v-threshold)
+	}
+
+	if len(ys) < 3 {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("FitGPD: %v exceedances of %v are fewer than 3", len(ys), threshold))
+	}
+
+	σ, ξ := gpdPWM(ys)
+	if !(σ > 0) || math.IsInf(σ, 1) || math.IsNaN(ξ) {
+		return nil, err.New(err.EINVAL, "FitGPD: no estimate for excesses without spread")
+	}
+
+	var cov [][]float64
+	switch method {
+	case MaximumLikelihood:
+		if math.IsInf(gpdLogLikelihood(ys, σ, ξ), -1) {
+			// the exponential, whose support covers any excesses
+			σ, ξ = sampleMean(ys), 0
+		}
+
+		nll := func(θ []float64) float64 {
+			return -gpdLogLikelihood(ys, math.Exp(θ[0]), θ[1])
+		}
+
+		θ, ok := nelderMead(nll, []float64{math.Log(σ), ξ}, []float64{.2, .1})
+		if !ok {
+			return nil, err.New(err.EMAXITER, "FitGPD: maximum likelihood did not converge")
+		}
+
+		σ, ξ = math.Exp(θ[0]), θ[1]
+		cov = observedCovariance(func(θ []float64) float64 {
+			return -gpdLogLikelihood(ys, θ[0], θ[1])
+		}, []float64{σ, ξ}, []float64{1e-4 * σ, 1e-4})
+	case ProbabilityWeightedMoments:
+	default:
+		return nil, err.New(err.EINVAL, fmt.Sprintf("FitGPD: unknown method %v", method))
+	}
+
+	g, e := NewGPDWithSource(threshold, σ, ξ, src)
+	if e != nil {
+		return nil, e
+	}
+
+	if method == ProbabilityWeightedMoments {
+		cov = bootstrapCovariance(len(ys), func() float64 { return g.Rand() - threshold }, func(y []float64) []float64 {
+			σ, ξ := gpdPWM(y)
+			return []float64{σ, ξ}
+		})
+	}
+
+	return &GPDFit{g, method, len(xs), len(ys), gpdLogLikelihood(ys, σ, ξ), cov, errs}, nil
+}
+
+func (f *GPDFit) Method() FitMethod {
+	return f.method
+}
+
+func (f *GPDFit) Threshold() float64 {
+	return f.location
+}
+
+func (f *GPDFit) Exceedances() int {
+	return f.k
+}
+
+// Rate returns ζ, the proportion of the sample exceeding the threshold.
+func (f *GPDFit) Rate() float64 {
+	return float64(f.k) / float64(f.n)
+}
+
+// LogLikelihood returns the log-likelihood of the excesses at the estimate, -∞ where one is outside the
+// support of a probability weighted moment fit.
+func (f *GPDFit) LogLikelihood() float64 {
+	return f.loglik
+}
+
+// Covariance returns the estimated covariance of (σ, ξ), NaN where the observed information of a maximum
+// likelihood fit is not positive definite.
+func (f *GPDFit) Covariance() [][]float64 {
+	return copyMatrix(f.cov)
+}
+
+// ReturnLevel returns the level exceeded on average once every period observations,
+// u + σ((period ζ)^ξ - 1)/ξ, which must be above the threshold u, so period ζ > 1.
+func (f *GPDFit) ReturnLevel(period float64) float64 {
+	if !(period*f.Rate() > 1) {
+		f.errs.Error(fmt.Sprintf("GPDFit.ReturnLevel: the threshold is exceeded %v times in period %v", period*f.Rate(), period), err.EDOM)
+		return math.NaN()
+	}
+
+	return f.location + f.scale*expm1Over(f.shape, math.Log(period*f.Rate()))
+}
+
+// ReturnLevelInterval returns the confidence interval, of coverage level, of the return level of period
+// observations, by the delta method, normal about the estimate, taking the rate ζ as binomial,
+// independent of (σ, ξ).
+func (f *GPDFit) ReturnLevelInterval(period, level float64) (lower, upper float64) {
+	z := f.ReturnLevel(period)
+	if math.IsNaN(z) {
+		return math.NaN(), math.NaN()
+	}
+
+	ζ, l := f.Rate(), math.Log(period*f.Rate())
+	grad := []float64{f.scale * math.Exp(f.shape*l) / ζ, expm1Over(f.shape, l), f.scale * expm1OverDerivative(f.shape, l)}
+	cov := [][]float64{
+		{ζ * (1 - ζ) / float64(f.n), 0, 0},
+		{0, f.cov[0][0], f.cov[0][1]},
+		{0, f.cov[1][0], f.cov[1][1]},
+	}
+
+	return deltaInterval(f.errs, "GPDFit.ReturnLevelInterval", z, grad, cov, level)
+}
+
+// A sorted copy of x, of at least min observations, all finite.
+func extremeSample(name string, x []float64, min int) ([]float64, error) {
+	if len(x) < min {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("%s: %v observations are fewer than %v", name, len(x), min))
+	}
+
+	xs := append([]float64(nil), x...)
+	for _, v := range xs {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s: observation %v is not finite", name, v))
+		}
+	}
+	sort.Float64s(xs)
+
+	return xs, nil
+}
+
+func sampleMean(x []float64) float64 {
+	var m float64
+	for i, v := range x {
+		m += (v - m) / float64(i+1)
+	}
+
+	return m
+}
+
+func sampleVariance(x []float64) float64 {
+	m := sampleMean(x)
+	var ss float64
+	for _, v := range x {
+		ss += (v - m) * (v - m)
+	}
+
+	return ss / float64(len(x)-1)
+}
+
+func gevLogLikelihood(x []float64, μ, σ, ξ float64) float64 {
+	if !(σ > 0) {
+		return math.Inf(-1)
+	}
+
+	ll := -float64(len(x)) * math.Log(σ)
+	for _, v := range x {
+		z := (v - μ) / σ
+		if 1+ξ*z <= 0 {
+			return math.Inf(-1)
+		}
+
+		l := log1pOver(ξ, z)
+		ll -= (1+ξ)*l + math.Exp(-l)
+	}
+
+	return ll
+}
+
+// of the excesses y over the threshold
+func gpdLogLikelihood(y []float64, σ, ξ float64) float64 {
+	if !(σ > 0) {
+		return math.Inf(-1)
+	}
+
+	ll := -float64(len(y)) * math.Log(σ)
+	for _, v := range y {
+		z := v / σ
+		if 1+ξ*z <= 0 {
+			return math.Inf(-1)
+		}
+
+		ll -= (1 + ξ) * log1pOver(ξ, z)
+	}
+
+	return ll
+}
+
+// Hosking, Wallis and Wood's estimate from the sorted sample xs, by the unbiased estimates bᵣ of the
+// probability weighted moments E[X F(X)ʳ] and their approximation -ξ ≈ 7.8590c + 2.9554c² for
+// c = (2b₁ - b₀)/(3b₂ - b₀) - log 2/log 3, which is within 9e-4 for |ξ| < 1/2.
+func gevPWM(xs []float64) (μ, σ, ξ float64) {
+	n := float64(len(xs))
+	var b0, b1, b2 float64
+	for i, v := range xs {
+		j := float64(i)
+		b0 += v
+		b1 += v * j / (n - 1)
+		b2 += v * j * (j - 1) / ((n - 1) * (n - 2))
+	}
+	b0, b1, b2 = b0/n, b1/n, b2/n
+
+	c := (2*b1-b0)/(3*b2-b0) - math.Ln2/math.Log(3)
+	ξ = -(7.8590*c + 2.9554*c*c)
+
+	// 2b₁ - b₀ = σΓ(1 - ξ)(1 - 2^ξ)/(-ξ)
+	σ = (2*b1 - b0) / (math.Exp(specfunc.Lngamma(1-ξ)) * expm1Over(ξ, math.Ln2))
+	return b0 - σ*gevMean(ξ), σ, ξ
+}
+
+// Hosking and Wallis' estimate from the sorted excesses ys, by the unbiased estimates a₀ of the mean and
+// a₁ of E[Y(1 - F(Y))], which are σ/(1 - ξ) and σ/2(2 - ξ).
+func gpdPWM(ys []float64) (σ, ξ float64) {
+	n := float64(len(ys))
+	var a0, a1 float64
+	for i, v := range ys {
+		a0 += v
+		a1 += v * (n - 1 - float64(i)) / (n - 1)
+	}
+	a0, a1 = a0/n, a1/n
+
+	return 2 * a0 * a1 / (a0 - 2*a1), 2 - a0/(a0-2*a1)
+}
+
+// Minimises f from x0 by the Nelder-Mead simplex, of first steps step, until the values at its vertices
+// agree to about 1e-12 relative and it has shrunk to about 1e-9 of x, restarting once from where it
+// stopped, lest it collapsed early. It is false if it ran out of iterations.
+//
+// J. A. Nelder and R. Mead, "A simplex method for function minimization," The Computer Journal, vol. 7,
+// no. 4, pp. 308-313, 1965.
+func nelderMead(f func([]float64) float64, x0, step []float64) ([]float64, bool) {
+	const maxIter = 10000
+	d := len(x0)
+	x, step := append([]float64(nil), x0...), append([]float64(nil), step...)
+	for restart := 0; restart < 2; restart++ {
+		p := make([][]float64, d+1)
+		v := make([]float64, d+1)
+		for i := range p {
+			p[i] = append([]float64(nil), x...)
+			if i > 0 {
+				p[i][i-1] += step[i-1]
+			}
+
+			v[i] = f(p[i])
+		}
+
+		point := func(from []float64, c float64, to []float64) []float64 {
+			r := make([]float64, d)
+			for j := range r {
+				r[j] = from[j] + c*(to[j]-from[j])
+			}
+
+			return r
+		}
+
+		converged := false
+		for iter := 0; iter < maxIter; iter++ {
+			sort.Sort(simplex{p, v})
+			size := 0.
+			for _, q := range p[1:] {
+				for j := range q {
+					size = math.Max(size, math.Abs(q[j]-p[0][j])/(1+math.Abs(p[0][j])))
+				}
+			}
+
+			if math.Abs(v[d]-v[0]) <= 1e-12*(1+math.Abs(v[0])) && size <= 1e-9 {
+				converged = true
+				break
+			}
+
+			c := make([]float64, d)
+			for _, q := range p[:d] {
+				for j := range c {
+					c[j] += q[j] / float64(d)
+				}
+			}
+
+			r := point(c, -1, p[d])
+			fr := f(r)
+			switch {
+			case fr < v[0]:
+				e := point(c, -2, p[d])
+				if fe := f(e); fe < fr {
+					p[d], v[d] = e, fe
+				} else {
+					p[d], v[d] = r, fr
+				}
+			case fr < v[d-1]:
+				p[d], v[d] = r, fr
+			default:
+				// inside, or outside if the reflection improves on the worst
+				k := point(c, .5, p[d])
+				if fr < v[d] {
+					k = point(c, -.5, p[d])
+				}
+
+				if fk := f(k); fk < math.Min(fr, v[d]) {
+					p[d], v[d] = k, fk
+					break
+				}
+
+				for i := 1; i <= d; i++ {
+					p[i] = point(p[0], .5, p[i])
+					v[i] = f(p[i])
+				}
+			}
+		}
+
+		if !converged {
+			return nil, false
+		}
+
+		x = p[0]
+		for j := range step {
+			step[j] = math.Max(1e-3*math.Abs(x[j]), 1e-3)
+		}
+	}
+
+	return x, true
+}
+
+// vertices of a Nelder-Mead simplex, by their values
+type simplex struct {
+	p [][]float64
+	v []float64
+}
+
+func (s simplex) Len() int           { return len(s.v) }
+func (s simplex) Less(i, j int) bool { return s.v[i] < s.v[j] }
+func (s simplex) Swap(i, j int) {
+	s.p[i], s.p[j] = s.p[j], s.p[i]
+	s.v[i], s.v[j] = s.v[j], s.v[i]
+}
+
+// Inverse of the Hessian of the negative log-likelihood nll at its minimum x, by central differences of
+// steps h, or NaN if that is not positive definite.
+func observedCovariance(nll func([]float64) float64, x, h []float64) [][]float64 {
+	d := len(x)
+	// nll at x moved by si steps in i and sj in j, or si + sj steps if i = j
+	at := func(i, j int, si, sj float64) float64 {
+		y := append([]float64(nil), x...)
+		y[i] += si * h[i]
+		y[j] += sj * h[j]
+		return nll(y)
+	}
+
+	hess := make([][]float64, d)
+	f0 := nll(x)
+	for i := range hess {
+		hess[i] = make([]float64, d)
+		hess[i][i] = (at(i, i, .5, .5) - 2*f0 + at(i, i, -.5, -.5)) / (h[i] * h[i])
+	}
+
+	for i := 0; i < d; i++ {
+		for j := 0; j < i; j++ {
+			hess[i][j] = (at(i, j, 1, 1) - at(i, j, 1, -1) - at(i, j, -1, 1) + at(i, j, -1, -1)) / (4 * h[i] * h[j])
+			hess[j][i] = hess[i][j]
+		}
+	}
+
+	return invertPositiveDefinite(hess)
+}
+
+// By Cholesky decomposition, or NaN if a is not positive definite.
+func invertPositiveDefinite(a [][]float64) [][]float64 {
+	d := len(a)
+	nan := func() [][]float64 {
+		r := make([][]float64, d)
+		for i := range r {
+			r[i] = make([]float64, d)
+			for j := range r[i] {
+				r[i][j] = math.NaN()
+			}
+		}
+
+		return r
+	}
+
+	// a = LLᵀ
+	l := make([][]float64, d)
+	for i := range l {
+		l[i] = make([]float64, d)
+		for j := 0; j <= i; j++ {
+			s := a[i][j]
+			for k := 0; k < j; k++ {
+				s -= l[i][k] * l[j][k]
+			}
+
+			if i == j {
+				if !(s > 0) {
+					return nan()
+				}
+
+				l[i][i] = math.Sqrt(s)
+			} else {
+				l[i][j] = s / l[j][j]
+			}
+		}
+	}
+
+	// L⁻¹, then a⁻¹ = L⁻ᵀL⁻¹
+	li := make([][]float64, d)
+	for i := range li {
+		li[i] = make([]float64, d)
+		li[i][i] = 1 / l[i][i]
+		for j := 0; j < i; j++ {
+			var s float64
+			for k := j; k < i; k++ {
+				s -= l[i][k] * li[k][j]
+			}
+
+			li[i][j] = s / l[i][i]
+		}
+	}
+
+	r := make([][]float64, d)
+	for i := range r {
+		r[i] = make([]float64, d)
+		for j := range r[i] {
+			for k := 0; k < d; k++ {
+				r[i][j] += li[k][i] * li[k][j]
+			}
+		}
+	}
+
+	return r
+}
+
+// Sample covariance of the estimates est makes of pwmReplicates samples of n drawn by draw, the
+// parametric bootstrap, skipping those it finds no finite estimate for.
+func bootstrapCovariance(n int, draw func() float64, est func([]float64) []float64) [][]float64 {
+	var reps [][]float64
+	y := make([]float64, n)
+	for b := 0; b < pwmReplicates; b++ {
+		for i := range y {
+			y[i] = draw()
+		}
+		sort.Float64s(y)
+
+		θ := est(y)
+		finite := true
+		for _, v := range θ {
+			finite = finite && !math.IsNaN(v) && !math.IsInf(v, 0)
+		}
+
+		if finite {
+			reps = append(reps, θ)
+		}
+	}
+
+	d := len(est(y))
+	mean := make([]float64, d)
+	for _, θ := range reps {
+		for j := range mean {
+			mean[j] += θ[j] / float64(len(reps))
+		}
+	}
+
+	cov := make([][]float64, d)
+	for i := range cov {
+		cov[i] = make([]float64, d)
+		for j := range cov[i] {
+			for _, θ := range reps {
+				cov[i][j] += (θ[i] - mean[i]) * (θ[j] - mean[j])
+			}
+
+			cov[i][j] /= float64(len(reps) - 1)
+		}
+	}
+
+	return cov
+}
+
+// z ∓ q √(gᵀ Σ g), for q the (1 + level)/2 quantile of the standard normal.
+func deltaInterval(c *err.Config, name string, z float64, grad []float64, cov [][]float64, level float64) (lower, upper float64) {
+	if !(level > 0 && level < 1) {
+		c.Error(fmt.Sprintf("%s: level %v is outside (0, 1)", name, level), err.EDOM)
+		return math.NaN(), math.NaN()
+	}
+
+	var v float64
+	for i := range grad {
+		for j := range grad {
+			v += grad[i] * cov[i][j] * grad[j]
+		}
+	}
+
+	q := math.Sqrt2 * math.Erfinv(level) * math.Sqrt(v)
+	return z - q, z + q
+}
+
+func copyMatrix(a [][]float64) [][]float64 {
+	r := make([][]float64, len(a))
+	for i := range a {
+		r[i] = append([]float64(nil), a[i]...)
+	}
+
+	return r
+}
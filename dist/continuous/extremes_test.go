@@ -0,0 +1,264 @@
+package continuous
+
+import (
+	"context"
+	"fmt"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBlockMaxima(t *testing.T) {
+	m, e := BlockMaxima([]float64{1, 5, 2, 3, 3, 0, 9, 1, 1, 7}, 3)
+	if e != nil {
+		t.Fatalf("BlockMaxima: %v", e)
+	}
+
+	want := []float64{5, 3, 9}
+	if len(m) != len(want) {
+		t.Fatalf("Mismatch. BlockMaxima, want: %v, got: %v", want, m)
+	}
+
+	for i := range want {
+		if m[i] != want[i] {
+			t.Errorf("Mismatch. BlockMaxima, want: %v, got: %v", want, m)
+			break
+		}
+	}
+
+	for _, c := range []struct {
+		x    []float64
+		size int
+	}{
+		{[]float64{1, 2}, 0},
+		{[]float64{1, 2}, 3},
+		{[]float64{1, math.NaN(), 2}, 3},
+	} {
+		if _, e := BlockMaxima(c.x, c.size); e == nil {
+			t.Errorf("BlockMaxima(%v, %v), want an error", c.x, c.size)
+		}
+	}
+}
+
+func TestMeanResidualLife(t *testing.T) {
+	r, e := MeanResidualLife([]float64{5, 1, 4, 2, 3, 3}, nil)
+	if e != nil {
+		t.Fatalf("MeanResidualLife: %v", e)
+	}
+
+	// excesses of 1 are 1, 2, 2, 3, 4, of 2 are 1, 1, 2, 3, of 3 are 1, 2
+	want := []MeanExcess{
+		{1, 2.4, math.Sqrt(1.3 / 5), 5},
+		{2, 1.75, math.Sqrt(11. / 12 / 4), 4},
+		{3, 1.5, .5, 2},
+	}
+
+	if len(r) != len(want) {
+		t.Fatalf("Mismatch. MeanResidualLife, want: %v, got: %v", want, r)
+	}
+
+	for i, w := range want {
+		if r[i].Threshold != w.Threshold || r[i].Exceedances != w.Exceedances {
+			t.Errorf("Mismatch. MeanResidualLife[%d], want: %v, got: %v", i, w, r[i])
+		}
+
+		run_test(t, r[i].Mean, w.Mean, 1e-15, fmt.Sprintf("MeanResidualLife[%d].Mean", i))
+		run_test(t, r[i].StdErr, w.StdErr, 1e-15, fmt.Sprintf("MeanResidualLife[%d].StdErr", i))
+	}
+
+	// thresholds leaving fewer than 2 exceedances are dropped
+	if r, _ = MeanResidualLife([]float64{5, 1, 4, 2, 3, 3}, []float64{0, 4, 5}); len(r) != 1 || r[0].Threshold != 0 {
+		t.Errorf("Mismatch. MeanResidualLife at 0, 4 and 5, want: the one at 0, got: %v", r)
+	}
+
+	if _, e = MeanResidualLife([]float64{1, 2}, nil); e == nil {
+		t.Errorf("MeanResidualLife of 2 observations, want an error")
+	}
+}
+
+// The mean excess of a GPD is linear in the threshold, of slope ξ/(1 - ξ)
+func TestMeanResidualLifeGPD(t *testing.T) {
+	g := &GPD{location: 0, scale: 1, shape: .25}
+	g.src = rand.NewSource(1)
+	x := make([]float64, 20000)
+	for i := range x {
+		x[i] = g.Rand()
+	}
+
+	r, e := MeanResidualLife(x, []float64{0, 1, 2})
+	if e != nil {
+		t.Fatalf("MeanResidualLife: %v", e)
+	}
+
+	for _, m := range r {
+		// σ + ξu over 1 - ξ
+		if want := (1 + .25*m.Threshold) / .75; math.Abs(m.Mean-want) > 4*m.StdErr {
+			t.Errorf("Mismatch. mean excess of %v, want: %v, got: %v ± %v", m.Threshold, want, m.Mean, m.StdErr)
+		}
+	}
+}
+
+// Fits of simulated samples recover the parameters, and the intervals the return level, within their errors
+func TestFitGEV(t *testing.T) {
+	for _, ξ := range []float64{.2, 0, -.25} {
+		g := &GEV{location: 10, scale: 2, shape: ξ}
+		g.src = rand.NewSource(int64(100 * (ξ + 1)))
+		x := make([]float64, 400)
+		for i := range x {
+			x[i] = g.Rand()
+		}
+
+		for _, method := range []FitMethod{MaximumLikelihood, ProbabilityWeightedMoments} {
+			f, e := FitGEVWithSource(x, method, rand.NewSource(1))
+			if e != nil {
+				t.Fatalf("FitGEV(%v): %v", method, e)
+			}
+
+			if f.Method() != method {
+				t.Errorf("Mismatch. FitGEV(%v).Method, want: %v, got: %v", method, method, f.Method())
+			}
+
+			cov := f.Covariance()
+			for i, v := range []struct{ got, want float64 }{{f.location, 10}, {f.scale, 2}, {f.shape, ξ}} {
+				if se := math.Sqrt(cov[i][i]); !(math.Abs(v.got-v.want) <= 4*se) {
+					t.Errorf("Mismatch. FitGEV(%v) of GEV(10, 2, %v) parameter %d, want: %v, got: %v ± %v", method, ξ, i, v.want, v.got, se)
+				}
+			}
+
+			run_test(t, f.LogLikelihood(), gevLogLikelihood(x, f.location, f.scale, f.shape), 1e-13, fmt.Sprintf("FitGEV(%v).LogLikelihood", method))
+
+			run_test(t, f.ReturnLevel(100), f.Inverse(.99), 1e-13, fmt.Sprintf("FitGEV(%v).ReturnLevel(100)", method))
+			want := g.InverseSurvival(.01)
+			if lo, hi := f.ReturnLevelInterval(100, .999); !(lo < want && want < hi) {
+				t.Errorf("Mismatch. FitGEV(%v) of GEV(10, 2, %v) 100 block return level, want: %v within, got: [%v, %v]", method, ξ, want, lo, hi)
+			}
+		}
+	}
+}
+
+func TestFitGPD(t *testing.T) {
+	for _, ξ := range []float64{.2, 0, -.25} {
+		// 3000 observations, 600 of them GPD excesses of 5
+		g := &GPD{location: 5, scale: 2, shape: ξ}
+		g.src = rand.NewSource(int64(10 * (ξ + 1)))
+		r := rand.New(rand.NewSource(2))
+		x := make([]float64, 3000)
+		for i := range x {
+			if i%5 == 0 {
+				x[i] = g.Rand()
+			} else {
+				x[i] = 5 * r.Float64()
+			}
+		}
+
+		for _, method := range []FitMethod{MaximumLikelihood, ProbabilityWeightedMoments} {
+			f, e := FitGPDWithSource(x, 5, method, rand.NewSource(1))
+			if e != nil {
+				t.Fatalf("FitGPD(%v): %v", method, e)
+			}
+
+			if f.Threshold() != 5 || f.Exceedances() != 600 || f.Rate() != .2 {
+				t.Errorf("Mismatch. FitGPD(%v) threshold, exceedances and rate, want: 5, 600, 0.2, got: %v, %v, %v", method, f.Threshold(), f.Exceedances(), f.Rate())
+			}
+
+			cov := f.Covariance()
+			for i, v := range []struct{ got, want float64 }{{f.scale, 2}, {f.shape, ξ}} {
+				if se := math.Sqrt(cov[i][i]); !(math.Abs(v.got-v.want) <= 4*se) {
+					t.Errorf("Mismatch. FitGPD(%v) of GPD(5, 2, %v) parameter %d, want: %v, got: %v ± %v", method, ξ, i, v.want, v.got, se)
+				}
+			}
+
+			// exceeded once in 1000 observations, 1 in 200 of the excesses
+			run_test(t, f.ReturnLevel(1000), f.InverseSurvival(.005), 1e-13, fmt.Sprintf("FitGPD(%v).ReturnLevel(1000)", method))
+			want := g.InverseSurvival(.005)
+			if lo, hi := f.ReturnLevelInterval(1000, .999); !(lo < want && want < hi) {
+				t.Errorf("Mismatch. FitGPD(%v) of GPD(5, 2, %v) 1000 observation return level, want: %v within, got: [%v, %v]", method, ξ, want, lo, hi)
+			}
+		}
+	}
+}
+
+// The delta method intervals of maximum likelihood fits cover the return level at about their level
+func TestFitGEVCoverage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping coverage simulation in short mode")
+	}
+
+	g := &GEV{location: 0, scale: 1, shape: .1}
+	g.src = rand.NewSource(7)
+	want := g.InverseSurvival(.02)
+	reps, covered := 200, 0
+	x := make([]float64, 200)
+	for i := 0; i < reps; i++ {
+		for j := range x {
+			x[j] = g.Rand()
+		}
+
+		f, e := FitGEV(x, MaximumLikelihood)
+		if e != nil {
+			t.Fatalf("FitGEV: %v", e)
+		}
+
+		if lo, hi := f.ReturnLevelInterval(50, .9); lo < want && want < hi {
+			covered++
+		}
+	}
+
+	// the binomial sd of the count is about 4.2
+	if covered < 165 || covered > 195 {
+		t.Errorf("Mismatch. 90%% intervals covering the 50 block return level, want: about 180 of %v, got: %v", reps, covered)
+	}
+}
+
+func TestExtremesErrors(t *testing.T) {
+	for _, x := range [][]float64{{1, 2}, {3, 3, 3, 3}, {1, 2, math.Inf(1)}} {
+		if _, e := FitGEV(x, MaximumLikelihood); e == nil {
+			t.Errorf("FitGEV(%v), want an error", x)
+		}
+	}
+
+	if _, e := FitGEV([]float64{1, 2, 4, 3}, FitMethod(7)); e == nil {
+		t.Errorf("FitGEV with %v, want an error", FitMethod(7))
+	}
+
+	x := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+	for _, u := range []float64{6, math.NaN(), math.Inf(-1)} {
+		if _, e := FitGPD(x, u, MaximumLikelihood); e == nil {
+			t.Errorf("FitGPD over %v, want an error", u)
+		}
+	}
+
+	if s := FitMethod(7).String(); s != "FitMethod(7)" {
+		t.Errorf("Mismatch. FitMethod(7).String, want: FitMethod(7), got: %v", s)
+	}
+
+	var d err.Diagnostics
+	ctx := err.NewContext(context.Background(), &err.Config{Policy: err.PolicyCollect, Diagnostics: &d})
+	f, e := FitGEVContext(ctx, []float64{1, 2, 4, 3, 7, 2}, ProbabilityWeightedMoments, nil)
+	if e != nil {
+		t.Fatalf("FitGEV: %v", e)
+	}
+
+	if z := f.ReturnLevel(1); !math.IsNaN(z) {
+		t.Errorf("Mismatch. FitGEV.ReturnLevel(1), want: NaN, got: %v", z)
+	}
+
+	if lo, hi := f.ReturnLevelInterval(10, 1.5); !math.IsNaN(lo) || !math.IsNaN(hi) {
+		t.Errorf("Mismatch. FitGEV.ReturnLevelInterval(10, 1.5), want: NaN, NaN, got: %v, %v", lo, hi)
+	}
+
+	h, e := FitGPDContext(ctx, x, 2, ProbabilityWeightedMoments, nil)
+	if e != nil {
+		t.Fatalf("FitGPD: %v", e)
+	}
+
+	// 6 of 8 exceed 2, so in 4/3 observations once
+	if z := h.ReturnLevel(1.2); !math.IsNaN(z) {
+		t.Errorf("Mismatch. FitGPD.ReturnLevel(1.2), want: NaN, got: %v", z)
+	}
+
+	if d.Len() != 3 {
+		t.Errorf("Mismatch. errors collected, want: 3, got: %v", d.Len())
+	}
+}
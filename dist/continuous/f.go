@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -21,20 +20,48 @@ func NewF(d1, d2 int) (*F, error) {
 }
 
 func NewFWithSource(d1, d2 int, src rand.Source) (*F, error) {
-	if d1 <= 0 || d2 <= 0 {
-		return nil, err.Invalid()
-	}
-
 	f := new(F)
 	f.d1 = d1
 	f.d2 = d2
 	f.src = src
 
+	if e := validate(f); e != nil {
+		return nil, e
+	}
+
 	return f, nil
 }
 
+func (f *F) spec() (string, []Common, []float64) {
+	return "F", nil, []float64{float64(f.d1), float64(f.d2)}
+}
+
 func (f *F) String() string {
-	return "F: Parameters - " + f.Parameters().String() + ", Support(x) - " + f.Support().String()
+	return specString(f.spec())
+}
+
+func (f *F) MarshalJSON() ([]byte, error) {
+	return marshalJSON(f)
+}
+
+func (f *F) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, f, f.src)
+}
+
+func (f *F) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+func (f *F) UnmarshalText(text []byte) error {
+	return unmarshalText(text, f, f.src)
+}
+
+func (f *F) ParameterValues() map[string]float64 {
+	return parameterValues(f)
+}
+
+func (f *F) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(f, params, f.src)
 }
 
 // d₁ ∈ (0,∞)
@@ -96,6 +123,20 @@ func (f *F) Inverse(p float64) float64 {
 	return res
 }
 
+func (f *F) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// S(x) = I(d2/(d2 + d1x); d2/2, d1/2)
+	w := smath.InverseRegularizedIncompleteBeta(0.5*float64(f.d2), 0.5*float64(f.d1), q)
+	return (float64(f.d2) - float64(f.d2)*w) / (float64(f.d1) * w)
+}
+
 func (f *F) Entropy() float64 {
 	lgd1 := specfunc.Lngamma(float64(f.d1) / 2.)
 	lgd2 := specfunc.Lngamma(float64(f.d2) / 2.)
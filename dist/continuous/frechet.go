@@ -4,7 +4,6 @@ import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -22,21 +21,49 @@ func NewFrechet(shape, scale, location float64) (*Frechet, error) {
 }
 
 func NewFrechetWithSource(shape, scale, location float64, src rand.Source) (*Frechet, error) {
-	if shape >= 0 || scale >= 0 {
-		return nil, err.Invalid()
-	}
-
 	r := new(Frechet)
 	r.shape = shape
 	r.scale = scale
 	r.location = location
 	r.src = src
 
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
 	return r, nil
 }
 
+func (f *Frechet) spec() (string, []Common, []float64) {
+	return "Frechet", nil, []float64{f.shape, f.scale, f.location}
+}
+
 func (f *Frechet) String() string {
-	return "Frechet: Parameters - " + f.Parameters().String() + ", Support(x) - " + f.Support().String()
+	return specString(f.spec())
+}
+
+func (f *Frechet) MarshalJSON() ([]byte, error) {
+	return marshalJSON(f)
+}
+
+func (f *Frechet) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, f, f.src)
+}
+
+func (f *Frechet) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+func (f *Frechet) UnmarshalText(text []byte) error {
+	return unmarshalText(text, f, f.src)
+}
+
+func (f *Frechet) ParameterValues() map[string]float64 {
+	return parameterValues(f)
+}
+
+func (f *Frechet) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(f, params, f.src)
 }
 
 // α ∈ (0,∞)
@@ -73,7 +100,7 @@ func (f *Frechet) Distribution(x float64) float64 {
 
 func (f *Frechet) Inverse(p float64) float64 {
 	if p <= 0 {
-		return 0
+		return f.location
 	}
 
 	if p >= 1 {
@@ -83,6 +110,18 @@ func (f *Frechet) Inverse(p float64) float64 {
 	return f.location + f.scale*math.Pow(-math.Log(p), -1/f.shape)
 }
 
+func (f *Frechet) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return f.location
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return f.location + f.scale*math.Pow(-math.Log1p(-q), -1/f.shape)
+}
+
 func (f *Frechet) Entropy() float64 {
 	return 1 + (gsl.Euler / f.shape) + gsl.Euler + math.Log(f.scale/f.shape)
 }
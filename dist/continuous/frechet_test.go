@@ -208,3 +208,27 @@ func TestFrechetExKurtosis(t *testing.T) {
 		})
 	}
 }
+
+func TestFrechetInverseSurvival(t *testing.T) {
+	tol := 1e-15
+	cases := []struct {
+		α, s, m, q float64
+		expected   float64
+	}{
+		{2, 1.5, 1, 0.5, 2.8016836131796747},
+		{2, 1.5, 1, 0.001, 48.42230290070476},
+		{2, 1.5, 1, 1e-12, 1500000.9999996251},
+		{0.5, 2, 0, 0.5, 4.1627379620112155},
+		{0.5, 2, 0, 0.001, 1998000.1666666584},
+		{0.5, 2, 0, 1e-12, 1.9999999999979999e+24},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			f := Frechet{shape: c.α, scale: c.s, location: c.m}
+
+			res := f.InverseSurvival(c.q)
+			run_test(t, res, c.expected, tol, "FrechetInverseSurvival")
+		})
+	}
+}
@@ -4,9 +4,9 @@ import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/linear"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
+	"math/cmplx"
 	"math/rand"
 )
 
@@ -14,7 +14,7 @@ import (
 // https://en.wikipedia.org/wiki/Gamma_distribution
 type Gamma struct {
 	baseContinuousWithSource
-	shape, rate float64 // α, β
+	shape, rate float64 // k, β
 	natural     linear.RealVector
 }
 
@@ -23,28 +23,71 @@ func NewGamma(shape, rate float64) (*Gamma, error) {
 }
 
 func NewGammaWithSource(shape, rate float64, src rand.Source) (*Gamma, error) {
-	if shape <= 0 || rate <= 0 {
-		return nil, err.Invalid()
-	}
-
 	r := new(Gamma)
 	r.shape = shape
 	r.rate = rate
 	r.src = src
 
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
 	return r, nil
 }
 
+// NewGammaFromMoments returns the Gamma distribution of the mean and variance of m, by the method of
+// moments: α = mean²/variance and β = mean/variance.
+func NewGammaFromMoments(m SampleMoments) (*Gamma, error) {
+	return NewGammaFromMomentsWithSource(m, nil)
+}
+
+func NewGammaFromMomentsWithSource(m SampleMoments, src rand.Source) (*Gamma, error) {
+	mean, v := m.Mean(), m.Variance()
+	if !(mean > 0 && v > 0 && !math.IsInf(v, 0)) {
+		return nil, momentsError("Gamma", mean, v)
+	}
+
+	return NewGammaWithSource(mean*mean/v, mean/v, src)
+}
+
+func (g *Gamma) spec() (string, []Common, []float64) {
+	return "Gamma", nil, []float64{g.shape, g.rate}
+}
+
 func (g *Gamma) String() string {
-	return "Gamma: Parameters - " + g.Parameters().String() + ", Support(x) - " + g.Support().String()
+	return specString(g.spec())
+}
+
+func (g *Gamma) MarshalJSON() ([]byte, error) {
+	return marshalJSON(g)
+}
+
+func (g *Gamma) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, g, g.src)
+}
+
+func (g *Gamma) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *Gamma) UnmarshalText(text []byte) error {
+	return unmarshalText(text, g, g.src)
+}
+
+func (g *Gamma) ParameterValues() map[string]float64 {
+	return parameterValues(g)
+}
+
+func (g *Gamma) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(g, params, g.src)
 }
 
 // k ∈ (0,∞)
-// θ ∈ (0,∞)
+// β ∈ (0,∞)
 func (g *Gamma) Parameters() stats.Limits {
 	return stats.Limits{
 		"k": stats.Interval{0, math.Inf(1), true, true},
-		"θ": stats.Interval{0, math.Inf(1), true, true},
+		"β": stats.Interval{0, math.Inf(1), true, true},
 	}
 }
 
@@ -82,6 +125,18 @@ func (g *Gamma) Inverse(p float64) float64 {
 	return smath.InverseRegularizedLowerIncompleteGamma(g.shape, p) / g.rate
 }
 
+func (g *Gamma) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return smath.InverseRegularizedUpperIncompleteGamma(g.shape, q) / g.rate
+}
+
 func (g *Gamma) Entropy() float64 {
 	return g.shape - math.Log(g.rate) + specfunc.Lngamma(g.shape) + (1-g.shape)*specfunc.Psi(g.shape)
 }
@@ -116,6 +171,11 @@ func (g *Gamma) Variance() float64 {
 	return g.shape / (g.rate * g.rate)
 }
 
+// (1 - it/β)^-α
+func (g *Gamma) CharacteristicFunction(t float64) complex128 {
+	return cmplx.Pow(complex(1, -t/g.rate), complex(-g.shape, 0))
+}
+
 func (g *Gamma) Rand() float64 {
 	var d, c float64
 	if g.shape < 1 {
@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -20,10 +19,6 @@ func NewGB1(alpha, beta, p, q float64) (*GB1, error) {
 }
 
 func NewGB1WithSource(alpha, beta, p, q float64, src rand.Source) (*GB1, error) {
-	if alpha <= 0 || beta <= 0 || p <= 0 || q <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(GB1)
 	ret.alpha = alpha
 	ret.beta = beta
@@ -31,11 +26,43 @@ func NewGB1WithSource(alpha, beta, p, q float64, src rand.Source) (*GB1, error)
 	ret.q = q
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (b *GB1) spec() (string, []Common, []float64) {
+	return "GB1", nil, []float64{b.alpha, b.beta, b.p, b.q}
+}
+
 func (b *GB1) String() string {
-	return "GB1: Parameters - " + b.Parameters().String() + ", Support(x) - " + b.Support().String()
+	return specString(b.spec())
+}
+
+func (b *GB1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(b)
+}
+
+func (b *GB1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, b, b.src)
+}
+
+func (b *GB1) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *GB1) UnmarshalText(text []byte) error {
+	return unmarshalText(text, b, b.src)
+}
+
+func (b *GB1) ParameterValues() map[string]float64 {
+	return parameterValues(b)
+}
+
+func (b *GB1) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(b, params, b.src)
 }
 
 // α ∈ (0,∞)
@@ -67,6 +94,10 @@ func (b *GB1) Probability(x float64) float64 {
 }
 
 func (b *GB1) Distribution(x float64) float64 {
+	if x >= b.Support().Upper {
+		return 1
+	}
+
 	if b.Support().IsWithinInterval(x) {
 		beta := Beta{alpha: b.p, beta: b.q}
 		y := math.Pow(x/b.beta, b.alpha)
@@ -90,6 +121,20 @@ func (b *GB1) Inverse(p float64) float64 {
 	return b.beta * math.Pow(z1, 1/b.alpha)
 }
 
+func (b *GB1) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return b.beta
+	}
+
+	beta1 := Beta{alpha: b.p, beta: b.q}
+	z1 := beta1.InverseSurvival(q)
+	return b.beta * math.Pow(z1, 1/b.alpha)
+}
+
 func (b *GB1) Mean() float64 {
 	return b.rm(1)
 }
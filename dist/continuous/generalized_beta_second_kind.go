@@ -3,7 +3,7 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
+	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
 )
@@ -20,10 +20,6 @@ func NewGB2(alpha, beta, p, q float64) (*GB2, error) {
 }
 
 func NewGB2WithSource(alpha, beta, p, q float64, src rand.Source) (*GB2, error) {
-	if alpha <= 0 || beta <= 0 || p <= 0 || q <= 0 {
-		return nil, err.Invalid()
-	}
-
 	ret := new(GB2)
 	ret.alpha = alpha
 	ret.beta = beta
@@ -31,11 +27,43 @@ func NewGB2WithSource(alpha, beta, p, q float64, src rand.Source) (*GB2, error)
 	ret.q = q
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
 	return ret, nil
 }
 
+func (b *GB2) spec() (string, []Common, []float64) {
+	return "GB2", nil, []float64{b.alpha, b.beta, b.p, b.q}
+}
+
 func (b *GB2) String() string {
-	return "GB2: Parameters - " + b.Parameters().String() + ", Support(x) - " + b.Support().String()
+	return specString(b.spec())
+}
+
+func (b *GB2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(b)
+}
+
+func (b *GB2) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, b, b.src)
+}
+
+func (b *GB2) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *GB2) UnmarshalText(text []byte) error {
+	return unmarshalText(text, b, b.src)
+}
+
+func (b *GB2) ParameterValues() map[string]float64 {
+	return parameterValues(b)
+}
+
+func (b *GB2) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(b, params, b.src)
 }
 
 // α ∈ (0,∞)
@@ -67,6 +95,10 @@ func (b *GB2) Probability(x float64) float64 {
 }
 
 func (b *GB2) Distribution(x float64) float64 {
+	if x >= b.Support().Upper {
+		return 1
+	}
+
 	if b.Support().IsWithinInterval(x) {
 		beta := Beta{alpha: b.p, beta: b.q}
 		y := math.Pow(x/b.beta, b.alpha)
@@ -91,6 +123,20 @@ func (b *GB2) Inverse(p float64) float64 {
 	return b.beta * math.Pow(z1/(1-z1), 1/b.alpha)
 }
 
+func (b *GB2) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// 1-z1 for z1 ~ Beta(p, q)
+	y := smath.InverseRegularizedIncompleteBeta(b.q, b.p, q)
+	return b.beta * math.Pow((1-y)/y, 1/b.alpha)
+}
+
 func (b *GB2) Mean() float64 {
 	return b.rm(1)
 }
@@ -0,0 +1,357 @@
+package continuous
+
+import (
+	gsl "github.com/jtejido/ggsl"
+	"github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats"
+	"math"
+	"math/rand"
+)
+
+// Generalized Extreme Value distribution, the limit of normalised maxima, of cdf exp(-(1 + ξz)^(-1/ξ))
+// for z = (x - μ)/σ. It is the Gumbel distribution at ξ = 0, which it passes through continuously, the
+// Frechet for ξ > 0 and the reverse Weibull for ξ < 0.
+// https://en.wikipedia.org/wiki/Generalized_extreme_value_distribution
+type GEV struct {
+	baseContinuousWithSource
+	location, scale, shape float64 // μ, σ, ξ
+}
+
+func NewGEV(location, scale, shape float64) (*GEV, error) {
+	return NewGEVWithSource(location, scale, shape, nil)
+}
+
+func NewGEVWithSource(location, scale, shape float64, src rand.Source) (*GEV, error) {
+	r := new(GEV)
+	r.location = location
+	r.scale = scale
+	r.shape = shape
+	r.src = src
+
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+func (g *GEV) spec() (string, []Common, []float64) {
+	return "GEV", nil, []float64{g.location, g.scale, g.shape}
+}
+
+func (g *GEV) String() string {
+	return specString(g.spec())
+}
+
+func (g *GEV) MarshalJSON() ([]byte, error) {
+	return marshalJSON(g)
+}
+
+func (g *GEV) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, g, g.src)
+}
+
+func (g *GEV) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *GEV) UnmarshalText(text []byte) error {
+	return unmarshalText(text, g, g.src)
+}
+
+func (g *GEV) ParameterValues() map[string]float64 {
+	return parameterValues(g)
+}
+
+func (g *GEV) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(g, params, g.src)
+}
+
+// μ ∈ (-∞,∞)
+// σ ∈ (0,∞)
+// ξ ∈ (-∞,∞)
+func (g *GEV) Parameters() stats.Limits {
+	return stats.Limits{
+		"μ": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
+		"σ": stats.Interval{0, math.Inf(1), true, true},
+		"ξ": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
+	}
+}
+
+// x ∈ [μ - σ/ξ,∞) for ξ > 0
+// x ∈ (-∞,∞) for ξ = 0
+// x ∈ (-∞,μ - σ/ξ] for ξ < 0
+func (g *GEV) Support() stats.Interval {
+	if g.shape > 0 {
+		return stats.Interval{g.location - g.scale/g.shape, math.Inf(1), false, true}
+	}
+
+	if g.shape < 0 {
+		return stats.Interval{math.Inf(-1), g.location - g.scale/g.shape, true, false}
+	}
+
+	return stats.Interval{math.Inf(-1), math.Inf(1), true, true}
+}
+
+// -log t(x), for t(x) = (1 + ξz)^(-1/ξ), and false outside the open support.
+func (g *GEV) logT(x float64) (float64, bool) {
+	z := (x - g.location) / g.scale
+	if 1+g.shape*z <= 0 {
+		return 0, false
+	}
+
+	return log1pOver(g.shape, z), true
+}
+
+func (g *GEV) Probability(x float64) float64 {
+	l, ok := g.logT(x)
+	if !ok {
+		return 0
+	}
+
+	return math.Exp(-(g.shape+1)*l-math.Exp(-l)) / g.scale
+}
+
+func (g *GEV) Distribution(x float64) float64 {
+	l, ok := g.logT(x)
+	if !ok {
+		if g.shape < 0 && x > g.location {
+			return 1
+		}
+
+		return 0
+	}
+
+	return math.Exp(-math.Exp(-l))
+}
+
+// μ + σ((-log p)^(-ξ) - 1)/ξ
+func (g *GEV) Inverse(p float64) float64 {
+	if p <= 0 {
+		return g.Support().Lower
+	}
+
+	if p >= 1 {
+		return g.Support().Upper
+	}
+
+	return g.location - g.scale*expm1Over(-g.shape, math.Log(-math.Log(p)))
+}
+
+func (g *GEV) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return g.Support().Lower
+	}
+
+	if q <= 0 {
+		return g.Support().Upper
+	}
+
+	return g.location - g.scale*expm1Over(-g.shape, math.Log(-math.Log1p(-q)))
+}
+
+func (g *GEV) Entropy() float64 {
+	return math.Log(g.scale) + gsl.Euler*(1+g.shape) + 1
+}
+
+func (g *GEV) Mean() float64 {
+	if g.shape >= 1 {
+		return math.Inf(1)
+	}
+
+	return g.location + g.scale*gevMean(g.shape)
+}
+
+func (g *GEV) Median() float64 {
+	return g.location - g.scale*expm1Over(-g.shape, math.Log(math.Ln2))
+}
+
+// μ + σ((1 + ξ)^(-ξ) - 1)/ξ
+func (g *GEV) Mode() float64 {
+	if g.shape <= -1 {
+		return g.Support().Upper
+	}
+
+	return g.location - g.scale*expm1Over(-g.shape, math.Log1p(g.shape))
+}
+
+func (g *GEV) Variance() float64 {
+	if g.shape >= .5 {
+		return math.Inf(1)
+	}
+
+	v, _, _ := gevMoments(g.shape)
+	return g.scale * g.scale * math.Exp(2*specfunc.Lngamma(1-g.shape)) * v
+}
+
+func (g *GEV) Skewness() float64 {
+	if g.shape >= 1./3 {
+		return math.Inf(1)
+	}
+
+	v, s, _ := gevMoments(g.shape)
+	return s / math.Pow(v, 1.5)
+}
+
+func (g *GEV) ExKurtosis() float64 {
+	if g.shape >= .25 {
+		return math.Inf(1)
+	}
+
+	v, _, k := gevMoments(g.shape)
+	return k/(v*v) - 3
+}
+
+func (g *GEV) Rand() float64 {
+	var rnd float64
+	if g.src != nil {
+		rnd = rand.New(g.src).Float64()
+	} else {
+		rnd = rand.Float64()
+	}
+
+	return g.Inverse(rnd)
+}
+
+// log(1 + ξz)/ξ, which is z at ξ = 0. log1p keeps the ratio to full precision however small ξz is, and
+// for small ξz it is taken as z log(1 + ξz)/(ξz), where rounding ξz, subnormal for the smallest ξ, is harmless.
+func log1pOver(ξ, z float64) float64 {
+	a := ξ * z
+	if ξ == 0 || a == 0 {
+		return z
+	}
+
+	if math.Abs(a) < 1e-8 {
+		return z * (math.Log1p(a) / a)
+	}
+
+	return math.Log1p(a) / ξ
+}
+
+// (e^(ξy) - 1)/ξ, which is y at ξ = 0, and taken as y (e^(ξy) - 1)/(ξy) for small ξy as in log1pOver.
+func expm1Over(ξ, y float64) float64 {
+	a := ξ * y
+	if ξ == 0 || a == 0 {
+		return y
+	}
+
+	if math.Abs(a) < 1e-8 {
+		return y * (math.Expm1(a) / a)
+	}
+
+	return math.Expm1(a) / ξ
+}
+
+// The derivative in ξ of (e^(ξy) - 1)/ξ, (ξy e^(ξy) - e^(ξy) + 1)/ξ², by its series Σ (n-1)ξⁿ⁻²yⁿ/n! for
+// |ξy| < 1, where the closed form cancels.
+func expm1OverDerivative(ξ, y float64) float64 {
+	a := ξ * y
+	if math.Abs(a) >= 1 {
+		return (a*math.Exp(a) - math.Expm1(a)) / (ξ * ξ)
+	}
+
+	var sum float64
+	t := y * y / 2 // ξⁿ⁻²yⁿ/n!
+	for n := 2.; n < 40; n++ {
+		sum += (n - 1) * t
+		if math.Abs(t) <= 1e-17*math.Abs(sum) {
+			break
+		}
+
+		t *= a / (n + 1)
+	}
+
+	return sum
+}
+
+// ζ(2), ..., ζ(17)
+var gevZeta = [...]float64{
+	1.6449340668482264, 1.2020569031595943, 1.0823232337111382, 1.0369277551433699,
+	1.0173430619844491, 1.0083492773819228, 1.0040773561979443, 1.0020083928260822,
+	1.0009945751278181, 1.0004941886041195, 1.0002460865533080, 1.0001227133475785,
+	1.0000612481350587, 1.0000305882363070, 1.0000152822594087, 1.0000076371976379,
+}
+
+// Below this |ξ| gevMoments sums power series in ξ, which the closed forms lose to cancellation.
+const gevSeriesShape = .02
+
+// Power series in ξ of Γ(1 - kξ) - 1, from lnΓ(1 - s) = γs + Σ ζ(m)sᵐ/m, or of Γ(1 - kξ)/Γ(1 - ξ)ᵏ - 1 if
+// centred, whose lnΓ series drops the γ term.
+func gammaSeries(k float64, centred bool) []float64 {
+	n := len(gevZeta) + 2
+	d := make([]float64, n)
+	if !centred {
+		d[1] = gsl.Euler * k
+	}
+
+	for m := 2; m < n; m++ {
+		c := math.Pow(k, float64(m))
+		if centred {
+			c -= k
+		}
+
+		d[m] = gevZeta[m-2] * c / float64(m)
+	}
+
+	// e = exp(d), by e' = d'e
+	e := make([]float64, n)
+	e[0] = 1
+	for i := 1; i < n; i++ {
+		for j := 1; j <= i; j++ {
+			e[i] += float64(j) * d[j] * e[i-j]
+		}
+
+		e[i] /= float64(i)
+	}
+
+	e[0] = 0
+	return e
+}
+
+// Σ c[i]ξ^(i-from), i ≥ from
+func seriesFrom(c []float64, from int, ξ float64) float64 {
+	var sum float64
+	for i := len(c) - 1; i >= from; i-- {
+		sum = sum*ξ + c[i]
+	}
+
+	return sum
+}
+
+// (Γ(1 - ξ) - 1)/ξ, the mean of the standard GEV, (e^(ξT) - 1)/ξ for T standard Gumbel
+func gevMean(ξ float64) float64 {
+	if math.Abs(ξ) < gevSeriesShape {
+		return seriesFrom(gammaSeries(1, false), 1, ξ)
+	}
+
+	return math.Expm1(specfunc.Lngamma(1-ξ)) / ξ
+}
+
+// The central moments of order 2, 3 and 4 of V = e^(ξT)/Γ(1 - ξ), for T standard Gumbel, divided by ξ², ξ³
+// and ξ⁴, those of the standard GEV over Γ(1 - ξ)ⁿ. V has moments e^(Dₖ) for Dₖ = lnΓ(1 - kξ) - k lnΓ(1 - ξ),
+// where they exist, kξ < 1.
+func gevMoments(ξ float64) (m2, m3, m4 float64) {
+	if math.Abs(ξ) < gevSeriesShape {
+		e2, e3, e4 := gammaSeries(2, true), gammaSeries(3, true), gammaSeries(4, true)
+		c3, c4 := make([]float64, len(e2)), make([]float64, len(e2))
+		for i := range e2 {
+			c3[i] = e3[i] - 3*e2[i]
+			c4[i] = e4[i] - 4*e3[i] + 6*e2[i]
+		}
+
+		// the orders below ξⁿ of the nth moment cancel, leaving only rounding
+		return seriesFrom(e2, 2, ξ), seriesFrom(c3, 3, ξ), seriesFrom(c4, 4, ξ)
+	}
+
+	l := specfunc.Lngamma(1 - ξ)
+	d := func(k float64) float64 {
+		if k*ξ >= 1 {
+			return math.NaN()
+		}
+
+		return math.Expm1(specfunc.Lngamma(1-k*ξ) - k*l)
+	}
+
+	d2, d3, d4 := d(2), d(3), d(4)
+	return d2 / (ξ * ξ), (d3 - 3*d2) / (ξ * ξ * ξ), (d4 - 4*d3 + 6*d2) / (ξ * ξ * ξ * ξ)
+}
@@ -0,0 +1,125 @@
+package continuous
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// ξ = 0 is the Gumbel, ξ > 0 the Frechet and ξ < 0 the reverse Weibull
+func TestGEVSpecialCases(t *testing.T) {
+	tol := 1e-12
+	μ, σ := 1., 2.
+	type dist interface {
+		Common
+		Mean() float64
+		Variance() float64
+		Skewness() float64
+		ExKurtosis() float64
+	}
+
+	for _, c := range []struct {
+		ξ    float64
+		want dist
+	}{
+		{0, &Gumbel{location: μ, scale: σ}},
+		{.2, &Frechet{shape: 1 / .2, scale: σ / .2, location: μ - σ/.2}},
+		{-.3, &ReverseWeibull{shape: 1 / .3, scale: σ / .3, location: μ + σ/.3}},
+	} {
+		g := &GEV{location: μ, scale: σ, shape: c.ξ}
+		for _, x := range []float64{-3, 0, 1, 2.5, 6} {
+			desc := fmt.Sprintf("GEV(%v, %v, %v).%%s(%v)", μ, σ, c.ξ, x)
+			run_test(t, g.Probability(x), c.want.Probability(x), tol, fmt.Sprintf(desc, "Probability"))
+			run_test(t, g.Distribution(x), c.want.Distribution(x), tol, fmt.Sprintf(desc, "Distribution"))
+		}
+
+		for _, p := range []float64{1e-6, .1, .5, .9, .999} {
+			run_test(t, g.Inverse(p), c.want.Inverse(p), tol, fmt.Sprintf("GEV(%v, %v, %v).Inverse(%v)", μ, σ, c.ξ, p))
+		}
+
+		desc := fmt.Sprintf("GEV(%v, %v, %v).%%s", μ, σ, c.ξ)
+		run_test(t, g.Mean(), c.want.Mean(), tol, fmt.Sprintf(desc, "Mean"))
+		run_test(t, g.Variance(), c.want.Variance(), tol, fmt.Sprintf(desc, "Variance"))
+		run_test(t, g.Skewness(), c.want.Skewness(), 1e-10, fmt.Sprintf(desc, "Skewness"))
+		run_test(t, g.ExKurtosis(), c.want.ExKurtosis(), 1e-10, fmt.Sprintf(desc, "ExKurtosis"))
+	}
+}
+
+// The series gevMoments sums for small ξ meet its closed forms
+func TestGEVMoments(t *testing.T) {
+	tol := 1e-9
+	for _, ξ := range []float64{-gevSeriesShape, gevSeriesShape} {
+		below := &GEV{scale: 1, shape: ξ * (1 - 1e-9)}
+		above := &GEV{scale: 1, shape: ξ * (1 + 1e-9)}
+		run_test(t, below.Mean(), above.Mean(), tol, fmt.Sprintf("GEV(%v).Mean", ξ))
+		run_test(t, below.Variance(), above.Variance(), tol, fmt.Sprintf("GEV(%v).Variance", ξ))
+		run_test(t, below.Skewness(), above.Skewness(), tol, fmt.Sprintf("GEV(%v).Skewness", ξ))
+		run_test(t, below.ExKurtosis(), above.ExKurtosis(), tol, fmt.Sprintf("GEV(%v).ExKurtosis", ξ))
+	}
+
+	for _, c := range []struct {
+		ξ, mean float64
+	}{
+		{.5, 1.5449077018110320}, // (Γ(1/2) - 1)/(1/2)
+		{-1, 0},                  // (Γ(2) - 1)/-1
+	} {
+		g := &GEV{scale: 1, shape: c.ξ}
+		run_test(t, g.Mean(), c.mean, 1e-15, fmt.Sprintf("GEV(%v).Mean", c.ξ))
+	}
+
+	for _, c := range []struct {
+		ξ    float64
+		m    func(*GEV) float64
+		name string
+	}{
+		{1, (*GEV).Mean, "Mean"},
+		{.5, (*GEV).Variance, "Variance"},
+		{1. / 3, (*GEV).Skewness, "Skewness"},
+		{.25, (*GEV).ExKurtosis, "ExKurtosis"},
+	} {
+		if v := c.m(&GEV{scale: 1, shape: c.ξ}); !math.IsInf(v, 1) {
+			t.Errorf("Mismatch. GEV(%v).%s, want: +Inf, got: %v", c.ξ, c.name, v)
+		}
+	}
+}
+
+// however small ξ is, down to the subnormal
+func TestGEVSmallShape(t *testing.T) {
+	tol := 1e-15
+	gumbel := &Gumbel{location: 1, scale: 2}
+	for _, ξ := range []float64{1e-300, -5e-324} {
+		g := &GEV{location: 1, scale: 2, shape: ξ}
+		for _, x := range []float64{-4, 0, 1, 12} {
+			run_test(t, g.Probability(x), gumbel.Probability(x), tol, fmt.Sprintf("GEV(%v).Probability(%v)", ξ, x))
+			run_test(t, g.Distribution(x), gumbel.Distribution(x), tol, fmt.Sprintf("GEV(%v).Distribution(%v)", ξ, x))
+		}
+
+		for _, p := range []float64{.001, .5, .999} {
+			run_test(t, g.Inverse(p), gumbel.Inverse(p), tol, fmt.Sprintf("GEV(%v).Inverse(%v)", ξ, p))
+		}
+
+		run_test(t, g.Median(), gumbel.Median(), tol, fmt.Sprintf("GEV(%v).Median", ξ))
+		run_test(t, g.Mode(), gumbel.Mode(), tol, fmt.Sprintf("GEV(%v).Mode", ξ))
+		run_test(t, g.Entropy(), gumbel.Entropy(), tol, fmt.Sprintf("GEV(%v).Entropy", ξ))
+	}
+}
+
+func TestGEVSupport(t *testing.T) {
+	g := &GEV{location: 1, scale: 2, shape: -.5}
+	if p, d := g.Probability(5.5), g.Distribution(5.5); p != 0 || d != 1 {
+		t.Errorf("Mismatch. GEV(1, 2, -0.5) above its support, want: 0, 1, got: %v, %v", p, d)
+	}
+
+	if x := g.Inverse(1); x != 5 {
+		t.Errorf("Mismatch. GEV(1, 2, -0.5).Inverse(1), want: 5, got: %v", x)
+	}
+
+	g = &GEV{location: 1, scale: 2, shape: .5}
+	if p, d := g.Probability(-3.5), g.Distribution(-3.5); p != 0 || d != 0 {
+		t.Errorf("Mismatch. GEV(1, 2, 0.5) below its support, want: 0, 0, got: %v, %v", p, d)
+	}
+
+	if x := g.Inverse(0); x != -3 {
+		t.Errorf("Mismatch. GEV(1, 2, 0.5).Inverse(0), want: -3, got: %v", x)
+	}
+}
@@ -0,0 +1,204 @@
+package continuous
+
+import (
+	"github.com/jtejido/stats"
+	"math"
+	"math/rand"
+)
+
+// Generalized Pareto distribution, the limit of excesses over a high threshold, of survival
+// (1 + ξz)^(-1/ξ) for z = (x - μ)/σ. It is the exponential distribution at ξ = 0, which it passes through
+// continuously, has a Pareto tail for ξ > 0 and is bounded above for ξ < 0.
+// https://en.wikipedia.org/wiki/Generalized_Pareto_distribution
+type GPD struct {
+	baseContinuousWithSource
+	location, scale, shape float64 // μ, σ, ξ
+}
+
+func NewGPD(location, scale, shape float64) (*GPD, error) {
+	return NewGPDWithSource(location, scale, shape, nil)
+}
+
+func NewGPDWithSource(location, scale, shape float64, src rand.Source) (*GPD, error) {
+	r := new(GPD)
+	r.location = location
+	r.scale = scale
+	r.shape = shape
+	r.src = src
+
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+func (g *GPD) spec() (string, []Common, []float64) {
+	return "GPD", nil, []float64{g.location, g.scale, g.shape}
+}
+
+func (g *GPD) String() string {
+	return specString(g.spec())
+}
+
+func (g *GPD) MarshalJSON() ([]byte, error) {
+	return marshalJSON(g)
+}
+
+func (g *GPD) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, g, g.src)
+}
+
+func (g *GPD) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *GPD) UnmarshalText(text []byte) error {
+	return unmarshalText(text, g, g.src)
+}
+
+func (g *GPD) ParameterValues() map[string]float64 {
+	return parameterValues(g)
+}
+
+func (g *GPD) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(g, params, g.src)
+}
+
+// μ ∈ (-∞,∞)
+// σ ∈ (0,∞)
+// ξ ∈ (-∞,∞)
+func (g *GPD) Parameters() stats.Limits {
+	return stats.Limits{
+		"μ": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
+		"σ": stats.Interval{0, math.Inf(1), true, true},
+		"ξ": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
+	}
+}
+
+// x ∈ [μ,∞) for ξ ≥ 0
+// x ∈ [μ,μ - σ/ξ] for ξ < 0
+func (g *GPD) Support() stats.Interval {
+	if g.shape < 0 {
+		return stats.Interval{g.location, g.location - g.scale/g.shape, false, false}
+	}
+
+	return stats.Interval{g.location, math.Inf(1), false, true}
+}
+
+// -log of the survival, log(1 + ξz)/ξ, and false outside [μ, μ - σ/ξ).
+func (g *GPD) logSurvival(x float64) (float64, bool) {
+	z := (x - g.location) / g.scale
+	if z < 0 || 1+g.shape*z <= 0 {
+		return 0, false
+	}
+
+	return log1pOver(g.shape, z), true
+}
+
+func (g *GPD) Probability(x float64) float64 {
+	l, ok := g.logSurvival(x)
+	if !ok {
+		return 0
+	}
+
+	return math.Exp(-(g.shape+1)*l) / g.scale
+}
+
+func (g *GPD) Distribution(x float64) float64 {
+	l, ok := g.logSurvival(x)
+	if !ok {
+		if x > g.location {
+			return 1
+		}
+
+		return 0
+	}
+
+	return -math.Expm1(-l)
+}
+
+// μ + σ((1 - p)^(-ξ) - 1)/ξ
+func (g *GPD) Inverse(p float64) float64 {
+	if p <= 0 {
+		return g.location
+	}
+
+	if p >= 1 {
+		return g.Support().Upper
+	}
+
+	return g.location - g.scale*expm1Over(-g.shape, math.Log1p(-p))
+}
+
+func (g *GPD) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return g.location
+	}
+
+	if q <= 0 {
+		return g.Support().Upper
+	}
+
+	return g.location - g.scale*expm1Over(-g.shape, math.Log(q))
+}
+
+func (g *GPD) Entropy() float64 {
+	return math.Log(g.scale) + g.shape + 1
+}
+
+func (g *GPD) Mean() float64 {
+	if g.shape >= 1 {
+		return math.Inf(1)
+	}
+
+	return g.location + g.scale/(1-g.shape)
+}
+
+func (g *GPD) Median() float64 {
+	return g.location + g.scale*expm1Over(g.shape, math.Ln2)
+}
+
+func (g *GPD) Mode() float64 {
+	if g.shape < -1 {
+		return g.Support().Upper
+	}
+
+	return g.location
+}
+
+func (g *GPD) Variance() float64 {
+	if g.shape >= .5 {
+		return math.Inf(1)
+	}
+
+	return g.scale * g.scale / ((1 - g.shape) * (1 - g.shape) * (1 - 2*g.shape))
+}
+
+func (g *GPD) Skewness() float64 {
+	if g.shape >= 1./3 {
+		return math.Inf(1)
+	}
+
+	return 2 * (1 + g.shape) * math.Sqrt(1-2*g.shape) / (1 - 3*g.shape)
+}
+
+func (g *GPD) ExKurtosis() float64 {
+	if g.shape >= .25 {
+		return math.Inf(1)
+	}
+
+	ξ := g.shape
+	return 3*(1-2*ξ)*(2*ξ*ξ+ξ+3)/((1-3*ξ)*(1-4*ξ)) - 3
+}
+
+func (g *GPD) Rand() float64 {
+	var rnd float64
+	if g.src != nil {
+		rnd = rand.New(g.src).Float64()
+	} else {
+		rnd = rand.Float64()
+	}
+
+	return g.Inverse(rnd)
+}
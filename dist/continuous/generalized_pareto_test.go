@@ -0,0 +1,82 @@
+package continuous
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// ξ = 0 is the exponential shifted to μ, ξ > 0 the Lomax and ξ = -1 the uniform on [μ, μ + σ]
+func TestGPDSpecialCases(t *testing.T) {
+	tol := 1e-12
+	μ, σ := 1., 2.
+	type dist interface {
+		Common
+		Mean() float64
+		Variance() float64
+		Skewness() float64
+		ExKurtosis() float64
+	}
+
+	for _, c := range []struct {
+		ξ     float64
+		want  dist
+		shift float64
+	}{
+		{0, &Exponential{rate: 1 / σ}, μ},
+		{.2, &ParetoType2{xmin: σ / .2, shape: 1 / .2, location: μ}, 0},
+		{-1, &Uniform{min: μ, max: μ + σ}, 0},
+	} {
+		g := &GPD{location: μ, scale: σ, shape: c.ξ}
+		for _, x := range []float64{1.5, 2, 2.9, 6} {
+			desc := fmt.Sprintf("GPD(%v, %v, %v).%%s(%v)", μ, σ, c.ξ, x)
+			run_test(t, g.Probability(x), c.want.Probability(x-c.shift), tol, fmt.Sprintf(desc, "Probability"))
+			run_test(t, g.Distribution(x), c.want.Distribution(x-c.shift), tol, fmt.Sprintf(desc, "Distribution"))
+		}
+
+		for _, p := range []float64{1e-6, .1, .5, .9, .999} {
+			run_test(t, g.Inverse(p), c.want.Inverse(p)+c.shift, tol, fmt.Sprintf("GPD(%v, %v, %v).Inverse(%v)", μ, σ, c.ξ, p))
+		}
+
+		desc := fmt.Sprintf("GPD(%v, %v, %v).%%s", μ, σ, c.ξ)
+		run_test(t, g.Mean(), c.want.Mean()+c.shift, tol, fmt.Sprintf(desc, "Mean"))
+		run_test(t, g.Variance(), c.want.Variance(), tol, fmt.Sprintf(desc, "Variance"))
+		run_test(t, g.Skewness(), c.want.Skewness(), tol, fmt.Sprintf(desc, "Skewness"))
+		run_test(t, g.ExKurtosis(), c.want.ExKurtosis(), tol, fmt.Sprintf(desc, "ExKurtosis"))
+	}
+}
+
+func TestGPDSmallShape(t *testing.T) {
+	tol := 1e-15
+	for _, ξ := range []float64{1e-300, -5e-324} {
+		g := &GPD{location: 1, scale: 2, shape: ξ}
+		e := &Exponential{rate: .5}
+		for _, x := range []float64{1.001, 2, 40} {
+			run_test(t, g.Probability(x), e.Probability(x-1), tol, fmt.Sprintf("GPD(%v).Probability(%v)", ξ, x))
+			run_test(t, g.Distribution(x), e.Distribution(x-1), tol, fmt.Sprintf("GPD(%v).Distribution(%v)", ξ, x))
+		}
+
+		for _, p := range []float64{1e-9, .5, .999} {
+			run_test(t, g.Inverse(p), e.Inverse(p)+1, tol, fmt.Sprintf("GPD(%v).Inverse(%v)", ξ, p))
+		}
+
+		run_test(t, g.Median(), 1+2*math.Ln2, tol, fmt.Sprintf("GPD(%v).Median", ξ))
+	}
+}
+
+func TestGPDInverseSurvival(t *testing.T) {
+	g := &GPD{location: 1, scale: 2, shape: .3}
+	for _, q := range []float64{1e-300, 1e-12, .25, .9} {
+		x := g.InverseSurvival(q)
+		run_test(t, x, 1+2*math.Expm1(-.3*math.Log(q))/.3, 1e-14, fmt.Sprintf("GPD.InverseSurvival(%v)", q))
+	}
+
+	g = &GPD{location: 1, scale: 2, shape: -.5}
+	if x := g.Inverse(1); x != 5 {
+		t.Errorf("Mismatch. GPD(1, 2, -0.5).Inverse(1), want: 5, got: %v", x)
+	}
+
+	if p, d := g.Probability(5.5), g.Distribution(5.5); p != 0 || d != 1 {
+		t.Errorf("Mismatch. GPD(1, 2, -0.5) above its support, want: 0, 1, got: %v, %v", p, d)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	// gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -22,20 +21,48 @@ func NewGompertz(shape, scale float64) (*Gompertz, error) {
 }
 
 func NewGompertzWithSource(shape, scale float64, src rand.Source) (*Gompertz, error) {
-	if shape <= 0 || scale <= 0 {
-		return nil, err.Invalid()
-	}
-
 	g := new(Gompertz)
 	g.shape = shape
 	g.scale = scale
 	g.src = src
 
+	if e := validate(g); e != nil {
+		return nil, e
+	}
+
 	return g, nil
 }
 
+func (g *Gompertz) spec() (string, []Common, []float64) {
+	return "Gompertz", nil, []float64{g.shape, g.scale}
+}
+
 func (g *Gompertz) String() string {
-	return "Gompertz: Parameters - " + g.Parameters().String() + ", Support(x) - " + g.Support().String()
+	return specString(g.spec())
+}
+
+func (g *Gompertz) MarshalJSON() ([]byte, error) {
+	return marshalJSON(g)
+}
+
+func (g *Gompertz) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, g, g.src)
+}
+
+func (g *Gompertz) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *Gompertz) UnmarshalText(text []byte) error {
+	return unmarshalText(text, g, g.src)
+}
+
+func (g *Gompertz) ParameterValues() map[string]float64 {
+	return parameterValues(g)
+}
+
+func (g *Gompertz) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(g, params, g.src)
 }
 
 // η ∈ (0,∞)
@@ -101,6 +128,18 @@ func (g *Gompertz) Inverse(p float64) float64 {
 	return math.Log(1.0-g.scale/g.shape*math.Log(1.0-p)) / g.scale
 }
 
+func (g *Gompertz) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return math.Log1p(-g.scale/g.shape*math.Log(q)) / g.scale
+}
+
 func (g *Gompertz) Rand() float64 {
 	var rnd float64
 	if g.src != nil {
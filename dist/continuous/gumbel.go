@@ -3,7 +3,6 @@ package continuous
 import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -20,11 +19,44 @@ func NewGumbel(location, scale float64) (*Gumbel, error) {
 }
 
 func NewGumbelWithSource(location, scale float64, src rand.Source) (*Gumbel, error) {
-	if scale <= 0 {
-		return nil, err.Invalid()
+	r := &Gumbel{location, scale, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Gumbel{location, scale, src}, nil
+	return r, nil
+}
+
+func (g *Gumbel) spec() (string, []Common, []float64) {
+	return "Gumbel", nil, []float64{g.location, g.scale}
+}
+
+func (g *Gumbel) String() string {
+	return specString(g.spec())
+}
+
+func (g *Gumbel) MarshalJSON() ([]byte, error) {
+	return marshalJSON(g)
+}
+
+func (g *Gumbel) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, g, g.src)
+}
+
+func (g *Gumbel) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *Gumbel) UnmarshalText(text []byte) error {
+	return unmarshalText(text, g, g.src)
+}
+
+func (g *Gumbel) ParameterValues() map[string]float64 {
+	return parameterValues(g)
+}
+
+func (g *Gumbel) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(g, params, g.src)
 }
 
 // μ ∈ (-∞,∞)
@@ -87,6 +119,18 @@ func (g *Gumbel) Inverse(p float64) float64 {
 	return g.location - g.scale*math.Log(-math.Log(p))
 }
 
+func (g *Gumbel) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return g.location - g.scale*math.Log(-math.Log1p(-q))
+}
+
 func (g *Gumbel) Skewness() float64 {
 	return 12 * math.Sqrt(6) * gsl.Apery / (math.Pi * math.Pi * math.Pi)
 }
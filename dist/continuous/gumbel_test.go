@@ -157,3 +157,27 @@ func TestGumbelSkewness(t *testing.T) {
 		})
 	}
 }
+
+func TestGumbelInverseSurvival(t *testing.T) {
+	tol := 1e-15
+	cases := []struct {
+		μ, β, q  float64
+		expected float64
+	}{
+		{2, 3, 0.5, 3.099538761744993},
+		{2, 3, 0.001, 22.72176521157115},
+		{2, 3, 1e-12, 84.89306334778415},
+		{-1, 0.5, 0.5, -0.8167435397091678},
+		{-1, 0.5, 0.001, 2.4536275352618584},
+		{-1, 0.5, 1e-12, 12.815510557964025},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			g := Gumbel{location: c.μ, scale: c.β}
+
+			res := g.InverseSurvival(c.q)
+			run_test(t, res, c.expected, tol, "GumbelInverseSurvival")
+		})
+	}
+}
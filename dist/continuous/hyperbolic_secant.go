@@ -15,7 +15,44 @@ type HyperbolicSecant struct {
 }
 
 func NewHyperbolicSecantWithSource(src rand.Source) (*HyperbolicSecant, error) {
-	return &HyperbolicSecant{src}, nil
+	r := &HyperbolicSecant{src}
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+func (hs *HyperbolicSecant) spec() (string, []Common, []float64) {
+	return "HyperbolicSecant", nil, []float64{}
+}
+
+func (hs *HyperbolicSecant) String() string {
+	return specString(hs.spec())
+}
+
+func (hs *HyperbolicSecant) MarshalJSON() ([]byte, error) {
+	return marshalJSON(hs)
+}
+
+func (hs *HyperbolicSecant) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, hs, hs.src)
+}
+
+func (hs *HyperbolicSecant) MarshalText() ([]byte, error) {
+	return []byte(hs.String()), nil
+}
+
+func (hs *HyperbolicSecant) UnmarshalText(text []byte) error {
+	return unmarshalText(text, hs, hs.src)
+}
+
+func (hs *HyperbolicSecant) ParameterValues() map[string]float64 {
+	return parameterValues(hs)
+}
+
+func (hs *HyperbolicSecant) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(hs, params, hs.src)
 }
 
 func (hs *HyperbolicSecant) Parameters() stats.Limits {
@@ -75,6 +112,19 @@ func (hs *HyperbolicSecant) Inverse(p float64) float64 {
 	return (2 / math.Pi) * math.Log(math.Tan((math.Pi/2)*p))
 }
 
+func (hs *HyperbolicSecant) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// symmetric about 0
+	return -hs.Inverse(q)
+}
+
 func (hs *HyperbolicSecant) Rand() float64 {
 	var rnd float64
 	if hs.src != nil {
@@ -0,0 +1,340 @@
+package continuous
+
+import (
+	"fmt"
+	gsl "github.com/jtejido/ggsl"
+	"github.com/jtejido/stats/err"
+	"math"
+)
+
+const (
+	defaultInverseMaxIterations = 200
+	defaultInverseRelTolerance  = 4 * gsl.Float64Eps
+)
+
+// InverseOptions configures the quantile solver used by InverseWithOptions.
+// The zero value is usable: it solves to a relative tolerance of 4ε using bracketing
+// steps only.
+type InverseOptions struct {
+	// The solver stops once a step is smaller than AbsTolerance + RelTolerance·|x|.
+	// A zero RelTolerance means 4ε.
+	AbsTolerance, RelTolerance float64
+
+	// Maximum number of iterations after a bracket is found, defaults to 200.
+	MaxIterations int
+
+	// Density of the distribution. When set, Newton steps are taken inside the bracket.
+	Density func(float64) float64
+
+	// Derivative of Density. When set together with Density, Halley steps are taken instead.
+	DensityDerivative func(float64) float64
+
+	// Survival function 1-cdf(x). When set, quantiles for p > 1/2 are solved against
+	// S(x) = 1-p, which keeps full relative accuracy in the upper tail.
+	Survival func(float64) float64
+}
+
+// Inverse finds x in [low, high] such that cdf(x) = p, where either limit may be infinite.
+// Finite limits are taken to be the ends of the support, with cdf(low) = 0 and cdf(high) = 1.
+func Inverse(cdf func(float64) float64, low, high, p float64) (float64, error) {
+	return InverseWithOptions(cdf, low, high, p, InverseOptions{})
+}
+
+// InverseWithOptions finds x in [low, high] such that cdf(x) = p, where either limit may be infinite.
+// Finite limits are taken to be the ends of the support, with cdf(low) = 0 and cdf(high) = 1.
+//
+// Infinite limits are replaced by a bracket grown geometrically from the finite end (or from 0),
+// and the bracket is then narrowed with safeguarded Newton or Halley steps when a density is
+// given, and with regula falsi steps otherwise, falling back to bisection whenever a step
+// leaves the bracket or fails to shrink it. Brackets spanning several orders of magnitude
+// are bisected geometrically so that quantiles far out in the tails are reached in a bounded
+// number of steps.
+//
+// On failure the best estimate found so far is returned along with the error.
+func InverseWithOptions(cdf func(float64) float64, low, high, p float64, opts InverseOptions) (float64, error) {
+	return solveInverse(cdf, low, high, math.NaN(), p, opts)
+}
+
+// InverseSurvival finds x in [low, high] such that sf(x) = q, where sf is the survival function
+// 1-cdf(x) and either limit may be infinite. Finite limits are taken to be the ends of the support,
+// with sf(low) = 1 and sf(high) = 0.
+func InverseSurvival(sf func(float64) float64, low, high, q float64) (float64, error) {
+	return InverseSurvivalWithOptions(sf, low, high, q, InverseOptions{})
+}
+
+// InverseSurvivalWithOptions finds x in [low, high] such that sf(x) = q, where sf is the survival
+// function 1-cdf(x) and either limit may be infinite. The solver is the one behind InverseWithOptions,
+// run against sf directly so that quantiles of the upper tail are resolved to a relative accuracy in q
+// for q well below ε. opts.Survival is ignored.
+func InverseSurvivalWithOptions(sf func(float64) float64, low, high, q float64, opts InverseOptions) (float64, error) {
+	return solveInverseSurvival(sf, low, high, math.NaN(), q, opts)
+}
+
+// Quantile by solveInverse using the density for Newton steps, reporting the error to the diagnostics of
+// c. x0 is an optional initial guess (NaN for none).
+func inverse(c *err.Config, cdf, pdf func(float64) float64, low, high, x0, p float64) float64 {
+	x, e := solveInverse(cdf, low, high, x0, p, InverseOptions{Density: pdf})
+	reportInverse(c, e, "quantile", p, x)
+	return x
+}
+
+// Upper quantile by solveInverseSurvival using the density for Newton steps, reporting the error to the
+// diagnostics of c. x0 is an optional initial guess (NaN for none).
+func inverseSurvival(c *err.Config, sf, pdf func(float64) float64, low, high, x0, q float64) float64 {
+	x, e := solveInverseSurvival(sf, low, high, x0, q, InverseOptions{Density: pdf})
+	reportInverse(c, e, "upper quantile", q, x)
+	return x
+}
+
+func reportInverse(c *err.Config, e error, what string, p, x float64) {
+	if e == nil {
+		return
+	}
+
+	errno := err.EFAILED
+	if se, ok := e.(err.StatsError); ok {
+		errno = se.Status()
+	}
+	c.Report(fmt.Sprintf("%s at %v: %v", what, p, e), errno, x, math.NaN())
+}
+
+func solveInverse(cdf func(float64) float64, low, high, x0, p float64, opts InverseOptions) (float64, error) {
+	if math.IsNaN(p) || p < 0 || p > 1 || math.IsNaN(low) || math.IsNaN(high) || low > high {
+		return math.NaN(), err.Domain()
+	}
+
+	opts, e := inverseDefaults(opts)
+	if e != nil {
+		return math.NaN(), e
+	}
+
+	if p == 0 {
+		return low, nil
+	}
+
+	if p == 1 {
+		return high, nil
+	}
+
+	// The residual r(x) is increasing in x and vanishes at the quantile. In the upper tail it is
+	// measured against the survival function so that 1-p is not rounded away.
+	target := p
+	r := func(x float64) float64 { return cdf(x) - p }
+	if opts.Survival != nil && p > .5 {
+		target = 1 - p
+		r = func(x float64) float64 { return target - opts.Survival(x) }
+	}
+
+	return solveResidual(r, low, high, x0, -p, 1-p, target, opts)
+}
+
+func solveInverseSurvival(sf func(float64) float64, low, high, x0, q float64, opts InverseOptions) (float64, error) {
+	if math.IsNaN(q) || q < 0 || q > 1 || math.IsNaN(low) || math.IsNaN(high) || low > high {
+		return math.NaN(), err.Domain()
+	}
+
+	opts, e := inverseDefaults(opts)
+	if e != nil {
+		return math.NaN(), e
+	}
+
+	if q == 1 {
+		return low, nil
+	}
+
+	if q == 0 {
+		return high, nil
+	}
+
+	return solveResidual(func(x float64) float64 { return q - sf(x) }, low, high, x0, q-1, q, q, opts)
+}
+
+func inverseDefaults(opts InverseOptions) (InverseOptions, error) {
+	if opts.AbsTolerance < 0 || opts.RelTolerance < 0 {
+		return opts, err.BadTolerance()
+	}
+
+	if opts.RelTolerance == 0 {
+		opts.RelTolerance = defaultInverseRelTolerance
+	}
+
+	if opts.MaxIterations <= 0 {
+		opts.MaxIterations = defaultInverseMaxIterations
+	}
+
+	return opts, nil
+}
+
+// Finds the root of the increasing residual r, whose values at finite low and high are rlow and
+// rhigh, to within opts or to a residual of ε·target/2.
+func solveResidual(r func(float64) float64, low, high, x0, rlow, rhigh, target float64, opts InverseOptions) (float64, error) {
+	lower, upper, rl, ru, e := bracketInverse(r, low, high, x0, rlow, rhigh)
+	if e != nil {
+		return lower, e
+	}
+
+	if rl == 0 {
+		return lower, nil
+	}
+
+	if ru == 0 {
+		return upper, nil
+	}
+
+	x := x0
+	if !(x > lower && x < upper) {
+		x = bisectInverse(lower, upper)
+	}
+
+	// Illinois bookkeeping for the regula falsi steps
+	side := 0
+	dx, dxold := upper-lower, upper-lower
+
+	for i := 0; i < opts.MaxIterations; i++ {
+		rx := r(x)
+		if rx == 0 || math.Abs(rx) <= gsl.Float64Eps*target*.5 {
+			return x, nil
+		}
+
+		if rx < 0 {
+			lower, rl = x, rx
+			if side == -1 {
+				ru /= 2
+			}
+			side = -1
+		} else {
+			upper, ru = x, rx
+			if side == 1 {
+				rl /= 2
+			}
+			side = 1
+		}
+
+		var xn float64
+		if opts.Density != nil {
+			d := opts.Density(x)
+			xn = x - rx/d
+			if opts.DensityDerivative != nil {
+				// Halley: x - 2r r' / (2r'² - r r'')
+				dd := opts.DensityDerivative(x)
+				if h := x - 2*rx*d/(2*d*d-rx*dd); !math.IsNaN(h) {
+					xn = h
+				}
+			}
+		} else {
+			xn = upper - ru*(upper-lower)/(ru-rl)
+		}
+
+		// bisect if the step left the bracket or is not converging fast enough
+		dxold, dx = dx, xn-x
+		if !(xn > lower && xn < upper) || math.Abs(dx) > .5*math.Abs(dxold) {
+			xn = bisectInverse(lower, upper)
+			dx = xn - x
+		}
+
+		tol := opts.AbsTolerance + opts.RelTolerance*math.Abs(xn)
+		if math.Abs(xn-x) <= tol || upper-lower <= tol || xn == lower || xn == upper {
+			return xn, nil
+		}
+
+		x = xn
+	}
+
+	return x, err.MaxIteration()
+}
+
+// Grows [low, high] geometrically until r changes sign over it, returning the bracket and the
+// residual at both ends. r is not evaluated at finite limits, where it is known to be rlow and rhigh.
+func bracketInverse(r func(float64) float64, low, high, x0, rlow, rhigh float64) (lower, upper, rl, ru float64, e error) {
+	lowerBounded := !math.IsInf(low, -1)
+	upperBounded := !math.IsInf(high, 1)
+
+	residual := func(x float64) float64 {
+		if lowerBounded && x <= low {
+			return rlow
+		}
+
+		if upperBounded && x >= high {
+			return rhigh
+		}
+
+		return r(x)
+	}
+
+	// start from the guess, or the finite end, or 0
+	start := x0
+	if !(start >= low && start <= high) {
+		switch {
+		case lowerBounded && upperBounded:
+			start = bisectInverse(low, high)
+		case lowerBounded:
+			start = low
+		case upperBounded:
+			start = high
+		default:
+			start = 0
+		}
+	}
+
+	rs := residual(start)
+	if rs == 0 {
+		return start, start, 0, 0, nil
+	}
+
+	lower, upper, rl, ru = start, start, rs, rs
+	step := math.Max(1, math.Abs(start))
+
+	if rs < 0 {
+		// search upwards
+		for ru < 0 {
+			lower, rl = upper, ru
+			upper = start + step
+			if upperBounded && upper > high {
+				upper = high
+			}
+
+			if math.IsInf(upper, 1) || upper > math.MaxFloat64 {
+				return lower, lower, rl, rl, err.Range()
+			}
+
+			ru = residual(upper)
+			step *= 2
+		}
+	} else {
+		// search downwards
+		for rl > 0 {
+			upper, ru = lower, rl
+			lower = start - step
+			if lowerBounded && lower < low {
+				lower = low
+			}
+
+			if math.IsInf(lower, -1) || lower < -math.MaxFloat64 {
+				return upper, upper, ru, ru, err.Range()
+			}
+
+			rl = residual(lower)
+			step *= 2
+		}
+	}
+
+	if math.IsNaN(rl) || math.IsNaN(ru) {
+		return lower, upper, rl, ru, err.BadFunc()
+	}
+
+	return lower, upper, rl, ru, nil
+}
+
+// Midpoint of [lower, upper], taken geometrically when both ends share a sign and span more than
+// a factor of four (an end at exactly zero counts as the smallest subnormal of that sign).
+func bisectInverse(lower, upper float64) float64 {
+	if lower >= 0 && upper > 4*lower {
+		return math.Sqrt(math.Max(lower, math.SmallestNonzeroFloat64)) * math.Sqrt(upper)
+	}
+
+	if upper <= 0 && lower < 4*upper {
+		return -math.Sqrt(math.Max(-upper, math.SmallestNonzeroFloat64)) * math.Sqrt(-lower)
+	}
+
+	return lower + (upper-lower)/2
+}
@@ -4,7 +4,6 @@ import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/linear"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -24,11 +23,44 @@ func NewInverseChiSquared(dof, scale float64) (*InverseChiSquared, error) {
 }
 
 func NewInverseChiSquaredWithSource(dof, scale float64, src rand.Source) (*InverseChiSquared, error) {
-	if dof <= 0 || scale <= 0 {
-		return nil, err.Invalid()
+	r := &InverseChiSquared{dof, scale, src, nil}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &InverseChiSquared{dof, scale, src, nil}, nil
+	return r, nil
+}
+
+func (i *InverseChiSquared) spec() (string, []Common, []float64) {
+	return "InverseChiSquared", nil, []float64{i.dof, i.scale}
+}
+
+func (i *InverseChiSquared) String() string {
+	return specString(i.spec())
+}
+
+func (i *InverseChiSquared) MarshalJSON() ([]byte, error) {
+	return marshalJSON(i)
+}
+
+func (i *InverseChiSquared) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, i, i.src)
+}
+
+func (i *InverseChiSquared) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *InverseChiSquared) UnmarshalText(text []byte) error {
+	return unmarshalText(text, i, i.src)
+}
+
+func (i *InverseChiSquared) ParameterValues() map[string]float64 {
+	return parameterValues(i)
+}
+
+func (i *InverseChiSquared) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(i, params, i.src)
 }
 
 // v ∈ (0,∞)
@@ -70,7 +102,22 @@ func (i *InverseChiSquared) Inverse(p float64) float64 {
 		return math.Inf(1)
 	}
 
-	return (i.dof * i.scale) / (2 * smath.InverseRegularizedLowerIncompleteGamma(i.dof/2, p))
+	return (i.dof * i.scale) / (2 * smath.InverseRegularizedUpperIncompleteGamma(i.dof/2, p))
+}
+
+func (i *InverseChiSquared) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// the inverse of P(ν/2, νσ²/2x) flips q to 1 - q, so it only starts the solver
+	sf := func(x float64) float64 { return specfunc.Gamma_inc_P(i.dof/2, (i.scale*i.dof)/(2*x)) }
+	x0 := (i.dof * i.scale) / (2 * smath.InverseRegularizedLowerIncompleteGamma(i.dof/2, q))
+	return inverseSurvival(nil, sf, i.Probability, 0, math.Inf(1), x0, q)
 }
 
 func (i *InverseChiSquared) Entropy() float64 {
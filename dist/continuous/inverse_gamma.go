@@ -4,7 +4,6 @@ import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/linear"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -23,11 +22,44 @@ func NewInverseGamma(shape, scale float64) (*InverseGamma, error) {
 }
 
 func NewInverseGammaWithSource(shape, scale float64, src rand.Source) (*InverseGamma, error) {
-	if shape <= 0 || scale <= 0 {
-		return nil, err.Invalid()
+	r := &InverseGamma{shape, scale, src, nil}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &InverseGamma{shape, scale, src, nil}, nil
+	return r, nil
+}
+
+func (ig *InverseGamma) spec() (string, []Common, []float64) {
+	return "InverseGamma", nil, []float64{ig.shape, ig.scale}
+}
+
+func (ig *InverseGamma) String() string {
+	return specString(ig.spec())
+}
+
+func (ig *InverseGamma) MarshalJSON() ([]byte, error) {
+	return marshalJSON(ig)
+}
+
+func (ig *InverseGamma) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, ig, ig.src)
+}
+
+func (ig *InverseGamma) MarshalText() ([]byte, error) {
+	return []byte(ig.String()), nil
+}
+
+func (ig *InverseGamma) UnmarshalText(text []byte) error {
+	return unmarshalText(text, ig, ig.src)
+}
+
+func (ig *InverseGamma) ParameterValues() map[string]float64 {
+	return parameterValues(ig)
+}
+
+func (ig *InverseGamma) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(ig, params, ig.src)
 }
 
 // α ∈ (0,∞)
@@ -69,7 +101,22 @@ func (ig *InverseGamma) Inverse(p float64) float64 {
 		return math.Inf(1)
 	}
 
-	return (1 / smath.InverseRegularizedLowerIncompleteGamma(ig.shape, p)) * ig.scale
+	return (1 / smath.InverseRegularizedUpperIncompleteGamma(ig.shape, p)) * ig.scale
+}
+
+func (ig *InverseGamma) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// the inverse of P(α, β/x) flips q to 1 - q, so it only starts the solver
+	sf := func(x float64) float64 { return specfunc.Gamma_inc_P(ig.shape, ig.scale/x) }
+	x0 := (1 / smath.InverseRegularizedLowerIncompleteGamma(ig.shape, q)) * ig.scale
+	return inverseSurvival(nil, sf, ig.Probability, 0, math.Inf(1), x0, q)
 }
 
 func (ig *InverseGamma) Entropy() float64 {
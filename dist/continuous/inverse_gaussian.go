@@ -2,7 +2,6 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -19,11 +18,44 @@ func NewInverseGaussian(mean, shape float64) (*InverseGaussian, error) {
 }
 
 func NewInverseGaussianWithSource(mean, shape float64, src rand.Source) (*InverseGaussian, error) {
-	if mean <= 0 || shape <= 0 {
-		return nil, err.Invalid()
+	r := &InverseGaussian{mean, shape, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &InverseGaussian{mean, shape, src}, nil
+	return r, nil
+}
+
+func (ig *InverseGaussian) spec() (string, []Common, []float64) {
+	return "InverseGaussian", nil, []float64{ig.mean, ig.shape}
+}
+
+func (ig *InverseGaussian) String() string {
+	return specString(ig.spec())
+}
+
+func (ig *InverseGaussian) MarshalJSON() ([]byte, error) {
+	return marshalJSON(ig)
+}
+
+func (ig *InverseGaussian) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, ig, ig.src)
+}
+
+func (ig *InverseGaussian) MarshalText() ([]byte, error) {
+	return []byte(ig.String()), nil
+}
+
+func (ig *InverseGaussian) UnmarshalText(text []byte) error {
+	return unmarshalText(text, ig, ig.src)
+}
+
+func (ig *InverseGaussian) ParameterValues() map[string]float64 {
+	return parameterValues(ig)
+}
+
+func (ig *InverseGaussian) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(ig, params, ig.src)
 }
 
 // μ ∈ (0,∞)
@@ -49,9 +81,13 @@ func (ig *InverseGaussian) Probability(x float64) float64 {
 }
 
 func (ig *InverseGaussian) Distribution(x float64) float64 {
+	if x >= ig.Support().Upper {
+		return 1
+	}
+
 	if ig.Support().IsWithinInterval(x) {
-		x1 := math.Sqrt((ig.shape / x) * ((x / ig.mean) - 1))
-		x2 := -math.Sqrt((ig.shape / x) * ((x / ig.mean) + 1))
+		x1 := math.Sqrt(ig.shape/x) * ((x / ig.mean) - 1)
+		x2 := -math.Sqrt(ig.shape/x) * ((x / ig.mean) + 1)
 		sn := &Normal{0, 1, nil, nil}
 		g1 := sn.Distribution(x1)
 		g2 := sn.Distribution(x2)
@@ -76,8 +112,35 @@ func (ig *InverseGaussian) Skewness() float64 {
 }
 
 func (ig *InverseGaussian) Inverse(p float64) float64 {
-	stats.NotImplementedError()
-	return math.NaN()
+	if p <= 0 {
+		return 0
+	}
+
+	if p >= 1 {
+		return math.Inf(1)
+	}
+
+	return inverse(nil, ig.Distribution, ig.Probability, 0, math.Inf(1), ig.mean, p)
+}
+
+// Φ(-x₁) - e^{2λ/μ}Φ(x₂), both terms taken from erfc so that neither is 1 less something small, and the
+// second in logs so that e^{2λ/μ} does not overflow where Φ(x₂) underflows.
+func (ig *InverseGaussian) survival(x float64) float64 {
+	x1 := math.Sqrt(ig.shape/x) * ((x / ig.mean) - 1)
+	x2 := -math.Sqrt(ig.shape/x) * ((x / ig.mean) + 1)
+	return math.Max(0, .5*math.Erfc(x1/math.Sqrt2)-math.Exp((2*ig.shape)/ig.mean+math.Log(.5*math.Erfc(-x2/math.Sqrt2))))
+}
+
+func (ig *InverseGaussian) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return inverseSurvival(nil, ig.survival, ig.Probability, 0, math.Inf(1), ig.mean, q)
 }
 
 func (ig *InverseGaussian) Mean() float64 {
@@ -0,0 +1,269 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/stats/err"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func stdNormalCdf(x float64) float64 {
+	return .5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func stdNormalPdf(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
+
+func stdNormalPdfDerivative(x float64) float64 {
+	return -x * stdNormalPdf(x)
+}
+
+// Known quantiles of the standard normal
+func TestInverseNormal(t *testing.T) {
+	tol := 1e-15
+	cases := []struct {
+		p, expected float64
+	}{
+		{.5, 0},
+		{.975, 1.959963984540054},
+		{1e-10, -6.361340902404056},
+		{.3, -0.5244005127080407},
+	}
+
+	opts := []InverseOptions{
+		{},
+		{Density: stdNormalPdf},
+		{Density: stdNormalPdf, DensityDerivative: stdNormalPdfDerivative},
+	}
+
+	for i, c := range cases {
+		for j, o := range opts {
+			t.Run(strconv.Itoa(i)+"/"+strconv.Itoa(j), func(t *testing.T) {
+				res, e := InverseWithOptions(stdNormalCdf, math.Inf(-1), math.Inf(1), c.p, o)
+				if e != nil {
+					t.Fatalf("unexpected error: %v", e)
+				}
+
+				run_test(t, res, c.expected, tol, "InverseNormal")
+			})
+		}
+	}
+}
+
+// Quantiles far in the lower tail are reached with full relative accuracy in p.
+func TestInverseLowerTail(t *testing.T) {
+	tol := 1e-14
+	for _, p := range []float64{1e-20, 1e-100, 1e-300} {
+		res, e := InverseWithOptions(stdNormalCdf, math.Inf(-1), math.Inf(1), p, InverseOptions{Density: stdNormalPdf})
+		if e != nil {
+			t.Fatalf("unexpected error: %v", e)
+		}
+
+		run_test(t, stdNormalCdf(res), p, tol, fmt.Sprintf("InverseLowerTail(%v)", p))
+	}
+
+	// on a half-bounded support, the quantile itself is tiny
+	ex := func(x float64) float64 { return -math.Expm1(-x) }
+	for _, p := range []float64{1e-20, 1e-200} {
+		res, e := Inverse(ex, 0, math.Inf(1), p)
+		if e != nil {
+			t.Fatalf("unexpected error: %v", e)
+		}
+
+		run_test(t, res, -math.Log1p(-p), tol, fmt.Sprintf("InverseLowerTailExponential(%v)", p))
+	}
+}
+
+// With a survival function the upper tail of the exponential is exact, while 1-exp(-x)
+// only resolves p to an absolute 1e-16.
+func TestInverseUpperTail(t *testing.T) {
+	tol := 1e-15
+	ex := func(x float64) float64 { return 1 - math.Exp(-x) }
+	sf := func(x float64) float64 { return math.Exp(-x) }
+	for _, q := range []float64{1e-8, 1e-12, 1e-15} {
+		p := 1 - q
+		res, e := InverseWithOptions(ex, 0, math.Inf(1), p, InverseOptions{Survival: sf})
+		if e != nil {
+			t.Fatalf("unexpected error: %v", e)
+		}
+
+		run_test(t, res, -math.Log(1-p), tol, fmt.Sprintf("InverseUpperTail(%v)", q))
+	}
+}
+
+// Solving against the survival function directly resolves q far below ε.
+func TestInverseSurvivalTail(t *testing.T) {
+	tol := 1e-15
+	sf := func(x float64) float64 { return .5 * math.Erfc(x/math.Sqrt2) }
+	for _, c := range []struct{ q, expected float64 }{
+		{1e-12, 7.034483825301132},
+		{1e-100, 21.27345356096532},
+	} {
+		res, e := InverseSurvivalWithOptions(sf, math.Inf(-1), math.Inf(1), c.q, InverseOptions{Density: stdNormalPdf})
+		if e != nil {
+			t.Fatalf("unexpected error: %v", e)
+		}
+
+		run_test(t, res, c.expected, tol, fmt.Sprintf("InverseSurvivalTail(%v)", c.q))
+	}
+
+	ex := func(x float64) float64 { return math.Exp(-x) }
+	for _, q := range []float64{1, .5, 1e-20, 1e-300, 0} {
+		res, e := InverseSurvival(ex, 0, math.Inf(1), q)
+		if q == 0 {
+			if !math.IsInf(res, 1) || e != nil {
+				t.Errorf("Mismatch. want: +Inf, got: %v (%v)", res, e)
+			}
+			continue
+		}
+
+		if e != nil {
+			t.Fatalf("unexpected error: %v", e)
+		}
+
+		run_test(t, res, -math.Log(q), tol, fmt.Sprintf("InverseSurvivalExponential(%v)", q))
+	}
+}
+
+func TestInverseBounded(t *testing.T) {
+	tol := 1e-15
+	uniform := func(x float64) float64 { return (x - 2) / 3 }
+	cases := []struct {
+		p, expected float64
+	}{
+		{0, 2},
+		{1, 5},
+		{.5, 3.5},
+		{.1, 2.3},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			res, e := Inverse(uniform, 2, 5, c.p)
+			if e != nil {
+				t.Fatalf("unexpected error: %v", e)
+			}
+
+			run_test(t, res, c.expected, tol, "InverseBounded")
+		})
+	}
+}
+
+func TestInverseAbsTolerance(t *testing.T) {
+	res, e := InverseWithOptions(stdNormalCdf, math.Inf(-1), math.Inf(1), .975, InverseOptions{AbsTolerance: 1e-3})
+	if e != nil {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	if math.Abs(res-1.959963984540054) > 1e-3 {
+		t.Errorf("Mismatch. want: %v ± 1e-3, got: %v", 1.959963984540054, res)
+	}
+}
+
+func TestInverseErrors(t *testing.T) {
+	bounded := func(x float64) float64 { return .5 * stdNormalCdf(x) }
+	cases := []struct {
+		cdf       func(float64) float64
+		low, high float64
+		p         float64
+		opts      InverseOptions
+		status    int
+	}{
+		{stdNormalCdf, math.Inf(-1), math.Inf(1), -.1, InverseOptions{}, err.EDOM},
+		{stdNormalCdf, math.Inf(-1), math.Inf(1), math.NaN(), InverseOptions{}, err.EDOM},
+		{stdNormalCdf, 1, -1, .5, InverseOptions{}, err.EDOM},
+		{stdNormalCdf, math.Inf(-1), math.Inf(1), .5, InverseOptions{RelTolerance: -1}, err.EBADTOL},
+		{bounded, math.Inf(-1), math.Inf(1), .75, InverseOptions{}, err.ERANGE},
+		{stdNormalCdf, math.Inf(-1), math.Inf(1), .3, InverseOptions{MaxIterations: 1}, err.EMAXITER},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			_, e := InverseWithOptions(c.cdf, c.low, c.high, c.p, c.opts)
+			if e == nil {
+				t.Fatalf("Mismatch. Case %d, want error %d, got nil", i, c.status)
+			}
+
+			if s := e.(err.StatsError).Status(); s != c.status {
+				t.Errorf("Mismatch. Case %d, want error %d, got: %d", i, c.status, s)
+			}
+		})
+	}
+}
+
+type inverseSurvivor interface {
+	Probability(float64) float64
+	Distribution(float64) float64
+	Inverse(float64) float64
+	InverseSurvival(float64) float64
+}
+
+// Both quantile functions of every distribution against its cdf
+func TestInverseSurvivalDistribution(t *testing.T) {
+	tol := 1e-10
+	empirical, _ := NewEmpirical(empiricalData, LinearCDF)
+	kde, _ := NewKDE(empiricalData, GaussianKernel, .8)
+	kdeEpan, _ := NewKDE(empiricalData, EpanechnikovKernel, .8)
+	pc, _ := NewPiecewiseConstant([]float64{0, 1, 3, 4, 6}, []float64{1, 4, 0, 5})
+	pl, _ := NewPiecewiseLinear([]float64{0, 1, 2, 4}, []float64{0, 4, 1, 0})
+	ds := map[string]inverseSurvivor{
+		"AlphaStable":   &AlphaStable{alpha: 1.5, beta: .5, scale: 2, location: 1},
+		"AlphaStableS1": &AlphaStable{alpha: .8, beta: -.4, scale: 2, location: 1, param: StableS1},
+		"Arcsine":       &Arcsine{}, "ArcsineBounded": &ArcsineBounded{min: 1, max: 4},
+		"AsymLaplace": &AssymetricLaplace{location: 1, scale: 2, assymetry: 1.5},
+		"Bates":       &Bates{a: 1, b: 3, n: 4}, "Benini": &Benini{alpha: 1, beta: 2, sigma: 1.5},
+		"Benini0": &Benini{alpha: 0, beta: 2, sigma: 1.5},
+		"BT1":     &BenktanderType1{a: 2, b: .5}, "BT2": &BenktanderType2{a: 2, b: .5}, "BT2b1": &BenktanderType2{a: 2, b: 1},
+		"Beta": &Beta{alpha: 2, beta: 3}, "BetaPrime": &BetaPrime{alpha: 2, beta: 3}, "BS": &BirnbaumSaunders{shape: .5, scale: 2},
+		"Burr": &Burr{c: 2, k: 3, scale: 1.5}, "Cauchy": &Cauchy{location: 1, scale: 2}, "Chi": &Chi{dof: 3},
+		"ChiSq": &ChiSquared{dof: 3}, "Dagum": &Dagum{p: 2, a: 3, scale: 1.5}, "Erlang": &Erlang{shape: 3, rate: 2},
+		"Exp": &Exponential{rate: 2}, "F": &F{d1: 3, d2: 7}, "Frechet": &Frechet{shape: 2, scale: 1.5, location: 1},
+		"Gamma": &Gamma{shape: 2.5, rate: 2}, "GB1": &GB1{alpha: 2, beta: 3, p: 2, q: 3}, "GB2": &GB2{alpha: 2, beta: 3, p: 2, q: 3},
+		"GEV": &GEV{location: 1, scale: 2, shape: .3}, "GEV0": &GEV{location: 1, scale: 2}, "GEVneg": &GEV{location: 1, scale: 2, shape: -.4},
+		"GPD": &GPD{location: 1, scale: 2, shape: .3}, "GPD0": &GPD{location: 1, scale: 2}, "GPDneg": &GPD{location: 1, scale: 2, shape: -.4},
+		"Gompertz": &Gompertz{shape: 2, scale: 1.5}, "Gumbel": &Gumbel{location: 1, scale: 2}, "HypSec": &HyperbolicSecant{},
+		"InvChiSq": &InverseChiSquared{dof: 3, scale: 2}, "InvGamma": &InverseGamma{shape: 3, scale: 2},
+		"InvGauss": &InverseGaussian{mean: 2, shape: 3}, "IrwinHall": &IrwinHall{n: 4},
+		"JSL": &JohnsonSL{gamma: 1, delta: 2, location: 1, scale: 2}, "JSN": &JohnsonSN{gamma: 1, delta: 2, location: 1, scale: 2},
+		"JSU": &JohnsonSU{gamma: 1, delta: 2, location: 1, scale: 2}, "Kumaraswamy": &Kumaraswamy{a: 2, b: 3},
+		"Laplace": &Laplace{location: 1, scale: 2}, "Levy": &Levy{location: 1, scale: 2},
+		"LogLogistic": &LogLogistic{scale: 2, shape: 3, location: 1}, "LogNormal": &LogNormal{location: 1, scale: .5},
+		"Logistic": &Logistic{location: 1, scale: 2}, "Maxwell": &MaxwellBoltzmann{scale: 2},
+		"ModPERT": &ModifiedPERT{min: 1, max: 5, mode: 2, shape: 4}, "Nakagami": &Nakagami{shape: 2, spread: 3},
+		"NCBeta": &NonCentralBeta{alpha: 2, beta: 3, lambda: 1}, "NCChi": &NonCentralChi{dof: 3, lambda: 1.5},
+		"NCChiSq": &NonCentralChiSquared{3, 2, nil, nil}, "NCGamma": &NonCentralGamma{shape: 2, scale: 1.5, lambda: 1},
+		"NCT": &NonCentralT{dof: 5, lambda: 1}, "Normal": &Normal{1, 2, nil, nil}, "Pareto": &Pareto{shape: 2, xmin: 1.5},
+		"ParetoBounded": &ParetoBounded{min: 1, max: 5, shape: 2}, "ParetoT2": &ParetoType2{xmin: 2, shape: 3, location: 1},
+		"PERT": &PERT{min: 1, max: 5, mode: 2}, "QExp": &QExponential{rate: 2, q: 1.5}, "QExp2": &QExponential{rate: 2, q: .5},
+		"QGauss": &QGaussian{mean: 1, scale: 2, q: 1.5}, "QWeibull": &QWeibull{rate: 2, shape: 1.5, q: 1.5},
+		"QWeibull2": &QWeibull{rate: 2, shape: 1.5, q: .5}, "RaisedCos": &RaisedCosine{location: 1, scale: 2},
+		"Rayleigh": &Rayleigh{scale: 2}, "ReverseWeibull": &ReverseWeibull{shape: 2, scale: 1.5, location: 1}, "Rice": &Rice{distance: 1, spread: 2}, "ShiftedGompertz": &ShiftedGompertz{scale: 2, shape: 1.5},
+		"StudentT": &StudentT{dof: 4}, "Triangular": &Triangular{min: 1, max: 5, mode: 2}, "Uniform": &Uniform{min: 1, max: 5},
+		"VonMises": &VonMises{mean: 1, concentration: 2, support: DefaultCircularSupport},
+		"Weibull":  &Weibull{scale: 2, shape: 1.5}, "Wigner": &WignerSemiCircle{radius: 2, center: 1},
+		"Truncated":      &Truncated{dist: &Normal{0, 1, nil, nil}, min: -1, max: 2},
+		"TruncatedAbove": &Truncated{dist: &Normal{0, 1, nil, nil}, min: -1, max: math.Inf(1)},
+		"Wrapped":        &Wrapped{dist: &Normal{0, 1, nil, nil}, support: DefaultCircularSupport, k: 5},
+		"Empirical":      empirical,
+		"KDE":            kde,
+		"KDEEpan":        kdeEpan,
+		"PiecewiseConst": pc,
+		"PiecewiseLin":   pl,
+	}
+	for name, d := range ds {
+		for _, q := range []float64{.9, .5, .1, 1e-3} {
+			run_test(t, 1-d.Distribution(d.InverseSurvival(q)), q, tol, fmt.Sprintf("%sInverseSurvival(%v)", name, q))
+			run_test(t, d.Distribution(d.Inverse(q)), q, tol, fmt.Sprintf("%sInverse(%v)", name, q))
+		}
+
+		// 1 - cdf cannot hold a tail of 1e-12 to 10 digits, so the density is integrated between two upper
+		// quantiles instead. Next to a finite upper end the quantiles themselves are only good to the
+		// spacing of floats there, which is allowed for.
+		q := 1e-12
+		a, b := d.InverseSurvival(2*q), d.InverseSurvival(q)
+		slack := 4 * (math.Nextafter(b, math.Inf(1)) - b) * (d.Probability(a) + d.Probability(b)) / q
+		run_test(t, quadrature(d.Probability, a, b), q, 1e-10+slack, fmt.Sprintf("%sInverseSurvival(%v)", name, q))
+	}
+}
@@ -19,13 +19,50 @@ func NewIrwinHall(n uint) (*IrwinHall, error) {
 }
 
 func NewIrwinHallWithSource(n uint, src rand.Source) (*IrwinHall, error) {
-	return &IrwinHall{n, src}, nil
+	r := &IrwinHall{n, src}
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+func (ih *IrwinHall) spec() (string, []Common, []float64) {
+	return "IrwinHall", nil, []float64{float64(ih.n)}
+}
+
+func (ih *IrwinHall) String() string {
+	return specString(ih.spec())
 }
 
-// n ∈ [0,∞)
+func (ih *IrwinHall) MarshalJSON() ([]byte, error) {
+	return marshalJSON(ih)
+}
+
+func (ih *IrwinHall) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, ih, ih.src)
+}
+
+func (ih *IrwinHall) MarshalText() ([]byte, error) {
+	return []byte(ih.String()), nil
+}
+
+func (ih *IrwinHall) UnmarshalText(text []byte) error {
+	return unmarshalText(text, ih, ih.src)
+}
+
+func (ih *IrwinHall) ParameterValues() map[string]float64 {
+	return parameterValues(ih)
+}
+
+func (ih *IrwinHall) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(ih, params, ih.src)
+}
+
+// n ∈ [1,∞)
 func (ih *IrwinHall) Parameters() stats.Limits {
 	return stats.Limits{
-		"n": stats.Interval{0, math.Inf(1), false, true},
+		"n": stats.Interval{1, math.Inf(1), false, true},
 	}
 }
 
@@ -48,6 +85,11 @@ func (ih *IrwinHall) Probability(x float64) float64 {
 			return 2.0 - x
 		}
 
+		// symmetric about n/2, and the alternating sum cancels less on the lower half
+		if x > float64(ih.n)/2 {
+			x = float64(ih.n) - x
+		}
+
 		sum := 0.
 		for k := 0; k <= int(math.Abs(x)); k++ {
 			pow := math.Pow(x-float64(k), float64(ih.n)-1)
@@ -89,6 +131,52 @@ func (ih *IrwinHall) Distribution(x float64) float64 {
 	return 0
 }
 
+func (ih *IrwinHall) Inverse(p float64) float64 {
+	if p <= 0 {
+		return 0
+	}
+
+	if p >= 1 {
+		return float64(ih.n)
+	}
+
+	if ih.n == 1 {
+		return p
+	}
+
+	if ih.n == 2 {
+		if p <= .5 {
+			return math.Sqrt(2 * p)
+		}
+
+		return 2 - math.Sqrt(2*(1-p))
+	}
+
+	// The density is symmetric about n/2, and the alternating cdf sum loses less
+	// precision on the lower half, so solve there and reflect.
+	if p > .5 {
+		return float64(ih.n) - ih.Inverse(1-p)
+	}
+
+	// start from the normal approximation N(n/2, n/12)
+	n := &Normal{float64(ih.n) / 2, math.Sqrt(float64(ih.n) / 12), nil, nil}
+
+	return inverse(nil, ih.Distribution, ih.Probability, 0, float64(ih.n)/2, n.Inverse(p), p)
+}
+
+func (ih *IrwinHall) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return float64(ih.n)
+	}
+
+	// symmetric about n/2
+	return float64(ih.n) - ih.Inverse(q)
+}
+
 func (ih *IrwinHall) Mean() float64 {
 	return float64(ih.n) / 2.
 }
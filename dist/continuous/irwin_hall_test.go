@@ -1,6 +1,7 @@
 package continuous
 
 import (
+	"fmt"
 	"math"
 	"strconv"
 	"testing"
@@ -64,3 +65,35 @@ func TestIrwinHallDistribution(t *testing.T) {
 		})
 	}
 }
+
+func TestIrwinHallInverse(t *testing.T) {
+	tol := 0.000000001
+	cases := []struct {
+		p        float64
+		n        uint
+		expected float64
+	}{
+		{.125, 2, .5},
+		{.875, 2, 1.5},
+		{.3, 1, .3},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			ih := IrwinHall{n: c.n}
+			res := ih.Inverse(c.p)
+			run_test(t, res, c.expected, tol, "IrwinHallInverse")
+		})
+	}
+}
+
+func TestIrwinHallInverseDistribution(t *testing.T) {
+	tol := 0.000000001
+	for _, n := range []uint{3, 5, 10} {
+		for _, p := range []float64{.001, .1, .5, .75, .999} {
+			ih := IrwinHall{n: n}
+			res := ih.Distribution(ih.Inverse(p))
+			run_test(t, res, p, tol, fmt.Sprintf("IrwinHallInverseDistribution(%v, %v)", n, p))
+		}
+	}
+}
@@ -2,7 +2,7 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
+	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
 )
@@ -19,11 +19,44 @@ func NewJohnsonSL(gamma, delta, location, scale float64) (*JohnsonSL, error) {
 }
 
 func NewJohnsonSLWithSource(gamma, delta, location, scale float64, src rand.Source) (*JohnsonSL, error) {
-	if delta <= 0 && scale <= 0 {
-		return nil, err.Invalid()
+	r := &JohnsonSL{gamma, delta, location, scale, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &JohnsonSL{gamma, delta, location, scale, src}, nil
+	return r, nil
+}
+
+func (j *JohnsonSL) spec() (string, []Common, []float64) {
+	return "JohnsonSL", nil, []float64{j.gamma, j.delta, j.location, j.scale}
+}
+
+func (j *JohnsonSL) String() string {
+	return specString(j.spec())
+}
+
+func (j *JohnsonSL) MarshalJSON() ([]byte, error) {
+	return marshalJSON(j)
+}
+
+func (j *JohnsonSL) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, j, j.src)
+}
+
+func (j *JohnsonSL) MarshalText() ([]byte, error) {
+	return []byte(j.String()), nil
+}
+
+func (j *JohnsonSL) UnmarshalText(text []byte) error {
+	return unmarshalText(text, j, j.src)
+}
+
+func (j *JohnsonSL) ParameterValues() map[string]float64 {
+	return parameterValues(j)
+}
+
+func (j *JohnsonSL) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(j, params, j.src)
 }
 
 // γ ∈ (-∞,∞)
@@ -55,9 +88,9 @@ func (j *JohnsonSL) Probability(x float64) float64 {
 
 func (j *JohnsonSL) Distribution(x float64) float64 {
 	if x >= j.location && x <= j.location+j.scale {
-		return .5 * (math.Erfc(-(j.gamma + j.delta*math.Log((x-j.location)/j.scale)/math.Sqrt(2))))
+		return .5 * math.Erfc(-(j.gamma+j.delta*math.Log((x-j.location)/j.scale))/math.Sqrt(2))
 	} else if x > j.location+j.scale {
-		return .5 * (1 + math.Erf(j.gamma+j.delta*math.Log((x-j.location)/j.scale)/math.Sqrt(2)))
+		return .5 * (1 + math.Erf((j.gamma+j.delta*math.Log((x-j.location)/j.scale))/math.Sqrt(2)))
 	}
 
 	return 0
@@ -97,6 +130,18 @@ func (j *JohnsonSL) Inverse(q float64) float64 {
 	return j.location + math.Exp((-j.gamma-math.Sqrt(2)*math.Erfcinv(2*q))/j.delta)*j.scale
 }
 
+func (j *JohnsonSL) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return j.location
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return j.location + math.Exp((-j.gamma-smath.Ndtri(q))/j.delta)*j.scale
+}
+
 func (j *JohnsonSL) Rand() float64 {
 	var rnd float64
 	if j.src != nil {
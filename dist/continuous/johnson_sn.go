@@ -2,7 +2,7 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
+	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
 )
@@ -19,11 +19,44 @@ func NewJohnsonSN(gamma, delta, location, scale float64) (*JohnsonSN, error) {
 }
 
 func NewJohnsonSNWithSource(gamma, delta, location, scale float64, src rand.Source) (*JohnsonSN, error) {
-	if delta <= 0 && scale <= 0 {
-		return nil, err.Invalid()
+	r := &JohnsonSN{gamma, delta, location, scale, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &JohnsonSN{gamma, delta, location, scale, src}, nil
+	return r, nil
+}
+
+func (j *JohnsonSN) spec() (string, []Common, []float64) {
+	return "JohnsonSN", nil, []float64{j.gamma, j.delta, j.location, j.scale}
+}
+
+func (j *JohnsonSN) String() string {
+	return specString(j.spec())
+}
+
+func (j *JohnsonSN) MarshalJSON() ([]byte, error) {
+	return marshalJSON(j)
+}
+
+func (j *JohnsonSN) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, j, j.src)
+}
+
+func (j *JohnsonSN) MarshalText() ([]byte, error) {
+	return []byte(j.String()), nil
+}
+
+func (j *JohnsonSN) UnmarshalText(text []byte) error {
+	return unmarshalText(text, j, j.src)
+}
+
+func (j *JohnsonSN) ParameterValues() map[string]float64 {
+	return parameterValues(j)
+}
+
+func (j *JohnsonSN) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(j, params, j.src)
 }
 
 // γ ∈ (-∞,∞)
@@ -86,6 +119,18 @@ func (j *JohnsonSN) Inverse(q float64) float64 {
 
 }
 
+func (j *JohnsonSN) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return j.location + (j.scale*(-j.gamma-smath.Ndtri(q)))/j.delta
+}
+
 func (j *JohnsonSN) Rand() float64 {
 	var rnd float64
 	if j.src != nil {
@@ -2,7 +2,7 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
+	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
 )
@@ -19,11 +19,44 @@ func NewJohnsonSU(gamma, delta, location, scale float64) (*JohnsonSU, error) {
 }
 
 func NewJohnsonSUWithSource(gamma, delta, location, scale float64, src rand.Source) (*JohnsonSU, error) {
-	if delta <= 0 && scale <= 0 {
-		return nil, err.Invalid()
+	r := &JohnsonSU{gamma, delta, location, scale, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &JohnsonSU{gamma, delta, location, scale, src}, nil
+	return r, nil
+}
+
+func (j *JohnsonSU) spec() (string, []Common, []float64) {
+	return "JohnsonSU", nil, []float64{j.gamma, j.delta, j.location, j.scale}
+}
+
+func (j *JohnsonSU) String() string {
+	return specString(j.spec())
+}
+
+func (j *JohnsonSU) MarshalJSON() ([]byte, error) {
+	return marshalJSON(j)
+}
+
+func (j *JohnsonSU) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, j, j.src)
+}
+
+func (j *JohnsonSU) MarshalText() ([]byte, error) {
+	return []byte(j.String()), nil
+}
+
+func (j *JohnsonSU) UnmarshalText(text []byte) error {
+	return unmarshalText(text, j, j.src)
+}
+
+func (j *JohnsonSU) ParameterValues() map[string]float64 {
+	return parameterValues(j)
+}
+
+func (j *JohnsonSU) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(j, params, j.src)
 }
 
 // γ ∈ (-∞,∞)
@@ -49,7 +82,7 @@ func (j *JohnsonSU) Probability(x float64) float64 {
 }
 
 func (j *JohnsonSU) Distribution(x float64) float64 {
-	return .5 * (1 + math.Erf(j.gamma+j.delta*math.Asinh((x-j.location)/j.scale)/math.Sqrt(2)))
+	return .5 * (1 + math.Erf((j.gamma+j.delta*math.Asinh((x-j.location)/j.scale))/math.Sqrt(2)))
 }
 
 func (j *JohnsonSU) Mean() float64 {
@@ -88,6 +121,18 @@ func (j *JohnsonSU) Inverse(q float64) float64 {
 	return j.location + j.scale*math.Sinh((-j.gamma+math.Sqrt(2)*math.Erfinv(-1+2*q))/j.delta)
 }
 
+func (j *JohnsonSU) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return j.location + j.scale*math.Sinh((-j.gamma-smath.Ndtri(q))/j.delta)
+}
+
 func (j *JohnsonSU) Rand() float64 {
 	var rnd float64
 	if j.src != nil {
@@ -0,0 +1,489 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/err"
+	smath "github.com/jtejido/stats/math"
+	"github.com/jtejido/stats/sample"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Kernel selects the kernel of a KDE, scaled to unit variance so that the bandwidth is its standard
+// deviation, as in R's density.
+type Kernel int
+
+const (
+	GaussianKernel     Kernel = iota // Normal(0, 1)
+	EpanechnikovKernel               // 3/4(1-u²) on [-1,1], which is 2Y-1 for Y from Beta(2, 2)
+	BiweightKernel                   // 15/16(1-u²)² on [-1,1], which is 2Y-1 for Y from Beta(3, 3)
+	TriangularKernel                 // Triangular(-1, 1, 0)
+	VonMisesKernel                   // VonMises(0, 1/h²), for angles
+)
+
+var kernelNames = map[Kernel]string{
+	GaussianKernel:     "Gaussian",
+	EpanechnikovKernel: "Epanechnikov",
+	BiweightKernel:     "biweight",
+	TriangularKernel:   "triangular",
+	VonMisesKernel:     "von Mises",
+}
+
+func (k Kernel) String() string {
+	if s, ok := kernelNames[k]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("Kernel(%d)", int(k))
+}
+
+// Beyond this many standard deviations the Gaussian kernel underflows.
+const gaussianRadius = 40
+
+// A kernel of unit variance, that of a + bY for Y drawn from d, which vanishes beyond radius.
+type kernel struct {
+	d      Common
+	a, b   float64
+	radius float64
+}
+
+func newKernel(k Kernel, src rand.Source) kernel {
+	switch k {
+	case EpanechnikovKernel:
+		d, _ := NewBetaWithSource(2, 2, src)
+		return kernel{d, -math.Sqrt(5), 2 * math.Sqrt(5), math.Sqrt(5)}
+	case BiweightKernel:
+		d, _ := NewBetaWithSource(3, 3, src)
+		return kernel{d, -math.Sqrt(7), 2 * math.Sqrt(7), math.Sqrt(7)}
+	case TriangularKernel:
+		d, _ := NewTriangularWithSource(-math.Sqrt(6), math.Sqrt(6), 0, src)
+		return kernel{d, 0, 1, math.Sqrt(6)}
+	}
+
+	d, _ := NewNormalWithSource(0, 1, src)
+	return kernel{d, 0, 1, gaussianRadius}
+}
+
+func (k kernel) pdf(u float64) float64 {
+	return k.d.Probability((u-k.a)/k.b) / k.b
+}
+
+func (k kernel) cdf(u float64) float64 {
+	return k.d.Distribution((u - k.a) / k.b)
+}
+
+func (k kernel) rand() float64 {
+	return k.a + k.b*k.d.Rand()
+}
+
+// Excess kurtosis of the kernel, which is that of d.
+func (k kernel) exKurtosis() float64 {
+	return k.d.(interface{ ExKurtosis() float64 }).ExKurtosis()
+}
+
+// KDE is the kernel density estimate (1/nh) Σ K((x-xᵢ)/h) of a sample x for a kernel K of unit variance
+// and the bandwidth h, its standard deviation, chosen e.g. by SelectBandwidth. For the von Mises kernel
+// the sample holds angles, wrapped into [-π,π), and the kernel is VonMises(xᵢ, 1/h²). Probability and
+// Distribution take O(n) time, or less for the bounded kernels; Grid evaluates the density on a grid of m
+// points in O(n + m log m).
+// https://en.wikipedia.org/wiki/Kernel_density_estimation
+// B. W. Silverman, Density Estimation for Statistics and Data Analysis, Chapman and Hall, 1986
+type KDE struct {
+	baseContinuousWithSource
+	x                []float64 // sorted
+	kernel           Kernel
+	h                float64
+	k                kernel    // the location-scale kernels
+	vm               *VonMises // the von Mises kernel, at 0
+	mean, m2, m3, m4 float64   // moments of the sample
+}
+
+func NewKDE(x []float64, k Kernel, h float64) (*KDE, error) {
+	return NewKDEWithSource(x, k, h, nil)
+}
+
+// NewKDEWithSource returns the kernel density estimate of x, which is copied, with kernel k and
+// bandwidth h > 0.
+func NewKDEWithSource(x []float64, k Kernel, h float64, src rand.Source) (*KDE, error) {
+	if _, ok := kernelNames[k]; !ok {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("KDE: unknown kernel %v", k))
+	}
+
+	if !(h > 0) || math.IsInf(h, 1) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("KDE: bandwidth %v is not positive", h))
+	}
+
+	xs, e := kdeSample("KDE", x, k, 1)
+	if e != nil {
+		return nil, e
+	}
+
+	r := &KDE{x: xs, kernel: k, h: h}
+	r.src = src
+	if k == VonMisesKernel {
+		r.vm, _ = NewVonMisesWithSource(0, 1/(h*h), DefaultCircularSupport, src)
+		return r, nil
+	}
+
+	r.k = newKernel(k, src)
+	r.mean = sample.Mean(xs, nil)
+	for _, v := range xs {
+		d := v - r.mean
+		r.m2 += d * d
+		r.m3 += d * d * d
+		r.m4 += d * d * d * d
+	}
+	n := float64(len(xs))
+	r.m2, r.m3, r.m4 = r.m2/n, r.m3/n, r.m4/n
+
+	return r, nil
+}
+
+// A sorted copy of x, with angles wrapped into [-π,π) for the von Mises kernel, which needs at least min
+// observations, all finite.
+func kdeSample(name string, x []float64, k Kernel, min int) ([]float64, error) {
+	if len(x) < min {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("%s: %v observations are fewer than %v", name, len(x), min))
+	}
+
+	xs := make([]float64, len(x))
+	for i, v := range x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s: observation %v is not finite", name, v))
+		}
+
+		if k == VonMisesKernel {
+			v = smath.WrapRange(v, -math.Pi, math.Pi, false)
+		}
+		xs[i] = v
+	}
+	sort.Float64s(xs)
+
+	return xs, nil
+}
+
+// String describes e, but as its sample is not part of it, it is not a spec that Parse accepts.
+func (e *KDE) String() string {
+	return fmt.Sprintf("KDE(%d observations, %v kernel, h=%v)", len(e.x), e.kernel, e.h)
+}
+
+// Kernel returns the kernel of e.
+func (e *KDE) Kernel() Kernel {
+	return e.kernel
+}
+
+// Bandwidth returns h, the standard deviation of the kernel.
+func (e *KDE) Bandwidth() float64 {
+	return e.h
+}
+
+// x ∈ [x₍₁₎-rh,x₍ₙ₎+rh] for a kernel vanishing beyond r, (-∞,∞) for the Gaussian and [-π,π) for the von
+// Mises kernel
+func (e *KDE) Support() stats.Interval {
+	switch e.kernel {
+	case VonMisesKernel:
+		return DefaultCircularSupport
+	case GaussianKernel:
+		return stats.Interval{math.Inf(-1), math.Inf(1), true, true}
+	}
+
+	r := e.k.radius * e.h
+	return stats.Interval{e.x[0] - r, e.x[len(e.x)-1] + r, false, false}
+}
+
+// The observations within reach of the kernel at x, [lo, hi).
+func (e *KDE) window(x float64) (lo, hi int) {
+	r := e.k.radius * e.h
+	lo = sort.SearchFloat64s(e.x, x-r)
+	hi = sort.Search(len(e.x), func(i int) bool { return e.x[i] > x+r })
+	return lo, hi
+}
+
+func (e *KDE) Probability(x float64) float64 {
+	n := float64(len(e.x))
+	if math.IsNaN(x) {
+		return math.NaN()
+	}
+
+	var sum float64
+	if e.vm != nil {
+		if !e.Support().IsWithinInterval(x) {
+			return 0
+		}
+
+		for _, v := range e.x {
+			sum += e.vm.Probability(smath.WrapRange(x-v, -math.Pi, math.Pi, false))
+		}
+		return sum / n
+	}
+
+	lo, hi := e.window(x)
+	for _, v := range e.x[lo:hi] {
+		sum += e.k.pdf((x - v) / e.h)
+	}
+
+	return sum / (n * e.h)
+}
+
+func (e *KDE) Distribution(x float64) float64 {
+	n := float64(len(e.x))
+	if math.IsNaN(x) {
+		return math.NaN()
+	}
+
+	if e.vm != nil {
+		s := e.Support()
+		switch {
+		case x < s.Lower:
+			return 0
+		case x >= s.Upper:
+			return 1
+		}
+
+		// the mass of each kernel over [-π, x]
+		var sum float64
+		for _, v := range e.x {
+			sum += e.unwrappedVonMises(x-v) - e.unwrappedVonMises(s.Lower-v)
+		}
+		return sum / n
+	}
+
+	// the observations below the window add 1 each
+	lo, hi := e.window(x)
+	sum := float64(lo)
+	for _, v := range e.x[lo:hi] {
+		sum += e.k.cdf((x - v) / e.h)
+	}
+
+	return sum / n
+}
+
+// The cdf of the von Mises kernel on [-π,π), extended to the line by adding 1 each turn.
+func (e *KDE) unwrappedVonMises(t float64) float64 {
+	return math.Floor((t+math.Pi)/(2*math.Pi)) + e.vm.Distribution(smath.WrapRange(t, -math.Pi, math.Pi, false))
+}
+
+func (e *KDE) Inverse(p float64) float64 {
+	s := e.Support()
+	if p <= 0 {
+		return s.Lower
+	}
+
+	if p >= 1 {
+		return s.Upper
+	}
+
+	x0 := math.NaN()
+	if e.vm == nil {
+		x0 = e.x[int(p*float64(len(e.x)-1))]
+	}
+
+	return inverse(nil, e.Distribution, e.Probability, s.Lower, s.Upper, x0, p)
+}
+
+// Mass of e above x, summed from the observations above it rather than taken as 1 - Distribution(x), so
+// that it keeps its digits in the upper tail. The kernels are symmetric, and the mass of the kernel at v
+// above x is its cdf at (v-x)/h.
+func (e *KDE) survival(x float64) float64 {
+	n := float64(len(e.x))
+	if math.IsNaN(x) {
+		return math.NaN()
+	}
+
+	if e.vm != nil {
+		s := e.Support()
+		switch {
+		case x < s.Lower:
+			return 1
+		case x >= s.Upper:
+			return 0
+		}
+
+		return arcSurvival(e.Distribution, e.Probability, x, s.Upper)
+	}
+
+	// the observations above the window add 1 each
+	lo, hi := e.window(x)
+	sum := float64(len(e.x) - hi)
+	for _, v := range e.x[lo:hi] {
+		sum += e.k.cdf((v - x) / e.h)
+	}
+
+	return sum / n
+}
+
+func (e *KDE) InverseSurvival(q float64) float64 {
+	s := e.Support()
+	if q >= 1 {
+		return s.Lower
+	}
+
+	if q <= 0 {
+		return s.Upper
+	}
+
+	x0 := math.NaN()
+	if e.vm == nil {
+		x0 = e.x[int((1-q)*float64(len(e.x)-1))]
+	}
+
+	return inverseSurvival(nil, e.survival, e.Probability, s.Lower, s.Upper, x0, q)
+}
+
+// Mean returns the mean of the sample, NaN for the von Mises kernel.
+func (e *KDE) Mean() float64 {
+	if e.vm != nil {
+		return math.NaN()
+	}
+
+	return e.mean
+}
+
+func (e *KDE) Median() float64 {
+	return e.Inverse(.5)
+}
+
+// Variance returns σ² + h² for the variance σ² of the sample, NaN for the von Mises kernel.
+func (e *KDE) Variance() float64 {
+	if e.vm != nil {
+		return math.NaN()
+	}
+
+	return e.m2 + e.h*e.h
+}
+
+func (e *KDE) Skewness() float64 {
+	if e.vm != nil {
+		return math.NaN()
+	}
+
+	return e.m3 / math.Pow(e.Variance(), 1.5)
+}
+
+// ExKurtosis adds the fourth cumulants of the sample and the kernel, as for a sum of independent
+// variables.
+func (e *KDE) ExKurtosis() float64 {
+	if e.vm != nil {
+		return math.NaN()
+	}
+
+	v := e.Variance()
+	h2 := e.h * e.h
+	return (e.m4 - 3*e.m2*e.m2 + h2*h2*e.k.exKurtosis()) / (v * v)
+}
+
+// Rand returns an observation picked at random, plus a draw from the kernel.
+func (e *KDE) Rand() float64 {
+	var rnd *rand.Rand
+	if e.src != nil {
+		rnd = rand.New(e.src)
+	}
+
+	x := e.x[intn(rnd, len(e.x))]
+	if e.vm != nil {
+		return smath.WrapRange(x+e.vm.Rand(), -math.Pi, math.Pi, false)
+	}
+
+	return x + e.h*e.k.rand()
+}
+
+// Grid returns the density at m ≥ 2 equally spaced points, from 3h below the smallest observation to 3h
+// above the largest, or at most as far as the kernel reaches, and over [-π,π) for the von Mises kernel.
+// The sample is binned linearly onto the grid, extended by the reach of the kernel, and the bins are
+// convolved with the kernel by FFT, which is accurate to O(δ²) for the spacing δ.
+//
+// M. C. Jones and H. W. Lotwick, "Remark AS R50: A remark on algorithm AS 176. Kernel density estimation
+// using the fast Fourier transform," Journal of the Royal Statistical Society. Series C, vol. 33, no. 1,
+// pp. 120-122, 1984.
+func (e *KDE) Grid(m int) ([]float64, []float64, error) {
+	if m < 2 {
+		return nil, nil, err.New(err.EINVAL, fmt.Sprintf("KDE: grid of %v points is fewer than 2", m))
+	}
+
+	var x, f []float64
+	n := float64(len(e.x))
+	if e.vm != nil {
+		delta := 2 * math.Pi / float64(m)
+		counts := make([]float64, m)
+		for _, v := range e.x {
+			t := (v + math.Pi) / delta
+			j := int(t) % m
+			counts[j] += 1 - (t - math.Floor(t))
+			counts[(j+1)%m] += t - math.Floor(t)
+		}
+
+		f = convolve(counts, func(d float64) float64 {
+			return e.vm.Probability(smath.WrapRange(d, -math.Pi, math.Pi, false))
+		}, delta)
+
+		x = make([]float64, m)
+		for j := range x {
+			x[j] = -math.Pi + float64(j)*delta
+			f[j] /= n
+		}
+		return x, f, nil
+	}
+
+	reach := math.Min(e.k.radius, 8) * e.h
+	cut := math.Min(e.k.radius, 3) * e.h
+	lo, hi := e.x[0]-cut, e.x[len(e.x)-1]+cut
+	delta := (hi - lo) / float64(m-1)
+
+	// the grid extended by K points either side, where observations reach
+	K := int(math.Ceil(reach / delta))
+	counts := make([]float64, m+2*K)
+	for _, v := range e.x {
+		t := (v-lo)/delta + float64(K)
+		j := int(t)
+		if j >= len(counts)-1 {
+			j = len(counts) - 2
+		}
+		counts[j] += 1 - (t - float64(j))
+		counts[j+1] += t - float64(j)
+	}
+
+	g := convolve(counts, func(d float64) float64 { return e.k.pdf(d/e.h) / e.h }, delta)
+	x, f = make([]float64, m), make([]float64, m)
+	for j := range x {
+		x[j] = lo + float64(j)*delta
+		f[j] = math.Max(0, g[j+K]) / n
+	}
+
+	return x, f, nil
+}
+
+// Σᵢ counts[i] k((j-i)δ) for each j, by FFT.
+func convolve(counts []float64, k func(float64) float64, delta float64) []float64 {
+	N := len(counts)
+	P := 1
+	for P < 2*N {
+		P <<= 1
+	}
+
+	a := make([]complex128, P)
+	kv := make([]complex128, P)
+	for i, c := range counts {
+		a[i] = complex(c, 0)
+		kv[i] = complex(k(float64(i)*delta), 0)
+		if i > 0 {
+			kv[P-i] = complex(k(-float64(i)*delta), 0)
+		}
+	}
+
+	// P is a power of 2, which the FFT takes without error
+	A, _ := smath.FFT(a)
+	Kv, _ := smath.FFT(kv)
+	for i := range A {
+		A[i] *= Kv[i]
+	}
+	c, _ := smath.InverseFFT(A)
+
+	out := make([]float64, N)
+	for i := range out {
+		out[i] = real(c[i])
+	}
+
+	return out
+}
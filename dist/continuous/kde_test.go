@@ -0,0 +1,135 @@
+package continuous
+
+import (
+	stattest "github.com/jtejido/stats/testing"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// The kernels at unit variance, written out.
+var unitKernels = map[Kernel]func(u float64) float64{
+	GaussianKernel: func(u float64) float64 { return math.Exp(-u*u/2) / math.Sqrt(2*math.Pi) },
+	EpanechnikovKernel: func(u float64) float64 {
+		return math.Max(0, 3/(4*math.Sqrt(5))*(1-u*u/5))
+	},
+	BiweightKernel: func(u float64) float64 {
+		if v := 1 - u*u/7; v > 0 {
+			return 15 / (16 * math.Sqrt(7)) * v * v
+		}
+		return 0
+	},
+	TriangularKernel: func(u float64) float64 {
+		return math.Max(0, (1-math.Abs(u)/math.Sqrt(6))/math.Sqrt(6))
+	},
+}
+
+func TestKDEProbability(t *testing.T) {
+	h := .8
+	for k, f := range unitKernels {
+		e, _ := NewKDE(empiricalData, k, h)
+		for _, x := range []float64{-1, .7, 2, 4.5, 9.2, 11} {
+			var want float64
+			for _, v := range empiricalData {
+				want += f((x-v)/h) / (h * float64(len(empiricalData)))
+			}
+
+			run_test(t, e.Probability(x), want, 1e-13, "KDE "+k.String()+" Probability")
+		}
+	}
+
+	// circular, the sum over the wrapped kernels
+	angles := []float64{-3, -2.5, .1, .4, 3.1, 7}
+	e, _ := NewKDE(angles, VonMisesKernel, .5)
+	for _, x := range []float64{-math.Pi, -2.9, 0, 3} {
+		var want float64
+		for _, v := range angles {
+			for k := -20.; k <= 20; k++ {
+				want += math.Exp(-math.Pow(x-v+2*math.Pi*k, 2)/(2*.25)) / math.Sqrt(2*math.Pi*.25)
+			}
+		}
+
+		// the von Mises kernel approaches the wrapped normal as κ = 1/h² grows
+		run_test(t, e.Probability(x), want/float64(len(angles)), .02, "KDE von Mises Probability")
+	}
+}
+
+// Each KDE passes the conformance checks, its Rand and moments included.
+func TestKDEConformance(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, 200)
+	for i := range x {
+		x[i] = rnd.NormFloat64() + 3*float64(i%2)
+	}
+
+	for _, k := range []Kernel{GaussianKernel, EpanechnikovKernel, BiweightKernel, TriangularKernel, VonMisesKernel} {
+		t.Run(k.String(), func(t *testing.T) {
+			h, _ := SelectBandwidth(x, k, SilvermanBandwidth)
+			e, _ := NewKDEWithSource(x, k, h, rand.NewSource(2))
+			stattest.Conformance(t, e, stattest.Config{Tol: 1e-7})
+		})
+	}
+}
+
+// The FFT on the binned sample agrees with direct evaluation to O(δ²).
+func TestKDEGrid(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	x := make([]float64, 5000)
+	for i := range x {
+		x[i] = rnd.ExpFloat64()
+	}
+
+	for _, k := range []Kernel{GaussianKernel, EpanechnikovKernel, VonMisesKernel} {
+		h, _ := SelectBandwidth(x, k, SilvermanBandwidth)
+		e, _ := NewKDE(x, k, h)
+		g, f, ge := e.Grid(512)
+		if ge != nil {
+			t.Fatalf("Mismatch. %v grid want: nil, got: %v", k, ge)
+		}
+
+		if len(g) != 512 || len(f) != 512 {
+			t.Fatalf("Mismatch. %v grid length want: 512, got: %v, %v", k, len(g), len(f))
+		}
+
+		var max, worst float64
+		for j := range g {
+			p := e.Probability(g[j])
+			max = math.Max(max, p)
+			worst = math.Max(worst, math.Abs(f[j]-p))
+		}
+
+		if worst > 2e-3*max {
+			t.Errorf("Mismatch. %v grid want: within %v, got: off by %v", k, 2e-3*max, worst)
+		}
+	}
+}
+
+// The upper quantiles of the von Mises kernel, checked by integrating the density up to π.
+func TestKDEInverseSurvival(t *testing.T) {
+	e, _ := NewKDE([]float64{-3, -2.5, .1, .4, 3.1, 7}, VonMisesKernel, .5)
+	for _, q := range []float64{.9, .5, .1, 1e-3, 1e-9} {
+		x := e.InverseSurvival(q)
+		run_test(t, quadrature(e.Probability, x, math.Pi), q, 1e-8, "KDE von Mises InverseSurvival")
+	}
+
+	run_test(t, e.InverseSurvival(.3), e.Inverse(.7), 1e-9, "KDE von Mises InverseSurvival")
+}
+
+func TestKDEErrors(t *testing.T) {
+	if _, e := NewKDE(nil, GaussianKernel, 1); e == nil {
+		t.Errorf("Mismatch. no observations want: error, got: nil")
+	}
+
+	if _, e := NewKDE(empiricalData, GaussianKernel, 0); e == nil {
+		t.Errorf("Mismatch. h = 0 want: error, got: nil")
+	}
+
+	e, _ := NewKDE(empiricalData, GaussianKernel, 1)
+	if x, f, ge := e.Grid(1); ge == nil || x != nil || f != nil {
+		t.Errorf("Mismatch. grid of 1 point want: error, got: %v", ge)
+	}
+
+	if _, e := NewKDE(empiricalData, Kernel(9), 1); e == nil {
+		t.Errorf("Mismatch. unknown kernel want: error, got: nil")
+	}
+}
@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -21,19 +20,52 @@ func NewKumaraswamy(a, b float64) (*Kumaraswamy, error) {
 }
 
 func NewKumaraswamyWithSource(a, b float64, src rand.Source) (*Kumaraswamy, error) {
-	if a <= 0 || b <= 0 {
-		return nil, err.Invalid()
+	r := &Kumaraswamy{a, b, nil}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Kumaraswamy{a, b, nil}, nil
+	return r, nil
 }
 
-// a ∈ [0,∞)
-// b ∈ [0,∞)
+func (k *Kumaraswamy) spec() (string, []Common, []float64) {
+	return "Kumaraswamy", nil, []float64{k.a, k.b}
+}
+
+func (k *Kumaraswamy) String() string {
+	return specString(k.spec())
+}
+
+func (k *Kumaraswamy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(k)
+}
+
+func (k *Kumaraswamy) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, k, k.src)
+}
+
+func (k *Kumaraswamy) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+func (k *Kumaraswamy) UnmarshalText(text []byte) error {
+	return unmarshalText(text, k, k.src)
+}
+
+func (k *Kumaraswamy) ParameterValues() map[string]float64 {
+	return parameterValues(k)
+}
+
+func (k *Kumaraswamy) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(k, params, k.src)
+}
+
+// a ∈ (0,∞)
+// b ∈ (0,∞)
 func (k *Kumaraswamy) Parameters() stats.Limits {
 	return stats.Limits{
-		"a": stats.Interval{0, math.Inf(1), false, true},
-		"b": stats.Interval{0, math.Inf(1), false, true},
+		"a": stats.Interval{0, math.Inf(1), true, true},
+		"b": stats.Interval{0, math.Inf(1), true, true},
 	}
 }
 
@@ -117,6 +149,18 @@ func (k *Kumaraswamy) Inverse(p float64) float64 {
 	return math.Pow(1-math.Pow(1-p, 1/k.b), 1/k.a)
 }
 
+func (k *Kumaraswamy) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return 1
+	}
+
+	return math.Pow(-math.Expm1(math.Log(q)/k.b), 1/k.a)
+}
+
 func (k *Kumaraswamy) Rand() float64 {
 	var rnd float64
 	if k.src != nil {
@@ -3,8 +3,8 @@ package continuous
 import (
 	"github.com/jtejido/linear"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
+	"math/cmplx"
 	"math/rand"
 )
 
@@ -21,11 +21,44 @@ func NewLaplace(location, scale float64) (*Laplace, error) {
 }
 
 func NewLaplaceWithSource(location, scale float64, src rand.Source) (*Laplace, error) {
-	if scale <= 0 {
-		return nil, err.Invalid()
+	r := &Laplace{location, scale, src, nil}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Laplace{location, scale, src, nil}, nil
+	return r, nil
+}
+
+func (l *Laplace) spec() (string, []Common, []float64) {
+	return "Laplace", nil, []float64{l.location, l.scale}
+}
+
+func (l *Laplace) String() string {
+	return specString(l.spec())
+}
+
+func (l *Laplace) MarshalJSON() ([]byte, error) {
+	return marshalJSON(l)
+}
+
+func (l *Laplace) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, l, l.src)
+}
+
+func (l *Laplace) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+func (l *Laplace) UnmarshalText(text []byte) error {
+	return unmarshalText(text, l, l.src)
+}
+
+func (l *Laplace) ParameterValues() map[string]float64 {
+	return parameterValues(l)
+}
+
+func (l *Laplace) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(l, params, l.src)
 }
 
 // μ ∈ (-∞,∞)
@@ -93,6 +126,19 @@ func (l *Laplace) Inverse(p float64) float64 {
 	return l.location - math.Log(2*(1-p))*l.scale
 }
 
+func (l *Laplace) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// symmetric about μ
+	return 2*l.location - l.Inverse(q)
+}
+
 func (l *Laplace) Mean() float64 {
 	return l.location
 }
@@ -109,6 +155,11 @@ func (l *Laplace) Variance() float64 {
 	return 2 * (l.scale * l.scale)
 }
 
+// e^{iμt}/(1 + b²t²)
+func (l *Laplace) CharacteristicFunction(t float64) complex128 {
+	return cmplx.Exp(complex(0, l.location*t)) / complex(1+l.scale*l.scale*t*t, 0)
+}
+
 func (l *Laplace) Rand() float64 {
 	var rnd float64
 	if l.src == nil {
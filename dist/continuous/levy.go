@@ -4,7 +4,6 @@ import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -21,18 +20,51 @@ func NewLevy(location, scale float64) (*Levy, error) {
 }
 
 func NewLevyWithSource(location, scale float64, src rand.Source) (*Levy, error) {
-	if location < 0 || scale <= 0 {
-		return nil, err.Invalid()
+	r := &Levy{location, scale, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Levy{location, scale, src}, nil
+	return r, nil
 }
 
-// μ ∈ [0,∞)
+func (l *Levy) spec() (string, []Common, []float64) {
+	return "Levy", nil, []float64{l.location, l.scale}
+}
+
+func (l *Levy) String() string {
+	return specString(l.spec())
+}
+
+func (l *Levy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(l)
+}
+
+func (l *Levy) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, l, l.src)
+}
+
+func (l *Levy) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+func (l *Levy) UnmarshalText(text []byte) error {
+	return unmarshalText(text, l, l.src)
+}
+
+func (l *Levy) ParameterValues() map[string]float64 {
+	return parameterValues(l)
+}
+
+func (l *Levy) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(l, params, l.src)
+}
+
+// μ ∈ (-∞,∞)
 // c ∈ (0,∞)
 func (l *Levy) Parameters() stats.Limits {
 	return stats.Limits{
-		"μ": stats.Interval{0, math.Inf(1), false, true},
+		"μ": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
 		"c": stats.Interval{0, math.Inf(1), true, true},
 	}
 }
@@ -83,6 +115,20 @@ func (l *Levy) Inverse(p float64) float64 {
 	return l.location + (l.scale / math.Pow(sn.Inverse(1-p/2), 2))
 }
 
+func (l *Levy) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return l.location
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// S(x) = erf(√(c/2(x-μ)))
+	e := math.Erfinv(q)
+	return l.location + l.scale/(2*e*e)
+}
+
 func (l *Levy) Mean() float64 {
 	return math.Inf(1)
 }
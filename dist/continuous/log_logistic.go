@@ -2,7 +2,6 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"github.com/jtejido/trig"
 	"math"
 	"math/rand"
@@ -22,11 +21,44 @@ func NewLogLogistic(scale, shape, location float64) (*LogLogistic, error) {
 }
 
 func NewLogLogisticWithSource(scale, shape, location float64, src rand.Source) (*LogLogistic, error) {
-	if scale <= 0 || shape <= 0 {
-		return nil, err.Invalid()
+	r := &LogLogistic{scale, shape, location, nil}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &LogLogistic{scale, shape, location, nil}, nil
+	return r, nil
+}
+
+func (ll *LogLogistic) spec() (string, []Common, []float64) {
+	return "LogLogistic", nil, []float64{ll.scale, ll.shape, ll.location}
+}
+
+func (ll *LogLogistic) String() string {
+	return specString(ll.spec())
+}
+
+func (ll *LogLogistic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(ll)
+}
+
+func (ll *LogLogistic) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, ll, ll.src)
+}
+
+func (ll *LogLogistic) MarshalText() ([]byte, error) {
+	return []byte(ll.String()), nil
+}
+
+func (ll *LogLogistic) UnmarshalText(text []byte) error {
+	return unmarshalText(text, ll, ll.src)
+}
+
+func (ll *LogLogistic) ParameterValues() map[string]float64 {
+	return parameterValues(ll)
+}
+
+func (ll *LogLogistic) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(ll, params, ll.src)
 }
 
 // α ∈ (0,∞)
@@ -68,7 +100,7 @@ func (ll *LogLogistic) Distribution(x float64) float64 {
 
 func (ll *LogLogistic) Inverse(p float64) float64 {
 	if p <= 0 {
-		return 0
+		return ll.location
 	}
 
 	if p >= 1 {
@@ -78,6 +110,18 @@ func (ll *LogLogistic) Inverse(p float64) float64 {
 	return (ll.scale * math.Pow(p/(1-p), 1/ll.shape)) + ll.location
 }
 
+func (ll *LogLogistic) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return ll.location
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return (ll.scale * math.Pow((1-q)/q, 1/ll.shape)) + ll.location
+}
+
 func (ll *LogLogistic) Mean() float64 {
 	if ll.shape <= 1 {
 		return math.NaN()
@@ -2,7 +2,6 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -19,11 +18,60 @@ func NewLogNormal(location, scale float64) (*LogNormal, error) {
 }
 
 func NewLogNormalWithSource(location, scale float64, src rand.Source) (*LogNormal, error) {
-	if scale <= 0 {
-		return nil, err.Invalid()
+	r := &LogNormal{location, scale, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &LogNormal{location, scale, src}, nil
+	return r, nil
+}
+
+// NewLogNormalFromMoments returns the LogNormal distribution of the mean and variance of m, by the
+// method of moments: σ² = log(1 + variance/mean²) and μ = log(mean) - σ²/2.
+func NewLogNormalFromMoments(m SampleMoments) (*LogNormal, error) {
+	return NewLogNormalFromMomentsWithSource(m, nil)
+}
+
+func NewLogNormalFromMomentsWithSource(m SampleMoments, src rand.Source) (*LogNormal, error) {
+	mean, v := m.Mean(), m.Variance()
+	if !(mean > 0 && v > 0 && !math.IsInf(mean, 0) && !math.IsInf(v, 0)) {
+		return nil, momentsError("LogNormal", mean, v)
+	}
+
+	s2 := math.Log1p(v / (mean * mean))
+	return NewLogNormalWithSource(math.Log(mean)-s2/2, math.Sqrt(s2), src)
+}
+
+func (ln *LogNormal) spec() (string, []Common, []float64) {
+	return "LogNormal", nil, []float64{ln.location, ln.scale}
+}
+
+func (ln *LogNormal) String() string {
+	return specString(ln.spec())
+}
+
+func (ln *LogNormal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(ln)
+}
+
+func (ln *LogNormal) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, ln, ln.src)
+}
+
+func (ln *LogNormal) MarshalText() ([]byte, error) {
+	return []byte(ln.String()), nil
+}
+
+func (ln *LogNormal) UnmarshalText(text []byte) error {
+	return unmarshalText(text, ln, ln.src)
+}
+
+func (ln *LogNormal) ParameterValues() map[string]float64 {
+	return parameterValues(ln)
+}
+
+func (ln *LogNormal) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(ln, params, ln.src)
 }
 
 // μ ∈ (-∞,∞)
@@ -87,6 +135,19 @@ func (ln *LogNormal) Inverse(p float64) float64 {
 	return math.Exp(d.Inverse(p))
 }
 
+func (ln *LogNormal) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	d := &Normal{ln.location, ln.scale, nil, nil}
+	return math.Exp(d.InverseSurvival(q))
+}
+
 func (ln *LogNormal) Mean() float64 {
 	return math.Exp(ln.location + ((ln.scale * ln.scale) / 2.))
 }
@@ -2,7 +2,6 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -19,15 +18,44 @@ func NewLogistic(location, scale float64) (*Logistic, error) {
 }
 
 func NewLogisticWithSource(location, scale float64, src rand.Source) (*Logistic, error) {
-	if scale <= 0 {
-		return nil, err.Invalid()
+	r := &Logistic{location, scale, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Logistic{location, scale, src}, nil
+	return r, nil
+}
+
+func (l *Logistic) spec() (string, []Common, []float64) {
+	return "Logistic", nil, []float64{l.location, l.scale}
 }
 
 func (l *Logistic) String() string {
-	return "Logistic: " + l.Parameters().String()
+	return specString(l.spec())
+}
+
+func (l *Logistic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(l)
+}
+
+func (l *Logistic) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, l, l.src)
+}
+
+func (l *Logistic) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+func (l *Logistic) UnmarshalText(text []byte) error {
+	return unmarshalText(text, l, l.src)
+}
+
+func (l *Logistic) ParameterValues() map[string]float64 {
+	return parameterValues(l)
+}
+
+func (l *Logistic) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(l, params, l.src)
 }
 
 // μ ∈ (-∞,∞)
@@ -87,6 +115,18 @@ func (l *Logistic) Inverse(p float64) float64 {
 	return l.location - l.scale*(math.Log1p(-p)-math.Log(p))
 }
 
+func (l *Logistic) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return l.location - l.scale*(math.Log(q)-math.Log1p(-q))
+}
+
 func (l *Logistic) Mean() float64 {
 	return l.location
 }
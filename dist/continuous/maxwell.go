@@ -3,7 +3,6 @@ package continuous
 import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -21,11 +20,44 @@ func NewMaxwellBoltzmann(scale float64) (*MaxwellBoltzmann, error) {
 }
 
 func NewMaxwellBoltzmannWithSource(scale float64, src rand.Source) (*MaxwellBoltzmann, error) {
-	if scale <= 0 {
-		return nil, err.Invalid()
+	r := &MaxwellBoltzmann{scale, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &MaxwellBoltzmann{scale, src}, nil
+	return r, nil
+}
+
+func (mb *MaxwellBoltzmann) spec() (string, []Common, []float64) {
+	return "MaxwellBoltzmann", nil, []float64{mb.scale}
+}
+
+func (mb *MaxwellBoltzmann) String() string {
+	return specString(mb.spec())
+}
+
+func (mb *MaxwellBoltzmann) MarshalJSON() ([]byte, error) {
+	return marshalJSON(mb)
+}
+
+func (mb *MaxwellBoltzmann) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, mb, mb.src)
+}
+
+func (mb *MaxwellBoltzmann) MarshalText() ([]byte, error) {
+	return []byte(mb.String()), nil
+}
+
+func (mb *MaxwellBoltzmann) UnmarshalText(text []byte) error {
+	return unmarshalText(text, mb, mb.src)
+}
+
+func (mb *MaxwellBoltzmann) ParameterValues() map[string]float64 {
+	return parameterValues(mb)
+}
+
+func (mb *MaxwellBoltzmann) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(mb, params, mb.src)
 }
 
 // σ ∈ (0,∞)
@@ -104,6 +136,18 @@ func (mb *MaxwellBoltzmann) Inverse(p float64) float64 {
 	return math.Sqrt(2) * mb.scale * math.Sqrt(smath.InverseRegularizedUpperIncompleteGamma(3./2, 1-p))
 }
 
+func (mb *MaxwellBoltzmann) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return math.Sqrt(2) * mb.scale * math.Sqrt(smath.InverseRegularizedUpperIncompleteGamma(3./2, q))
+}
+
 func (mb *MaxwellBoltzmann) Rand() float64 {
 	var rnd float64
 	if mb.src == nil {
@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -21,22 +20,55 @@ func NewModifiedPERT(min, max, mode, shape float64) (*ModifiedPERT, error) {
 }
 
 func NewModifiedPERTWithSource(min, max, mode, shape float64, src rand.Source) (*ModifiedPERT, error) {
-	if shape <= 0 || min <= 0 || mode <= min || max <= mode {
-		return nil, err.Invalid()
+	r := &ModifiedPERT{min, max, mode, shape, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &ModifiedPERT{min, max, mode, shape, src}, nil
+	return r, nil
 }
 
-// min ∈ (0,∞)
+func (p *ModifiedPERT) spec() (string, []Common, []float64) {
+	return "ModifiedPERT", nil, []float64{p.min, p.max, p.mode, p.shape}
+}
+
+func (p *ModifiedPERT) String() string {
+	return specString(p.spec())
+}
+
+func (p *ModifiedPERT) MarshalJSON() ([]byte, error) {
+	return marshalJSON(p)
+}
+
+func (p *ModifiedPERT) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, p, p.src)
+}
+
+func (p *ModifiedPERT) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ModifiedPERT) UnmarshalText(text []byte) error {
+	return unmarshalText(text, p, p.src)
+}
+
+func (p *ModifiedPERT) ParameterValues() map[string]float64 {
+	return parameterValues(p)
+}
+
+func (p *ModifiedPERT) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(p, params, p.src)
+}
+
+// min ∈ (-∞,∞)
 // mode ∈ (min,max)
 // max ∈ (mode,∞)
 // λ ∈ (0,∞)
 func (p *ModifiedPERT) Parameters() stats.Limits {
 	return stats.Limits{
-		"a": stats.Interval{0, math.Inf(1), true, true},
-		"b": stats.Interval{p.min, p.max, false, true},
-		"c": stats.Interval{p.mode, math.Inf(1), false, true},
+		"a": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
+		"b": stats.Interval{p.min, p.max, true, true},
+		"c": stats.Interval{p.mode, math.Inf(1), true, true},
 		"λ": stats.Interval{0, math.Inf(1), true, true},
 	}
 }
@@ -111,6 +143,21 @@ func (p *ModifiedPERT) Inverse(q float64) float64 {
 	return p.min + (p.max-p.min)*smath.InverseRegularizedIncompleteBeta(1+a, 1+b, q)
 }
 
+func (p *ModifiedPERT) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return p.min
+	}
+
+	if q <= 0 {
+		return p.max
+	}
+
+	a := p.alpha()
+	b := p.beta()
+
+	return p.max - (p.max-p.min)*smath.InverseRegularizedIncompleteBeta(1+b, 1+a, q)
+}
+
 func (p *ModifiedPERT) Mean() float64 {
 	return (p.max + p.min + p.mode*p.shape) / (2 + p.shape)
 }
@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -21,11 +20,44 @@ func NewNakagami(shape, spread float64) (*Nakagami, error) {
 }
 
 func NewNakagamiWithSource(shape, spread float64, src rand.Source) (*Nakagami, error) {
-	if shape < 0.5 || spread <= 0 {
-		return nil, err.Invalid()
+	r := &Nakagami{shape, spread, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Nakagami{shape, spread, src}, nil
+	return r, nil
+}
+
+func (n *Nakagami) spec() (string, []Common, []float64) {
+	return "Nakagami", nil, []float64{n.shape, n.spread}
+}
+
+func (n *Nakagami) String() string {
+	return specString(n.spec())
+}
+
+func (n *Nakagami) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n)
+}
+
+func (n *Nakagami) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, n, n.src)
+}
+
+func (n *Nakagami) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+func (n *Nakagami) UnmarshalText(text []byte) error {
+	return unmarshalText(text, n, n.src)
+}
+
+func (n *Nakagami) ParameterValues() map[string]float64 {
+	return parameterValues(n)
+}
+
+func (n *Nakagami) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(n, params, n.src)
 }
 
 // m ∈ [0.5,∞)
@@ -92,10 +124,22 @@ func (n *Nakagami) Inverse(p float64) float64 {
 	return math.Sqrt((n.spread * smath.InverseRegularizedLowerIncompleteGamma(n.shape, p)) / n.shape)
 }
 
+func (n *Nakagami) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return math.Sqrt((n.spread * smath.InverseRegularizedUpperIncompleteGamma(n.shape, q)) / n.shape)
+}
+
 func (n *Nakagami) Rand() float64 {
 	var g Gamma
 	g.shape = n.shape
-	g.rate = n.spread / n.shape
+	g.rate = n.shape / n.spread
 	g.src = n.src
 
 	return math.Sqrt(g.Rand())
@@ -1,23 +1,88 @@
 package continuous
 
 import (
+	"context"
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
 	"github.com/jtejido/stats/err"
 	"math"
+	"math/rand"
 )
 
 type NonCentralBeta struct {
+	baseContinuousWithSource
 	alpha, beta, lambda float64 // α, β, λ (noncentrality)
+	errs                *err.Config
 }
 
 func NewNonCentralBeta(alpha, beta, lambda float64) (*NonCentralBeta, error) {
-	if alpha <= 0 || beta <= 0 || lambda <= 0 {
-		return nil, err.Invalid()
+	return NewNonCentralBetaWithSource(alpha, beta, lambda, nil)
+}
+
+func NewNonCentralBetaWithSource(alpha, beta, lambda float64, src rand.Source) (*NonCentralBeta, error) {
+	r := new(NonCentralBeta)
+	r.alpha = alpha
+	r.beta = beta
+	r.lambda = lambda
+	r.src = src
+
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+// NewNonCentralBetaContext is NewNonCentralBetaWithSource reporting to the err.Config carried by ctx, if any.
+func NewNonCentralBetaContext(ctx context.Context, alpha, beta, lambda float64, src rand.Source) (*NonCentralBeta, error) {
+	r, e := NewNonCentralBetaWithSource(alpha, beta, lambda, src)
+	if e != nil {
+		return nil, e
 	}
 
-	return &NonCentralBeta{alpha, beta, lambda}, nil
+	r.errs = err.FromContext(ctx)
+	return r, nil
+}
+
+func (n *NonCentralBeta) spec() (string, []Common, []float64) {
+	return "NonCentralBeta", nil, []float64{n.alpha, n.beta, n.lambda}
+}
+
+func (n *NonCentralBeta) String() string {
+	return specString(n.spec())
+}
+
+func (n *NonCentralBeta) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n)
+}
+
+func (n *NonCentralBeta) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, n, n.src)
+}
+
+func (n *NonCentralBeta) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+func (n *NonCentralBeta) UnmarshalText(text []byte) error {
+	return unmarshalText(text, n, n.src)
+}
+
+func (n *NonCentralBeta) ParameterValues() map[string]float64 {
+	return parameterValues(n)
+}
+
+func (n *NonCentralBeta) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(n, params, n.src)
+}
+
+func (n *NonCentralBeta) config() *err.Config {
+	return n.errs
+}
+
+func (n *NonCentralBeta) setConfig(c *err.Config) {
+	n.errs = c
 }
 
 // α ∈ (0,∞)
@@ -38,7 +103,7 @@ func (n *NonCentralBeta) Support() stats.Interval {
 
 func (n *NonCentralBeta) Probability(x float64) float64 {
 	if n.Support().IsWithinInterval(x) {
-		num := math.Exp(-n.lambda/2) * math.Pow(1-x, -1+n.beta) * math.Pow(x, -1+n.alpha) * specfunc.Hyperg_1F1(n.alpha+n.beta, n.alpha, (x*n.lambda)/2)
+		num := math.Exp(-n.lambda/2) * math.Pow(1-x, -1+n.beta) * math.Pow(x, -1+n.alpha) * checkSpecial(n.errs, "NonCentralBeta: 1F1", x, specfunc.Hyperg_1F1(n.alpha+n.beta, n.alpha, (x*n.lambda)/2))
 		denom := specfunc.Beta(n.alpha, n.beta)
 		return num / denom
 	}
@@ -47,6 +112,10 @@ func (n *NonCentralBeta) Probability(x float64) float64 {
 }
 
 func (n *NonCentralBeta) Distribution(x float64) float64 {
+	if x >= n.Support().Upper {
+		return 1
+	}
+
 	if n.Support().IsWithinInterval(x) {
 		var j int
 		var sum, c float64
@@ -66,3 +135,46 @@ func (n *NonCentralBeta) Distribution(x float64) float64 {
 
 	return 0
 }
+
+func (n *NonCentralBeta) Inverse(p float64) float64 {
+	if p <= 0 {
+		return 0
+	}
+
+	if p >= 1 {
+		return 1
+	}
+
+	// start from the central Beta with α shifted by the Poisson mean λ/2
+	b := &Beta{alpha: n.alpha + n.lambda/2, beta: n.beta}
+
+	return inverse(n.errs, n.Distribution, n.Probability, 0, 1, b.Inverse(p), p)
+}
+
+func (n *NonCentralBeta) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return 1
+	}
+
+	b := &Beta{alpha: n.alpha + n.lambda/2, beta: n.beta}
+	// Poisson(λ/2) mixture of Beta(α+J, β) survival functions, summed term by term
+	sf := func(x float64) float64 {
+		return poissonMixture(n.lambda/2, func(j float64) float64 { return specfunc.Beta_inc(n.beta, n.alpha+j, 1-x) })
+	}
+
+	return inverseSurvival(n.errs, sf, n.Probability, 0, 1, b.InverseSurvival(q), q)
+}
+
+// Poisson mixture of central Beta variates: given J ~ Poisson(λ/2), X ~ Beta(α+J, β).
+func (n *NonCentralBeta) Rand() float64 {
+	var b Beta
+	b.alpha = n.alpha + poisson(n.src, n.lambda/2)
+	b.beta = n.beta
+	b.src = n.src
+
+	return b.Rand()
+}
@@ -1,6 +1,8 @@
 package continuous
 
 import (
+	"fmt"
+	"math/rand"
 	"strconv"
 	"testing"
 )
@@ -41,3 +43,39 @@ func TestNonCentralBetaDistribution(t *testing.T) {
 		})
 	}
 }
+
+func TestNonCentralBetaRand(t *testing.T) {
+	cases := []struct {
+		alpha, beta, lambda float64
+	}{
+		{1, 2, 3},
+		{.5, .5, 1},
+		{2, 5, 20},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			b, _ := NewNonCentralBetaWithSource(c.alpha, c.beta, c.lambda, rand.NewSource(int64(i+1)))
+			test_rand_ks(t, b.Rand, b.Distribution, 5000, fmt.Sprintf("NonCentralBetaRand(%v, %v, %v)", c.alpha, c.beta, c.lambda))
+		})
+	}
+}
+
+func TestNonCentralBetaInverseDistribution(t *testing.T) {
+	tol := 0.000000001
+	cases := []struct {
+		alpha, beta, lambda float64
+	}{
+		{1, 2, 3},
+		{.5, .5, 1},
+		{2, 5, 20},
+	}
+
+	for _, c := range cases {
+		for _, p := range []float64{.001, .1, .5, .9, .999} {
+			b := NonCentralBeta{alpha: c.alpha, beta: c.beta, lambda: c.lambda}
+			res := b.Distribution(b.Inverse(p))
+			run_test(t, res, p, tol, fmt.Sprintf("NonCentralBetaInverseDistribution(%v, %v, %v, %v)", c.alpha, c.beta, c.lambda, p))
+		}
+	}
+}
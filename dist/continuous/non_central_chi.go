@@ -1,26 +1,90 @@
 package continuous
 
 import (
+	"context"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
 	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
+	"math/rand"
 )
 
 // NonCentralChi distribution
 // https://en.wikipedia.org/wiki/Noncentral_chi_distribution
 type NonCentralChi struct {
+	baseContinuousWithSource
 	dof    int     // degrees of freedom
 	lambda float64 // λ, non-centrality
+	errs   *err.Config
 }
 
 func NewNonCentralChi(dof int, lambda float64) (*NonCentralChi, error) {
-	if dof <= 0 || lambda <= 0 {
-		return nil, err.Invalid()
+	return NewNonCentralChiWithSource(dof, lambda, nil)
+}
+
+func NewNonCentralChiWithSource(dof int, lambda float64, src rand.Source) (*NonCentralChi, error) {
+	r := new(NonCentralChi)
+	r.dof = dof
+	r.lambda = lambda
+	r.src = src
+
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &NonCentralChi{dof, lambda}, nil
+	return r, nil
+}
+
+// NewNonCentralChiContext is NewNonCentralChiWithSource reporting to the err.Config carried by ctx, if any.
+func NewNonCentralChiContext(ctx context.Context, dof int, lambda float64, src rand.Source) (*NonCentralChi, error) {
+	r, e := NewNonCentralChiWithSource(dof, lambda, src)
+	if e != nil {
+		return nil, e
+	}
+
+	r.errs = err.FromContext(ctx)
+	return r, nil
+}
+
+func (n *NonCentralChi) spec() (string, []Common, []float64) {
+	return "NonCentralChi", nil, []float64{float64(n.dof), n.lambda}
+}
+
+func (n *NonCentralChi) String() string {
+	return specString(n.spec())
+}
+
+func (n *NonCentralChi) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n)
+}
+
+func (n *NonCentralChi) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, n, n.src)
+}
+
+func (n *NonCentralChi) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+func (n *NonCentralChi) UnmarshalText(text []byte) error {
+	return unmarshalText(text, n, n.src)
+}
+
+func (n *NonCentralChi) ParameterValues() map[string]float64 {
+	return parameterValues(n)
+}
+
+func (n *NonCentralChi) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(n, params, n.src)
+}
+
+func (n *NonCentralChi) config() *err.Config {
+	return n.errs
+}
+
+func (n *NonCentralChi) setConfig(c *err.Config) {
+	n.errs = c
 }
 
 // k ∈ (0,∞)
@@ -41,7 +105,7 @@ func (n *NonCentralChi) Probability(x float64) float64 {
 	if n.Support().IsWithinInterval(x) {
 		num := math.Exp(-((x*x)+(n.lambda*n.lambda))/2) * math.Pow(x, float64(n.dof)) * n.lambda
 		denom := math.Pow(n.lambda*x, float64(n.dof)/2)
-		return (num / denom) * specfunc.Bessel_Inu((float64(n.dof)/2)-1, n.lambda*x)
+		return (num / denom) * checkSpecial(n.errs, "NonCentralChi: Iν", x, specfunc.Bessel_Inu((float64(n.dof)/2)-1, n.lambda*x))
 	}
 
 	return 0
@@ -49,12 +113,39 @@ func (n *NonCentralChi) Probability(x float64) float64 {
 
 func (n *NonCentralChi) Distribution(x float64) float64 {
 	if n.Support().IsWithinInterval(x) {
-		return 1 - smath.MarcumQ(float64(n.dof)/2, n.lambda, x)
+		return 1 - smath.MarcumQWithConfig(float64(n.dof)/2, n.lambda, x, n.errs)
 	}
 
 	return 0
 }
 
+func (n *NonCentralChi) Inverse(p float64) float64 {
+	if p <= 0 {
+		return 0
+	}
+
+	if p >= 1 {
+		return math.Inf(1)
+	}
+
+	// X² has mean k+λ²
+	return inverse(n.errs, n.Distribution, n.Probability, 0, math.Inf(1), math.Sqrt(float64(n.dof)+n.lambda*n.lambda), p)
+}
+
+func (n *NonCentralChi) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// the root of a noncentral χ² of noncentrality λ², whose series keeps the tail where Marcum's Q does not
+	sf := func(x float64) float64 { return poissonGammaSurvival(float64(n.dof)/2, n.lambda*n.lambda/2, x*x/2) }
+	return inverseSurvival(n.errs, sf, n.Probability, 0, math.Inf(1), math.Sqrt(float64(n.dof)+n.lambda*n.lambda), q)
+}
+
 func (n *NonCentralChi) Mean() float64 {
 	return math.Sqrt(math.Pi/2) * smath.AssociatedLaguerre(1./2, (float64(n.dof)/2)-1, -(n.lambda*n.lambda)/2)
 }
@@ -71,3 +162,14 @@ func (n *NonCentralChi) ExKurtosis() float64 {
 func (n *NonCentralChi) Skewness() float64 {
 	return 3 * math.Sqrt(math.Pi/2) * smath.AssociatedLaguerre(3./2, (float64(n.dof)/2)-1, -(n.lambda*n.lambda)/2)
 }
+
+// Square root of a non-central chi-squared variate with non-centrality λ², drawn as a
+// Poisson mixture: given J ~ Poisson(λ²/2), X² ~ χ²(k+2J) = 2·Gamma(k/2+J, 1).
+func (n *NonCentralChi) Rand() float64 {
+	var g Gamma
+	g.shape = float64(n.dof)/2 + poisson(n.src, (n.lambda*n.lambda)/2)
+	g.rate = .5
+	g.src = n.src
+
+	return math.Sqrt(g.Rand())
+}
@@ -1,6 +1,7 @@
 package continuous
 
 import (
+	"context"
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
@@ -16,6 +17,7 @@ type NonCentralChiSquared struct {
 	dof    int
 	lambda float64 // degrees of freedom, non-centrality
 	src    rand.Source
+	errs   *err.Config
 }
 
 func NewNonCentralChiSquared(dof int, lambda float64) (*NonCentralChiSquared, error) {
@@ -23,11 +25,63 @@ func NewNonCentralChiSquared(dof int, lambda float64) (*NonCentralChiSquared, er
 }
 
 func NewNonCentralChiSquaredWithSource(dof int, lambda float64, src rand.Source) (*NonCentralChiSquared, error) {
-	if dof <= 0 || lambda <= 0 {
-		return nil, err.Invalid()
+	r := &NonCentralChiSquared{dof, lambda, src, nil}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &NonCentralChiSquared{dof, lambda, src}, nil
+	return r, nil
+}
+
+// NewNonCentralChiSquaredContext is NewNonCentralChiSquaredWithSource reporting to the err.Config carried by ctx, if any.
+func NewNonCentralChiSquaredContext(ctx context.Context, dof int, lambda float64, src rand.Source) (*NonCentralChiSquared, error) {
+	r, e := NewNonCentralChiSquaredWithSource(dof, lambda, src)
+	if e != nil {
+		return nil, e
+	}
+
+	r.errs = err.FromContext(ctx)
+	return r, nil
+}
+
+func (n *NonCentralChiSquared) spec() (string, []Common, []float64) {
+	return "NonCentralChiSquared", nil, []float64{float64(n.dof), n.lambda}
+}
+
+func (n *NonCentralChiSquared) String() string {
+	return specString(n.spec())
+}
+
+func (n *NonCentralChiSquared) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n)
+}
+
+func (n *NonCentralChiSquared) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, n, n.src)
+}
+
+func (n *NonCentralChiSquared) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+func (n *NonCentralChiSquared) UnmarshalText(text []byte) error {
+	return unmarshalText(text, n, n.src)
+}
+
+func (n *NonCentralChiSquared) ParameterValues() map[string]float64 {
+	return parameterValues(n)
+}
+
+func (n *NonCentralChiSquared) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(n, params, n.src)
+}
+
+func (n *NonCentralChiSquared) config() *err.Config {
+	return n.errs
+}
+
+func (n *NonCentralChiSquared) setConfig(c *err.Config) {
+	n.errs = c
 }
 
 // k ∈ (0,∞)
@@ -46,7 +100,7 @@ func (n *NonCentralChiSquared) Support() stats.Interval {
 
 func (n *NonCentralChiSquared) Probability(x float64) float64 {
 	if n.Support().IsWithinInterval(x) {
-		return (1. / 2) * math.Exp(-(x+n.lambda)/2) * math.Pow(x/n.lambda, (float64(n.dof)/4)-(1./2)) * specfunc.Bessel_Inu((float64(n.dof)/2)-1, math.Sqrt(n.lambda*x))
+		return (1. / 2) * math.Exp(-(x+n.lambda)/2) * math.Pow(x/n.lambda, (float64(n.dof)/4)-(1./2)) * checkSpecial(n.errs, "NonCentralChiSquared: Iν", x, specfunc.Bessel_Inu((float64(n.dof)/2)-1, math.Sqrt(n.lambda*x)))
 	}
 
 	return 0
@@ -54,7 +108,7 @@ func (n *NonCentralChiSquared) Probability(x float64) float64 {
 
 func (n *NonCentralChiSquared) Distribution(x float64) float64 {
 	if n.Support().IsWithinInterval(x) {
-		return 1 - smath.MarcumQ(float64(n.dof)/2, math.Sqrt(n.lambda), math.Sqrt(x))
+		return 1 - smath.MarcumQWithConfig(float64(n.dof)/2, math.Sqrt(n.lambda), math.Sqrt(x), n.errs)
 	}
 
 	return 0
@@ -65,6 +119,10 @@ func (n *NonCentralChiSquared) Inverse(p float64) float64 {
 		eps = 1e-11
 	)
 
+	if p <= 0 {
+		return 0
+	}
+
 	/* Finding an upper and lower bound. This is Pearson's (1959) approximation, which is usually good to 4 figs or so.  */
 	var b, c, ff, ux, lx, ux0, nx, pp float64
 	b = (n.lambda * n.lambda) / (float64(n.dof) + 3*n.lambda)
@@ -106,6 +164,20 @@ func (n *NonCentralChiSquared) Inverse(p float64) float64 {
 	return 0.5 * (ux + lx)
 }
 
+func (n *NonCentralChiSquared) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// 2·NonCentralGamma(k/2, 1, λ/2), whose series keeps the tail where Marcum's Q does not
+	sf := func(x float64) float64 { return poissonGammaSurvival(float64(n.dof)/2, n.lambda/2, x/2) }
+	return inverseSurvival(n.errs, sf, n.Probability, 0, math.Inf(1), float64(n.dof)+n.lambda, q)
+}
+
 func (n *NonCentralChiSquared) Mean() float64 {
 	return float64(n.dof) + n.lambda
 }
@@ -24,7 +24,7 @@ func TestNonCentralChiSquaredProbability(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			b := NonCentralChiSquared{c.k, c.λ, nil}
+			b := NonCentralChiSquared{c.k, c.λ, nil, nil}
 
 			res := b.Probability(c.x)
 			if math.Abs(res-c.expected) > tol {
@@ -53,7 +53,7 @@ func TestNonCentralChiSquaredDistribution(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			b := NonCentralChiSquared{c.k, c.λ, nil}
+			b := NonCentralChiSquared{c.k, c.λ, nil, nil}
 			res := b.Distribution(c.x)
 			run_test(t, res, c.expected, tol, "NonCentralChiSquaredDistribution")
 		})
@@ -77,7 +77,7 @@ func TestNonCentralChiSquaredInverse(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			b := NonCentralChiSquared{c.k, c.λ, nil}
+			b := NonCentralChiSquared{c.k, c.λ, nil, nil}
 			res := b.Inverse(c.x)
 			run_test(t, res, c.expected, tol, "NonCentralChiSquaredDistribution")
 		})
@@ -100,7 +100,7 @@ func TestNonCentralChiSquaredMean(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			b := NonCentralChiSquared{c.k, c.λ, nil}
+			b := NonCentralChiSquared{c.k, c.λ, nil, nil}
 			res := b.Mean()
 			run_test(t, res, c.expected, tol, "NonCentralChiSquaredMean")
 		})
@@ -123,7 +123,7 @@ func TestNonCentralChiSquaredVariance(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			b := NonCentralChiSquared{c.k, c.λ, nil}
+			b := NonCentralChiSquared{c.k, c.λ, nil, nil}
 			res := b.Variance()
 			run_test(t, res, c.expected, tol, "NonCentralChiSquaredVariance")
 		})
@@ -146,7 +146,7 @@ func TestNonCentralChiSquaredSkewness(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			b := NonCentralChiSquared{c.k, c.λ, nil}
+			b := NonCentralChiSquared{c.k, c.λ, nil, nil}
 			res := b.Skewness()
 			run_test(t, res, c.expected, tol, "NonCentralChiSquaredSkewness")
 		})
@@ -169,7 +169,7 @@ func TestNonCentralChiSquaredExKurtosis(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			b := NonCentralChiSquared{c.k, c.λ, nil}
+			b := NonCentralChiSquared{c.k, c.λ, nil, nil}
 			res := b.ExKurtosis()
 			run_test(t, res, c.expected, tol, "NonCentralChiSquaredExKurtosis")
 		})
@@ -0,0 +1,46 @@
+package continuous
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestNonCentralChiRand(t *testing.T) {
+	cases := []struct {
+		dof    int
+		lambda float64
+	}{
+		{1, 1},
+		{3, 2.5},
+		{10, 6},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			n, _ := NewNonCentralChiWithSource(c.dof, c.lambda, rand.NewSource(int64(i+1)))
+			test_rand_ks(t, n.Rand, n.Distribution, 5000, fmt.Sprintf("NonCentralChiRand(%v, %v)", c.dof, c.lambda))
+		})
+	}
+}
+
+func TestNonCentralChiInverseDistribution(t *testing.T) {
+	tol := 0.000000001
+	cases := []struct {
+		dof    int
+		lambda float64
+	}{
+		{1, 1},
+		{3, 2.5},
+		{10, 6},
+	}
+
+	for _, c := range cases {
+		for _, p := range []float64{.001, .1, .5, .9, .999} {
+			n := NonCentralChi{dof: c.dof, lambda: c.lambda}
+			res := n.Distribution(n.Inverse(p))
+			run_test(t, res, p, tol, fmt.Sprintf("NonCentralChiInverseDistribution(%v, %v, %v)", c.dof, c.lambda, p))
+		}
+	}
+}
@@ -1,23 +1,89 @@
 package continuous
 
 import (
+	"context"
+	"fmt"
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
 	"github.com/jtejido/stats/err"
 	"math"
+	"math/rand"
 )
 
 type NonCentralGamma struct {
+	baseContinuousWithSource
 	shape, scale, lambda float64 // k, θ, λ (noncentrality)
+	errs                 *err.Config
 }
 
 func NewNonCentralGamma(shape, scale, lambda float64) (*NonCentralGamma, error) {
-	if shape <= 0 || scale <= 0 || lambda <= 0 {
-		return nil, err.Invalid()
+	return NewNonCentralGammaWithSource(shape, scale, lambda, nil)
+}
+
+func NewNonCentralGammaWithSource(shape, scale, lambda float64, src rand.Source) (*NonCentralGamma, error) {
+	r := new(NonCentralGamma)
+	r.shape = shape
+	r.scale = scale
+	r.lambda = lambda
+	r.src = src
+
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+// NewNonCentralGammaContext is NewNonCentralGammaWithSource reporting to the err.Config carried by ctx, if any.
+func NewNonCentralGammaContext(ctx context.Context, shape, scale, lambda float64, src rand.Source) (*NonCentralGamma, error) {
+	r, e := NewNonCentralGammaWithSource(shape, scale, lambda, src)
+	if e != nil {
+		return nil, e
 	}
 
-	return &NonCentralGamma{shape, scale, lambda}, nil
+	r.errs = err.FromContext(ctx)
+	return r, nil
+}
+
+func (g *NonCentralGamma) spec() (string, []Common, []float64) {
+	return "NonCentralGamma", nil, []float64{g.shape, g.scale, g.lambda}
+}
+
+func (g *NonCentralGamma) String() string {
+	return specString(g.spec())
+}
+
+func (g *NonCentralGamma) MarshalJSON() ([]byte, error) {
+	return marshalJSON(g)
+}
+
+func (g *NonCentralGamma) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, g, g.src)
+}
+
+func (g *NonCentralGamma) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *NonCentralGamma) UnmarshalText(text []byte) error {
+	return unmarshalText(text, g, g.src)
+}
+
+func (g *NonCentralGamma) ParameterValues() map[string]float64 {
+	return parameterValues(g)
+}
+
+func (g *NonCentralGamma) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(g, params, g.src)
+}
+
+func (g *NonCentralGamma) config() *err.Config {
+	return g.errs
+}
+
+func (g *NonCentralGamma) setConfig(c *err.Config) {
+	g.errs = c
 }
 
 // k ∈ (0,∞)
@@ -50,7 +116,7 @@ func (g *NonCentralGamma) Probability(x float64) float64 {
 		a := g.shape + m
 		gx := math.Pow(x, a) * math.Exp(-x) / specfunc.Gamma(a+1) / g.scale
 		var gxp float64
-		if x == 0 {
+		if x != 0 {
 			gxp = gx * a / x
 		}
 
@@ -60,15 +126,27 @@ func (g *NonCentralGamma) Probability(x float64) float64 {
 		remain := 1 - pp
 		ii := 1.0
 		gg := pp * gxp
+		var errest float64
 
 		for {
 			gxp = gxp * x / (a + ii - 1)
 			pp = pp * g.lambda / (m + ii)
 			gg = gg + pp*gxp
-			er := gg * remain
 			remain = remain - pp
 			if ii > m {
+				// the terms fall by r = λx/(m+ii+1)(a+ii) from here on once r < 1, which in the upper
+				// tail is only well past the mode of the weights, and are bounded relative to the sum
+				r := g.lambda * x / ((m + ii + 1) * (a + ii))
+				er := math.Inf(1)
+				if r < 1 {
+					er = 0
+					if t := pp * gxp * r / (1 - r); t > 0 {
+						er = t / gg
+					}
+				}
+
 				if er < gsl.Float64Eps || int(ii) > maxIter {
+					errest = er
 					break
 				}
 			} else {
@@ -77,6 +155,7 @@ func (g *NonCentralGamma) Probability(x float64) float64 {
 				gg = gg + pr*gxr
 				remain = remain - pr
 				if remain < gsl.Float64Eps || int(ii) > maxIter {
+					errest = remain
 					break
 				}
 			}
@@ -84,6 +163,10 @@ func (g *NonCentralGamma) Probability(x float64) float64 {
 			ii++
 		}
 
+		if errest >= gsl.Float64Eps {
+			g.errs.Report(fmt.Sprintf("NonCentralGamma: density series did not converge in %d terms at x = %v", maxIter, x*g.scale), err.EMAXITER, gg, errest)
+		}
+
 		return gg
 	}
 
@@ -106,7 +189,7 @@ func (g *NonCentralGamma) Distribution(x float64) float64 {
 		a := g.shape + m
 		gammap := specfunc.Gamma_inc_P(a, x)
 		gammar := gammap
-		gxr := math.Pow(x, a) * math.Exp(-x) / specfunc.Gamma(a+1) / g.scale
+		gxr := math.Pow(x, a) * math.Exp(-x) / specfunc.Gamma(a+1)
 		var gxp float64
 		if x != 0 {
 			gxp = gxr * a / x
@@ -121,6 +204,7 @@ func (g *NonCentralGamma) Distribution(x float64) float64 {
 		remain := 1 - pp
 		ii := 1.0
 		cdf := pp * gammap
+		var errest float64
 
 		for {
 			gxp = gxp * x / (a + ii - 1)
@@ -131,15 +215,17 @@ func (g *NonCentralGamma) Distribution(x float64) float64 {
 			remain = remain - pp
 			if ii > m {
 				if er <= gsl.Float64Eps || int(ii) > maxIter {
+					errest = er
 					break
 				}
 			} else {
-				gxr = gxr * (a - ii) / x
+				gxr = gxr * (a + 1 - ii) / x
 				gammar = gammar + gxr
 				pr = pr * (m - ii + 1) / g.lambda
 				cdf = cdf + pr*gammar
 				remain = remain - pr
 				if remain <= gsl.Float64Eps || int(ii) > maxIter {
+					errest = remain
 					break
 				}
 
@@ -147,6 +233,10 @@ func (g *NonCentralGamma) Distribution(x float64) float64 {
 			ii++
 		}
 
+		if errest > gsl.Float64Eps {
+			g.errs.Report(fmt.Sprintf("NonCentralGamma: distribution series did not converge in %d terms at x = %v", maxIter, x*g.scale), err.EMAXITER, cdf, errest)
+		}
+
 		return cdf
 
 	}
@@ -161,7 +251,14 @@ func (g *NonCentralGamma) Distribution(x float64) float64 {
 //     distribution," SIAM Journal on Scientific Computing, vol. 17, no. 5,
 //     pp.1224-1231, Sep. 1996.
 func (ncg *NonCentralGamma) Inverse(p float64) float64 {
-	p = p / ncg.scale
+	if p <= 0 {
+		return 0
+	}
+
+	if p >= 1 {
+		return math.Inf(1)
+	}
+
 	maxitr := 5000
 	d := ncg.lambda * 2
 	k := math.Ceil(ncg.lambda)
@@ -222,10 +319,76 @@ func (ncg *NonCentralGamma) Inverse(p float64) float64 {
 			xn = x - (cdf-p)/g
 		}
 		if math.Abs(xn-x) <= x*gsl.Float64Eps || it > maxitr {
+			if it > maxitr {
+				ncg.errs.Report(fmt.Sprintf("NonCentralGamma: quantile did not converge in %d steps at p = %v", maxitr, p), err.EMAXITER, xn*ncg.scale, math.Abs(xn-x)*ncg.scale)
+			}
 			break
 		}
 		it++
 	}
 
-	return xn
+	return xn * ncg.scale
+}
+
+func (ncg *NonCentralGamma) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	sf := func(x float64) float64 { return poissonGammaSurvival(ncg.shape, ncg.lambda, x/ncg.scale) }
+	return inverseSurvival(ncg.errs, sf, ncg.Probability, 0, math.Inf(1), (ncg.shape+ncg.lambda)*ncg.scale, q)
+}
+
+// Σ e^{-λ}λʲ/j! Q(k+j, y), the survival function of the Poisson mixture of Gamma(k+J, 1) variates.
+func poissonGammaSurvival(k, λ, y float64) float64 {
+	return poissonMixture(λ, func(j float64) float64 { return specfunc.Gamma_inc_Q(k+j, y) })
+}
+
+// Σ e^{-λ}λʲ/j! Q(j) for a Q in [0, 1] that grows with j, such as the survival functions mixed into the
+// noncentral distributions, summed outward from the mode of the weights. Every term is positive, so the sum
+// keeps its relative accuracy however small it is, where 1 less the cdf does not. Above the mode the terms
+// left are bounded by the weights left, and below it by j times the last.
+func poissonMixture(λ float64, Q func(j float64) float64) float64 {
+	if λ == 0 {
+		return Q(0)
+	}
+
+	const maxIter = 5000
+	m := math.Floor(λ)
+	wm := math.Exp(-λ + m*math.Log(λ) - specfunc.Lngamma(m+1))
+	w := wm
+	var sum float64
+	for j := m; j < m+maxIter; j++ {
+		sum += w * Q(j)
+		w *= λ / (j + 1)
+		if j+2 > λ && w/(1-λ/(j+2)) <= gsl.Float64Eps*sum {
+			break
+		}
+	}
+
+	w = wm
+	for j := m - 1; j >= 0; j-- {
+		w *= (j + 1) / λ
+		t := w * Q(j)
+		sum += t
+		if j*t <= gsl.Float64Eps*sum {
+			break
+		}
+	}
+
+	return sum
+}
+
+// Poisson mixture of central Gamma variates: given J ~ Poisson(λ), X ~ θ·Gamma(k+J, 1).
+func (ncg *NonCentralGamma) Rand() float64 {
+	var g Gamma
+	g.shape = ncg.shape + poisson(ncg.src, ncg.lambda)
+	g.rate = 1
+	g.src = ncg.src
+
+	return ncg.scale * g.Rand()
 }
@@ -0,0 +1,96 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/ggsl/specfunc"
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// Reference values summed directly from the Poisson mixture Σ e^(-λ)λ^j/j!·Gamma(k+j, θ).
+func TestNonCentralGammaProbability(t *testing.T) {
+	tol := 0.0000001
+	cases := []struct {
+		x, shape, scale, lambda float64
+		expected                float64
+	}{
+		{.5, 1.5, 1, 3, 0.05653979046874305},
+		{4, 1.5, 1, 3, 0.15158390219319814},
+		{2, 1.5, 2.5, 3, 0.0322331327637648},
+		{8, 1.5, 2.5, 3, 0.06493686485591263},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			g := NonCentralGamma{shape: c.shape, scale: c.scale, lambda: c.lambda}
+			res := g.Probability(c.x)
+			run_test(t, res, c.expected, tol, "NonCentralGammaProbability")
+		})
+	}
+}
+
+func TestNonCentralGammaDistribution(t *testing.T) {
+	tol := 0.0000001
+	cases := []struct {
+		x, shape, scale, lambda float64
+		expected                float64
+	}{
+		{.5, 1.5, 1, 3, 0.01677981738247193},
+		{4, 1.5, 1, 3, 0.49604885053013154},
+		{2, 1.5, 2.5, 3, 0.037407360811247646},
+		{8, 1.5, 2.5, 3, 0.36967106798077126},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			g := NonCentralGamma{shape: c.shape, scale: c.scale, lambda: c.lambda}
+			res := g.Distribution(c.x)
+			run_test(t, res, c.expected, tol, "NonCentralGammaDistribution")
+		})
+	}
+}
+
+func TestNonCentralGammaRand(t *testing.T) {
+	cases := []struct {
+		shape, scale, lambda float64
+	}{
+		{1, 1, 1},
+		{2.5, 2, 4},
+		{.5, 1, 15},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			g, _ := NewNonCentralGammaWithSource(c.shape, c.scale, c.lambda, rand.NewSource(int64(i+1)))
+			test_rand_ks(t, g.Rand, g.Distribution, 5000, fmt.Sprintf("NonCentralGammaRand(%v, %v, %v)", c.shape, c.scale, c.lambda))
+		})
+	}
+}
+
+// Regression for three slips in the series of Knüsel and Bablok: the density dropped the terms below the
+// Poisson mode unless x = 0, and the distribution function divided by θ, a density factor, and stepped
+// the downward recurrence of xᵃe⁻ˣ/Γ(a+1) by a - i instead of a + 1 - i. Each shows at θ ≠ 1 and λ above
+// a few, against the Poisson mixture summed directly. The series stop on an absolute error, so the far
+// tails are left out.
+func TestNonCentralGammaSeries(t *testing.T) {
+	tol := 1e-10
+	for _, c := range []struct{ shape, scale, lambda float64 }{{1.5, 2.5, 3}, {.7, .4, 12}, {4, 1, 25}} {
+		g := NonCentralGamma{shape: c.shape, scale: c.scale, lambda: c.lambda}
+		mean := (c.shape + c.lambda) * c.scale
+		for _, x := range []float64{.05 * mean, .3 * mean, mean, 2 * mean} {
+			var pdf, cdf float64
+			for j := 0.; j < 400; j++ {
+				w := math.Exp(-c.lambda + j*math.Log(c.lambda) - specfunc.Lngamma(j+1))
+				a := c.shape + j
+				pdf += w * math.Exp((a-1)*math.Log(x/c.scale)-x/c.scale-specfunc.Lngamma(a)) / c.scale
+				cdf += w * specfunc.Gamma_inc_P(a, x/c.scale)
+			}
+
+			desc := fmt.Sprintf("NonCentralGamma(%v, %v, %v).%%s(%v)", c.shape, c.scale, c.lambda, x)
+			run_test(t, g.Probability(x), pdf, tol, fmt.Sprintf(desc, "Probability"))
+			run_test(t, g.Distribution(x), cdf, tol, fmt.Sprintf(desc, "Distribution"))
+		}
+	}
+}
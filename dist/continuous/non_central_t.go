@@ -1,29 +1,94 @@
 package continuous
 
 import (
+	"context"
+	"fmt"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
 	"github.com/jtejido/stats/err"
 	"math"
+	"math/rand"
 )
 
 // Noncentral t-distribution
 // https://en.wikipedia.org/wiki/Noncentral_t-distribution
 type NonCentralT struct {
+	baseContinuousWithSource
 	dof, lambda float64 // v (degrees of freedom), μ (non-centrality)
+	errs        *err.Config
 }
 
 func NewNonCentralT(dof, lambda float64) (*NonCentralT, error) {
-	if dof <= 0 {
-		return nil, err.Invalid()
+	return NewNonCentralTWithSource(dof, lambda, nil)
+}
+
+func NewNonCentralTWithSource(dof, lambda float64, src rand.Source) (*NonCentralT, error) {
+	r := new(NonCentralT)
+	r.dof = dof
+	r.lambda = lambda
+	r.src = src
+
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+// NewNonCentralTContext is NewNonCentralTWithSource reporting to the err.Config carried by ctx, if any.
+func NewNonCentralTContext(ctx context.Context, dof, lambda float64, src rand.Source) (*NonCentralT, error) {
+	r, e := NewNonCentralTWithSource(dof, lambda, src)
+	if e != nil {
+		return nil, e
 	}
 
-	return &NonCentralT{dof, lambda}, nil
+	r.errs = err.FromContext(ctx)
+	return r, nil
+}
+
+func (n *NonCentralT) spec() (string, []Common, []float64) {
+	return "NonCentralT", nil, []float64{n.dof, n.lambda}
+}
+
+func (n *NonCentralT) String() string {
+	return specString(n.spec())
+}
+
+func (n *NonCentralT) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n)
+}
+
+func (n *NonCentralT) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, n, n.src)
+}
+
+func (n *NonCentralT) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+func (n *NonCentralT) UnmarshalText(text []byte) error {
+	return unmarshalText(text, n, n.src)
+}
+
+func (n *NonCentralT) ParameterValues() map[string]float64 {
+	return parameterValues(n)
+}
+
+func (n *NonCentralT) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(n, params, n.src)
+}
+
+func (n *NonCentralT) config() *err.Config {
+	return n.errs
+}
+
+func (n *NonCentralT) setConfig(c *err.Config) {
+	n.errs = c
 }
 
 // ν ∈ (0,∞)
 // μ ∈ (-∞,∞)
-func (n *NonCentralT) ParameterLimits() stats.Limits {
+func (n *NonCentralT) Parameters() stats.Limits {
 	return stats.Limits{
 		"ν": stats.Interval{0, math.Inf(1), true, true},
 		"μ": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
@@ -31,7 +96,7 @@ func (n *NonCentralT) ParameterLimits() stats.Limits {
 }
 
 // x ∈ (-∞,∞)
-func (n *NonCentralT) SupportLimits() stats.Interval {
+func (n *NonCentralT) Support() stats.Interval {
 	return stats.Interval{math.Inf(-1), math.Inf(1), true, true}
 }
 
@@ -44,11 +109,11 @@ func (n *NonCentralT) Probability(x float64) float64 {
 	f1 := ν/2. + 1.
 	f2 := 3. / 2.
 	f3 := (μ * μ) * (x * x) / 2 / (ν + (x * x))
-	ip1 := math.Sqrt(2.) * μ * x * specfunc.Hyperg_1F1(f1, f2, f3) / (ν + (x * x)) / math.Gamma((ν+1.)/2.)
+	ip1 := math.Sqrt(2.) * μ * x * checkSpecial(n.errs, "NonCentralT: 1F1", x, specfunc.Hyperg_1F1(f1, f2, f3)) / (ν + (x * x)) / math.Gamma((ν+1.)/2.)
 
 	f1 = (ν + 1.) / 2.
 	f2 = 1. / 2.
-	ip2 := specfunc.Hyperg_1F1(f1, f2, f3) / math.Sqrt(ν+(x*x)) / math.Gamma(ν/2.+1)
+	ip2 := checkSpecial(n.errs, "NonCentralT: 1F1", x, specfunc.Hyperg_1F1(f1, f2, f3)) / math.Sqrt(ν+(x*x)) / math.Gamma(ν/2.+1)
 
 	return p1 * (ip1 + ip2)
 
@@ -109,6 +174,7 @@ func (n *NonCentralT) Distribution(x float64) float64 {
 	xeven := 1.0 - rxb
 	geven := b * t * rxb
 	value = p*xodd + q*xeven
+	var errest float64
 
 	// Repeat until convergence.
 	for {
@@ -125,6 +191,7 @@ func (n *NonCentralT) Distribution(x float64) float64 {
 		errbd := 2.0 * s * (xodd - godd)
 
 		if errbd <= errmax || math.IsNaN(errbd) || en >= float64(itrmax) {
+			errest = errbd
 			break
 		}
 	}
@@ -136,9 +203,54 @@ func (n *NonCentralT) Distribution(x float64) float64 {
 		value = 1.0 - value
 	}
 
+	if math.IsNaN(errest) {
+		n.errs.Report(fmt.Sprintf("NonCentralT: series lost its error bound at x = %v", x), err.ELOSS, value, errest)
+	} else if errest > errmax {
+		n.errs.Report(fmt.Sprintf("NonCentralT: series did not converge in %d terms at x = %v", itrmax, x), err.EMAXITER, value, errest)
+	}
+
 	return value
 }
 
+func (n *NonCentralT) Inverse(p float64) float64 {
+	if p <= 0 {
+		return math.Inf(-1)
+	}
+
+	if p >= 1 {
+		return math.Inf(1)
+	}
+
+	return inverse(n.errs, n.Distribution, n.Probability, math.Inf(-1), math.Inf(1), n.lambda, p)
+}
+
+func (n *NonCentralT) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return inverseSurvival(n.errs, n.survival, n.Probability, math.Inf(-1), math.Inf(1), n.lambda, q)
+}
+
+// P(T > x). Above 0 it is P(Z > x·√(V/ν)) for Z ~ N(λ, 1) and V ~ χ²(ν), which is φ(z-λ)P(ν/2, νz²/2x²)
+// integrated over z > 0: a positive integrand, where the series behind Distribution is good only to about
+// 1e-10 absolute. φ is negligible 40 past λ.
+func (n *NonCentralT) survival(x float64) float64 {
+	if x <= 0 {
+		return 1 - n.Distribution(x)
+	}
+
+	f := func(z float64) float64 {
+		return math.Exp(-(z-n.lambda)*(z-n.lambda)/2) / math.Sqrt(2*math.Pi) * specfunc.Gamma_inc_P(n.dof/2, n.dof*z*z/(2*x*x))
+	}
+
+	return quadrature(f, 0, math.Max(n.lambda, 0)+40)
+}
+
 func (n *NonCentralT) Mean() float64 {
 	if n.dof == 1 {
 		return math.NaN()
@@ -184,3 +296,14 @@ func (n *NonCentralT) Skewness() float64 {
 
 	return math.NaN()
 }
+
+// T = Z/√(V/ν), with Z ~ N(μ,1) and V ~ χ²(ν) = 2·Gamma(ν/2, 1).
+func (n *NonCentralT) Rand() float64 {
+	z := &Normal{location: n.lambda, scale: 1, src: n.src}
+	var v Gamma
+	v.shape = n.dof / 2
+	v.rate = .5
+	v.src = n.src
+
+	return z.Rand() / math.Sqrt(v.Rand()/n.dof)
+}
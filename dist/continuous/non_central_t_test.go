@@ -1,7 +1,10 @@
 package continuous
 
 import (
+	"fmt"
+	"github.com/jtejido/stats/err"
 	"math"
+	"math/rand"
 	"strconv"
 	"testing"
 )
@@ -53,7 +56,7 @@ func TestNonCentralTProbability(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			e := NonCentralT{c.ν, c.μ}
+			e := NonCentralT{dof: c.ν, lambda: c.μ}
 
 			res := e.Probability(c.t)
 			if math.Abs(res-c.expected) > tol {
@@ -103,7 +106,7 @@ func TestNonCentralTDistribution(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			e := NonCentralT{c.ν, c.μ}
+			e := NonCentralT{dof: c.ν, lambda: c.μ}
 
 			res := e.Distribution(c.t)
 			if math.Abs(res-c.expected) > tol {
@@ -130,7 +133,7 @@ func TestNonCentralTCDMean(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			e := NonCentralT{c.ν, c.μ}
+			e := NonCentralT{dof: c.ν, lambda: c.μ}
 
 			res := e.Mean()
 			if math.Abs(res-c.expected) > tol {
@@ -153,7 +156,7 @@ func TestNonCentralTCDMeanNaN(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			e := NonCentralT{c.ν, c.μ}
+			e := NonCentralT{dof: c.ν, lambda: c.μ}
 			res := e.Mean()
 			if !math.IsNaN(res) {
 				t.Errorf("Mismatch. Case %d, want: %v, got: %v", i, math.NaN(), res)
@@ -162,3 +165,71 @@ func TestNonCentralTCDMeanNaN(t *testing.T) {
 		})
 	}
 }
+
+func TestNonCentralTRand(t *testing.T) {
+	cases := []struct {
+		ν, μ float64
+	}{
+		{1, 1},
+		{3, -2},
+		{25, 4},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			e, _ := NewNonCentralTWithSource(c.ν, c.μ, rand.NewSource(int64(i+1)))
+			test_rand_ks(t, e.Rand, e.Distribution, 5000, fmt.Sprintf("NonCentralTRand(%v, %v)", c.ν, c.μ))
+		})
+	}
+}
+
+func TestNonCentralTInverseDistribution(t *testing.T) {
+	tol := 0.00000001
+	cases := []struct {
+		ν, μ float64
+	}{
+		{1, 1},
+		{3, -2},
+		{25, 4},
+	}
+
+	for _, c := range cases {
+		for _, p := range []float64{.001, .1, .5, .9, .999} {
+			e := NonCentralT{dof: c.ν, lambda: c.μ}
+			res := e.Distribution(e.Inverse(p))
+			run_test(t, res, p, tol, fmt.Sprintf("NonCentralTInverseDistribution(%v, %v, %v)", c.ν, c.μ, p))
+		}
+	}
+}
+
+func TestNonCentralTDiagnostics(t *testing.T) {
+	cases := []struct {
+		x, ν, μ float64
+		errno   int
+	}{
+		{1, 5, 1, err.SUCCESS},
+		{-2, 2, -2, err.SUCCESS},
+		{15, 3, 15, err.EMAXITER},
+		{20, 5, 20, err.EMAXITER},
+	}
+
+	for _, c := range cases {
+		n, _ := NewNonCentralT(c.ν, c.μ)
+		v, d := err.Diagnose(func() float64 { return n.Distribution(c.x) })
+		if c.errno == err.SUCCESS {
+			if d.Len() != 0 {
+				t.Errorf("NonCentralT(%v, %v).Distribution(%v) want no diagnostics, got: %v", c.ν, c.μ, c.x, d.List())
+			}
+			continue
+		}
+
+		if e := d.Err(); e == nil || e.Status() != c.errno {
+			t.Errorf("Mismatch. NonCentralT(%v, %v).Distribution(%v) want errno %v, got: %v", c.ν, c.μ, c.x, c.errno, e)
+			continue
+		}
+
+		if r := d.List()[0]; r.Value != v || !(r.Estimate > 1e-10) {
+			t.Errorf("Mismatch. NonCentralT(%v, %v).Distribution(%v) want value %v with an error estimate, got: %+v", c.ν, c.μ, c.x, v, r)
+		}
+	}
+}
@@ -3,8 +3,9 @@ package continuous
 import (
 	"github.com/jtejido/linear"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
+	smath "github.com/jtejido/stats/math"
 	"math"
+	"math/cmplx"
 	"math/rand"
 )
 
@@ -21,11 +22,44 @@ func NewNormal(location, scale float64) (*Normal, error) {
 }
 
 func NewNormalWithSource(location, scale float64, src rand.Source) (*Normal, error) {
-	if scale <= 0 {
-		return nil, err.Invalid()
+	r := &Normal{location, scale, src, nil}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Normal{location, scale, src, nil}, nil
+	return r, nil
+}
+
+func (n *Normal) spec() (string, []Common, []float64) {
+	return "Normal", nil, []float64{n.location, n.scale}
+}
+
+func (n *Normal) String() string {
+	return specString(n.spec())
+}
+
+func (n *Normal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n)
+}
+
+func (n *Normal) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, n, n.src)
+}
+
+func (n *Normal) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+func (n *Normal) UnmarshalText(text []byte) error {
+	return unmarshalText(text, n, n.src)
+}
+
+func (n *Normal) ParameterValues() map[string]float64 {
+	return parameterValues(n)
+}
+
+func (n *Normal) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(n, params, n.src)
 }
 
 // μ ∈ (-∞,∞)
@@ -56,7 +90,7 @@ func (n *Normal) Probability(x float64) float64 {
 
 func (n *Normal) Distribution(x float64) float64 {
 	if n.Support().IsWithinInterval(x) {
-		return 0.5 + 0.5*math.Erf(((x-n.location)/n.scale)/math.Sqrt2)
+		return 0.5 * math.Erfc(-((x-n.location)/n.scale)/math.Sqrt2)
 	}
 
 	return 0
@@ -86,6 +120,18 @@ func (n *Normal) Inverse(p float64) float64 {
 	return math.Sqrt(2*(n.scale*n.scale))*math.Erfinv(2*p-1) + n.location
 }
 
+func (n *Normal) InverseSurvival(q float64) float64 {
+	if q >= 1. {
+		return math.Inf(-1)
+	}
+
+	if q <= 0. {
+		return math.Inf(1)
+	}
+
+	return n.location - n.scale*smath.Ndtri(q)
+}
+
 func (n *Normal) Mean() float64 {
 	return n.location
 }
@@ -102,6 +148,11 @@ func (n *Normal) Variance() float64 {
 	return n.scale * n.scale
 }
 
+// e^{iμt - σ²t²/2}
+func (n *Normal) CharacteristicFunction(t float64) complex128 {
+	return cmplx.Exp(complex(-n.scale*n.scale*t*t/2, n.location*t))
+}
+
 func (n *Normal) Rand() float64 {
 	return n.rand()*n.scale + n.location
 }
@@ -529,3 +529,30 @@ func TestNormalInverseDistribution(t *testing.T) {
 		})
 	}
 }
+
+// Generated with Python statistics.NormalDist(μ, σ).inv_cdf(1 - q)
+func TestNormalInverseSurvival(t *testing.T) {
+	tol := 1e-14
+	cases := []struct {
+		μ, σ, q  float64
+		expected float64
+	}{
+		{0, 1, 0.5, 0},
+		{0, 1, 0.025, 1.9599639845400538},
+		{0, 1, 1e-12, 7.034483825301132},
+		{0, 1, 1e-100, 21.27345356096532},
+		{1, 2, 0.5, 1},
+		{1, 2, 0.025, 4.919927969080108},
+		{1, 2, 1e-12, 15.068967650602264},
+		{1, 2, 1e-100, 43.54690712193064},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			n := Normal{location: c.μ, scale: c.σ}
+
+			res := n.InverseSurvival(c.q)
+			run_test(t, res, c.expected, tol, "NormalInverseSurvival")
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/err"
+	"math/rand"
+)
+
+// Parametric is implemented by every distribution of this package but those built from a sample
+// (Empirical, KDE, PiecewiseConstant and PiecewiseLinear), as a sample is no parameter. Parameter keys
+// are the stable ASCII names of the registry entry (e.g. "shape" and "rate" for Gamma), with the
+// symbols declared in Parameters() accepted and reported alongside them (e.g. "k" and "β").
+type Parametric interface {
+	// ParameterValues returns the current parameter values under both their names and their symbols.
+	ParameterValues() map[string]float64
+
+	// WithParameters returns a new distribution of the same type with the given parameters replaced and
+	// the others kept, validated as by the constructor and against the declared limits. It reports to the
+	// same err.Config as the distribution it is called on.
+	WithParameters(map[string]float64) (Common, error)
+}
+
+func parameterValues(d specifier) map[string]float64 {
+	name, _, params := d.spec()
+	entry, _ := Lookup(name)
+
+	values := make(map[string]float64, 2*len(params))
+	for i, v := range params {
+		values[entry.Params[i].Name] = v
+		if sym := entry.Params[i].Symbol; sym != "" {
+			values[sym] = v
+		}
+	}
+
+	return values
+}
+
+func withParameters(d specifier, params map[string]float64, src rand.Source) (Common, error) {
+	name, dists, values := d.spec()
+	entry, ok := Lookup(name)
+	if !ok {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("unknown distribution %q", name))
+	}
+
+	values = append([]float64(nil), values...)
+	given := make([]bool, len(values))
+	for key, v := range params {
+		i := entry.param(key)
+		if i < 0 {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s has no parameter %q", name, key))
+		}
+
+		if given[i] && values[i] != v {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s.%s given twice with different values", name, entry.Params[i].Name))
+		}
+
+		values[i], given[i] = v, true
+	}
+
+	set := make([]bool, len(values))
+	for i := range set {
+		set[i] = true
+	}
+
+	return entry.build(dists, values, set, src, configOf(d))
+}
+
+// Checks the parameters of d against the limits it declares in Parameters(), naming the first parameter
+// found outside them. Used by every constructor of this package.
+func validate(d specifier) error {
+	l, ok := d.(interface{ Parameters() stats.Limits })
+	if !ok {
+		return nil
+	}
+
+	name, _, values := d.spec()
+	entry, _ := Lookup(name)
+	limits := l.Parameters()
+	for i, par := range entry.Params {
+		lim, ok := limits[par.Symbol]
+		if !ok || lim.IsWithinInterval(values[i]) {
+			continue
+		}
+
+		return err.New(err.EINVAL, fmt.Sprintf("%s: %s (%s) = %v is outside %v", name, par.Name, par.Symbol, values[i], lim))
+	}
+
+	return nil
+}
+
+// SampleMoments is what the method-of-moments constructors, such as NewGammaFromMoments, need of a
+// sample: its mean and unbiased variance, as kept by the accumulators of package sample.
+type SampleMoments interface {
+	Mean() float64
+	Variance() float64
+}
+
+func momentsError(name string, mean, variance float64) error {
+	return err.New(err.EINVAL, fmt.Sprintf("%s: no parameters match mean %v and variance %v", name, mean, variance))
+}
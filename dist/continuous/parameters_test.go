@@ -0,0 +1,256 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestParameterValues(t *testing.T) {
+	g, _ := NewGamma(2, .5)
+	want := map[string]float64{"shape": 2, "k": 2, "rate": .5, "β": .5}
+
+	got := g.ParameterValues()
+	if len(got) != len(want) {
+		t.Errorf("Mismatch. want: %v, got: %v", want, got)
+	}
+
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("Mismatch. %s want: %v, got: %v", k, v, got[k])
+		}
+	}
+}
+
+func TestWithParametersRoundTrip(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			d, e := Parse(registrySpecs[name])
+			if e != nil {
+				t.Fatalf("Parse(%q): %v", registrySpecs[name], e)
+			}
+
+			p := d.(Parametric)
+			d2, e := p.WithParameters(p.ParameterValues())
+			if e != nil {
+				t.Fatalf("WithParameters(%v): %v", p.ParameterValues(), e)
+			}
+
+			if fmt.Sprint(d2) != fmt.Sprint(d) {
+				t.Errorf("Mismatch. want: %v, got: %v", d, d2)
+			}
+		})
+	}
+}
+
+func TestWithParameters(t *testing.T) {
+	src := rand.NewSource(1)
+	g, _ := NewGammaWithSource(2, .5, src)
+
+	cases := []struct {
+		params   map[string]float64
+		expected string
+	}{
+		{nil, "Gamma(shape=2, rate=0.5)"},
+		{map[string]float64{"shape": 3}, "Gamma(shape=3, rate=0.5)"},
+		{map[string]float64{"β": 4}, "Gamma(shape=2, rate=4)"},
+		{map[string]float64{"shape": 3, "k": 3, "rate": 1}, "Gamma(shape=3, rate=1)"},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			d, e := g.WithParameters(c.params)
+			if e != nil {
+				t.Fatalf("WithParameters(%v): %v", c.params, e)
+			}
+
+			if s := fmt.Sprint(d); s != c.expected {
+				t.Errorf("Mismatch. want: %s, got: %s", c.expected, s)
+			}
+
+			if d.(*Gamma).src != src {
+				t.Errorf("source not kept")
+			}
+		})
+	}
+
+	tr, _ := NewTruncated(g, 1, 2)
+	d, e := tr.WithParameters(map[string]float64{"max": 3})
+	if e != nil {
+		t.Fatalf("WithParameters: %v", e)
+	}
+
+	if s, want := fmt.Sprint(d), "Truncated(Gamma(shape=2, rate=0.5), min=1, max=3)"; s != want {
+		t.Errorf("Mismatch. want: %s, got: %s", want, s)
+	}
+}
+
+func TestWithParametersErrors(t *testing.T) {
+	// returned without raising them, so that none of these panics under the default handler
+	g, _ := NewGamma(2, .5)
+	cases := []map[string]float64{
+		{"scale": 1},
+		{"shape": -1},
+		{"shape": 3, "k": 4},
+		{"θ": 2}, // the scale, which Gamma does not take
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			if d, e := g.WithParameters(c); e == nil {
+				t.Errorf("WithParameters(%v) want error, got: %v", c, d)
+			}
+		})
+	}
+
+	c, _ := NewChi(3)
+	if d, e := c.WithParameters(map[string]float64{"dof": 2.5}); e == nil {
+		t.Errorf("WithParameters(dof=2.5) want error, got: %v", d)
+	}
+}
+
+func TestValidateMessage(t *testing.T) {
+	_, e := NewGamma(-1, 1)
+	if e == nil {
+		t.Fatalf("NewGamma(-1, 1) want error")
+	}
+
+	expected := "Gamma: shape (k) = -1 is outside (0,∞)"
+	if e.Error() != expected {
+		t.Errorf("Mismatch. want: %s, got: %s", expected, e.Error())
+	}
+}
+
+// Cross-checks every constructor against the limits its type declares: values outside a parameter's
+// limits are rejected, and every accepted distribution lies within its own limits.
+func TestConstructorLimits(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			entry, _ := Lookup(name)
+			base, e := Parse(registrySpecs[name])
+			if e != nil {
+				t.Fatalf("Parse(%q): %v", registrySpecs[name], e)
+			}
+
+			_, dists, values := base.(specifier).spec()
+			limits := base.(interface{ Parameters() stats.Limits }).Parameters()
+			for i, par := range entry.Params {
+				if par.Name == "lower" {
+					continue // the support of circular distributions, checked by its constructor
+				}
+
+				lim, ok := limits[par.Symbol]
+				if !ok {
+					t.Errorf("%s.%s has no declared limits", name, par.Name)
+					continue
+				}
+
+				for _, v := range limitProbes(lim, par.Integer) {
+					p := append([]float64(nil), values...)
+					p[i] = v
+
+					d, e := entry.New(dists, p, nil)
+					if e != nil {
+						if se, ok := e.(err.StatsError); !ok || se.Status() != err.EINVAL {
+							t.Errorf("%s.%s = %v: want EINVAL, got: %v", name, par.Name, v, e)
+						}
+						continue
+					}
+
+					if !lim.IsWithinInterval(v) {
+						t.Errorf("%s.%s = %v is outside %v but was accepted", name, par.Name, v, lim)
+					}
+
+					_, _, got := d.(specifier).spec()
+					own := d.(interface{ Parameters() stats.Limits }).Parameters()
+					for j, q := range entry.Params {
+						if l, ok := own[q.Symbol]; ok && !l.IsWithinInterval(got[j]) {
+							t.Errorf("%v accepted with %s = %v outside %v", d, q.Name, got[j], l)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+// Finite values on, just inside and just outside each finite end of lim, and NaN unless the parameter is an
+// integer, which the registry rejects before it reaches a constructor.
+func limitProbes(lim stats.Interval, integer bool) []float64 {
+	var probes []float64
+	if !integer {
+		probes = append(probes, math.NaN())
+	}
+
+	for _, end := range []float64{lim.Lower, lim.Upper} {
+		if math.IsInf(end, 0) {
+			continue
+		}
+
+		d := 1e-9 * math.Max(1, math.Abs(end))
+		if integer {
+			d = 1
+		}
+
+		probes = append(probes, end, end-d, end+d, end-1, end+1)
+	}
+
+	return probes
+}
+
+type moments struct{ mean, variance float64 }
+
+func (m moments) Mean() float64     { return m.mean }
+func (m moments) Variance() float64 { return m.variance }
+
+// The method of moments recovers a distribution from its own mean and variance.
+func TestFromMoments(t *testing.T) {
+	tol := 1e-12
+	g, _ := NewGamma(3.5, 1.25)
+	ln, _ := NewLogNormal(.3, .8)
+	b, _ := NewBeta(2, 5)
+	cases := []struct {
+		from func(SampleMoments) (Parametric, error)
+		want Parametric
+	}{
+		{func(m SampleMoments) (Parametric, error) { return NewGammaFromMoments(m) }, g},
+		{func(m SampleMoments) (Parametric, error) { return NewLogNormalFromMoments(m) }, ln},
+		{func(m SampleMoments) (Parametric, error) { return NewBetaFromMoments(m) }, b},
+	}
+
+	for _, c := range cases {
+		got, e := c.from(c.want.(SampleMoments))
+		if e != nil {
+			t.Errorf("Mismatch. %v: %v", c.want, e)
+			continue
+		}
+
+		want := c.want.ParameterValues()
+		for k, v := range got.ParameterValues() {
+			if math.Abs(v-want[k]) > tol*math.Abs(want[k]) {
+				t.Errorf("Mismatch. %v %s want: %v, got: %v", c.want, k, want[k], v)
+			}
+		}
+	}
+
+	invalid := []struct {
+		from func(SampleMoments) (Parametric, error)
+		m    moments
+	}{
+		{func(m SampleMoments) (Parametric, error) { return NewGammaFromMoments(m) }, moments{-1, 1}},
+		{func(m SampleMoments) (Parametric, error) { return NewGammaFromMoments(m) }, moments{1, 0}},
+		{func(m SampleMoments) (Parametric, error) { return NewLogNormalFromMoments(m) }, moments{0, 1}},
+		{func(m SampleMoments) (Parametric, error) { return NewLogNormalFromMoments(m) }, moments{1, math.NaN()}},
+		{func(m SampleMoments) (Parametric, error) { return NewBetaFromMoments(m) }, moments{1.5, .1}},
+		{func(m SampleMoments) (Parametric, error) { return NewBetaFromMoments(m) }, moments{.5, .25}},
+	}
+
+	for _, c := range invalid {
+		if _, e := c.from(c.m); e == nil || e.(err.StatsError).Status() != err.EINVAL {
+			t.Errorf("Mismatch. %v want: EINVAL, got: %v", c.m, e)
+		}
+	}
+}
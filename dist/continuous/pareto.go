@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/linear"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -21,11 +20,44 @@ func NewPareto(shape, xmin float64) (*Pareto, error) {
 }
 
 func NewParetoWithSource(shape, xmin float64, src rand.Source) (*Pareto, error) {
-	if shape <= 0 || xmin <= 0 {
-		return nil, err.Invalid()
+	r := &Pareto{shape, xmin, src, nil}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Pareto{shape, xmin, src, nil}, nil
+	return r, nil
+}
+
+func (p *Pareto) spec() (string, []Common, []float64) {
+	return "Pareto", nil, []float64{p.shape, p.xmin}
+}
+
+func (p *Pareto) String() string {
+	return specString(p.spec())
+}
+
+func (p *Pareto) MarshalJSON() ([]byte, error) {
+	return marshalJSON(p)
+}
+
+func (p *Pareto) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, p, p.src)
+}
+
+func (p *Pareto) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *Pareto) UnmarshalText(text []byte) error {
+	return unmarshalText(text, p, p.src)
+}
+
+func (p *Pareto) ParameterValues() map[string]float64 {
+	return parameterValues(p)
+}
+
+func (p *Pareto) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(p, params, p.src)
 }
 
 // a ∈ (0,∞)
@@ -68,7 +100,7 @@ func (p *Pareto) Entropy() float64 {
 
 func (p *Pareto) ExKurtosis() float64 {
 	if p.shape <= 4 {
-		return 0
+		return math.Inf(1)
 	}
 	return 6 * (p.shape*p.shape*p.shape + p.shape*p.shape - 6*p.shape - 2) / (p.shape * (p.shape - 3) * (p.shape - 4))
 
@@ -88,7 +120,19 @@ func (p *Pareto) Inverse(q float64) float64 {
 	}
 
 	if q <= 0 {
-		return 0
+		return p.xmin
+	}
+
+	return math.Inf(1)
+}
+
+func (p *Pareto) InverseSurvival(q float64) float64 {
+	if q > 0 && q < 1 {
+		return p.xmin / math.Pow(q, 1/p.shape)
+	}
+
+	if q >= 1 {
+		return p.xmin
 	}
 
 	return math.Inf(1)
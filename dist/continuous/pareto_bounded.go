@@ -2,7 +2,6 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -19,11 +18,44 @@ func NewParetoBounded(min, max, shape float64) (*ParetoBounded, error) {
 }
 
 func NewParetoBoundedWithSource(min, max, shape float64, src rand.Source) (*ParetoBounded, error) {
-	if min >= max || shape <= 0 {
-		return nil, err.Invalid()
+	r := &ParetoBounded{min, max, shape, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &ParetoBounded{min, max, shape, src}, nil
+	return r, nil
+}
+
+func (p ParetoBounded) spec() (string, []Common, []float64) {
+	return "ParetoBounded", nil, []float64{p.min, p.max, p.shape}
+}
+
+func (p ParetoBounded) String() string {
+	return specString(p.spec())
+}
+
+func (p ParetoBounded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(p)
+}
+
+func (p *ParetoBounded) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, p, p.src)
+}
+
+func (p ParetoBounded) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ParetoBounded) UnmarshalText(text []byte) error {
+	return unmarshalText(text, p, p.src)
+}
+
+func (p ParetoBounded) ParameterValues() map[string]float64 {
+	return parameterValues(p)
+}
+
+func (p ParetoBounded) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(p, params, p.src)
 }
 
 // L ∈ (0,∞)
@@ -54,6 +86,10 @@ func (p ParetoBounded) Probability(x float64) float64 {
 }
 
 func (p ParetoBounded) Distribution(x float64) float64 {
+	if x >= p.Support().Upper {
+		return 1
+	}
+
 	if p.Support().IsWithinInterval(x) {
 		a := p.shape
 		num := 1 - math.Pow(p.min, a)*math.Pow(x, -a)
@@ -71,17 +107,29 @@ func (p ParetoBounded) Entropy() float64 {
 
 func (p ParetoBounded) Inverse(q float64) float64 {
 	if q > 0 && q < 1 {
-		num := -q*math.Pow(p.max, p.shape) - q*math.Pow(p.min, p.shape) - math.Pow(p.max, p.shape)
-		denom := math.Pow(p.max, p.shape) * math.Pow(p.min, p.shape)
-		return math.Pow(-(num / denom), -1/p.shape)
+		r := math.Pow(p.min/p.max, p.shape)
+		return p.min * math.Pow(1-q*(1-r), -1/p.shape)
 	}
 
 	if q <= 0 {
-		return 0
+		return p.min
+	}
+
+	return p.max
+
+}
+
+func (p ParetoBounded) InverseSurvival(q float64) float64 {
+	if q > 0 && q < 1 {
+		r := math.Pow(p.min/p.max, p.shape)
+		return p.min * math.Pow(q*(1-r)+r, -1/p.shape)
 	}
 
-	return math.Inf(1)
+	if q >= 1 {
+		return p.min
+	}
 
+	return p.max
 }
 
 func (p ParetoBounded) Mean() float64 {
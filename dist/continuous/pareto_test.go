@@ -304,3 +304,27 @@ func TestParetoVariance(t *testing.T) {
 		})
 	}
 }
+
+func TestParetoInverseSurvival(t *testing.T) {
+	tol := 1e-15
+	cases := []struct {
+		α, xm, q float64
+		expected float64
+	}{
+		{2, 1.5, 0.5, 2.121320343559643},
+		{2, 1.5, 0.001, 47.43416490252569},
+		{2, 1.5, 1e-12, 1500000},
+		{0.5, 1, 0.5, 4},
+		{0.5, 1, 0.001, 1000000},
+		{0.5, 1, 1e-12, 1e+24},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			p := Pareto{shape: c.α, xmin: c.xm}
+
+			res := p.InverseSurvival(c.q)
+			run_test(t, res, c.expected, tol, "ParetoInverseSurvival")
+		})
+	}
+}
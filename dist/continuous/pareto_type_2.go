@@ -2,7 +2,6 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -20,11 +19,44 @@ func NewParetoType2(xmin, shape, location float64) (*ParetoType2, error) {
 }
 
 func NewParetoType2WithSource(xmin, shape, location float64, src rand.Source) (*ParetoType2, error) {
-	if xmin <= 0 || shape <= 0 {
-		return nil, err.Invalid()
+	r := &ParetoType2{xmin, shape, location, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &ParetoType2{xmin, shape, location, src}, nil
+	return r, nil
+}
+
+func (p *ParetoType2) spec() (string, []Common, []float64) {
+	return "ParetoType2", nil, []float64{p.xmin, p.shape, p.location}
+}
+
+func (p *ParetoType2) String() string {
+	return specString(p.spec())
+}
+
+func (p *ParetoType2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(p)
+}
+
+func (p *ParetoType2) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, p, p.src)
+}
+
+func (p *ParetoType2) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ParetoType2) UnmarshalText(text []byte) error {
+	return unmarshalText(text, p, p.src)
+}
+
+func (p *ParetoType2) ParameterValues() map[string]float64 {
+	return parameterValues(p)
+}
+
+func (p *ParetoType2) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(p, params, p.src)
 }
 
 // xm ∈ (0,∞)
@@ -68,7 +100,19 @@ func (p *ParetoType2) Inverse(q float64) float64 {
 		return math.Inf(1)
 	}
 
-	return p.xmin*math.Pow(-1+(1-q), (-1/p.shape)) + p.location
+	return p.xmin*math.Expm1(-math.Log1p(-q)/p.shape) + p.location
+}
+
+func (p *ParetoType2) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return p.location
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return p.xmin*math.Expm1(-math.Log(q)/p.shape) + p.location
 }
 
 func (p *ParetoType2) Mean() float64 {
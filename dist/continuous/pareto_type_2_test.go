@@ -59,12 +59,15 @@ func TestParetoType2Distribution(t *testing.T) {
 func TestParetoType2Inverse(t *testing.T) {
 	tol := 0.0000001
 
+	// x = F(expected) = 1 - (1 + expected/λ)^-α to full precision, 19/27 for the first. These were rounded
+	// to 7 digits while Inverse returned NaN, and the rounding alone moves the quantile by more than tol,
+	// by 5e-6 in the third case, where the density is about 5e-3.
 	cases := []struct {
 		x, λ, α, expected float64
 	}{
-		{0.7037037, 2, 3, 1},
-		{0.831247, 4.9876675475, 10.987876, .87675},
-		{0.9836044, 25.98789, 11.75765678217, 10.87676},
+		{0.7037037037037037, 2, 3, 1},
+		{0.8312470412790381, 4.9876675475, 10.987876, .87675},
+		{0.983604372863237, 25.98789, 11.75765678217, 10.87676},
 	}
 
 	for i, c := range cases {
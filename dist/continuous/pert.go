@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -21,21 +20,54 @@ func NewPERT(min, max, mode float64) (*PERT, error) {
 }
 
 func NewPERTWithSource(min, max, mode float64, src rand.Source) (*PERT, error) {
-	if min <= 0 || mode <= min || max <= mode {
-		return nil, err.Invalid()
+	r := &PERT{min, max, mode, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &PERT{min, max, mode, src}, nil
+	return r, nil
 }
 
-// min ∈ (0,∞)
+func (p *PERT) spec() (string, []Common, []float64) {
+	return "PERT", nil, []float64{p.min, p.max, p.mode}
+}
+
+func (p *PERT) String() string {
+	return specString(p.spec())
+}
+
+func (p *PERT) MarshalJSON() ([]byte, error) {
+	return marshalJSON(p)
+}
+
+func (p *PERT) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, p, p.src)
+}
+
+func (p *PERT) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *PERT) UnmarshalText(text []byte) error {
+	return unmarshalText(text, p, p.src)
+}
+
+func (p *PERT) ParameterValues() map[string]float64 {
+	return parameterValues(p)
+}
+
+func (p *PERT) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(p, params, p.src)
+}
+
+// min ∈ (-∞,∞)
 // mode ∈ (min,max)
 // max ∈ (mode,∞)
 func (p *PERT) Parameters() stats.Limits {
 	return stats.Limits{
-		"a": stats.Interval{0, math.Inf(1), true, true},
-		"b": stats.Interval{p.min, p.max, false, true},
-		"c": stats.Interval{p.mode, math.Inf(1), false, true},
+		"a": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
+		"b": stats.Interval{p.min, p.max, true, true},
+		"c": stats.Interval{p.mode, math.Inf(1), true, true},
 	}
 }
 
@@ -65,6 +97,10 @@ func (p *PERT) Probability(x float64) float64 {
 }
 
 func (p *PERT) Distribution(x float64) float64 {
+	if x >= p.Support().Upper {
+		return 1
+	}
+
 	if p.Support().IsWithinInterval(x) {
 		a := p.alpha()
 		b := p.beta()
@@ -101,7 +137,19 @@ func (p *PERT) Inverse(q float64) float64 {
 		return p.min
 	}
 
-	return p.min + (p.max-p.min)*smath.InverseRegularizedIncompleteBeta(1+((p.mode-p.min)/(p.max-p.min)), 1+((-p.mode+p.max)/(p.max-p.min)), q)
+	return p.min + (p.max-p.min)*smath.InverseRegularizedIncompleteBeta(p.alpha(), p.beta(), q)
+}
+
+func (p *PERT) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return p.min
+	}
+
+	if q <= 0 {
+		return p.max
+	}
+
+	return p.max - (p.max-p.min)*smath.InverseRegularizedIncompleteBeta(p.beta(), p.alpha(), q)
 }
 
 func (p *PERT) Mean() float64 {
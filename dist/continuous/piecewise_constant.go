@@ -0,0 +1,282 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Piecewise constant distribution, a histogram: the weight of each bin between consecutive edges, spread
+// uniformly over it, as C++'s std::piecewise_constant_distribution. Rand picks a bin from an alias table
+// and a point within it uniformly.
+// https://en.cppreference.com/w/cpp/numeric/random/piecewise_constant_distribution
+type PiecewiseConstant struct {
+	baseContinuousWithSource
+	edges            []float64
+	p, cum, sur      []float64 // probability of each bin, below each edge and above it
+	table            alias
+	mean, m2, m3, m4 float64
+}
+
+func NewPiecewiseConstant(edges, weights []float64) (*PiecewiseConstant, error) {
+	return NewPiecewiseConstantWithSource(edges, weights, nil)
+}
+
+// NewPiecewiseConstantWithSource returns the distribution with len(edges)-1 bins, bin i between edges[i]
+// and edges[i+1] having probability in proportion to weights[i]. The edges are finite and increasing and
+// the weights nonnegative, of positive sum. Both are copied.
+func NewPiecewiseConstantWithSource(edges, weights []float64, src rand.Source) (*PiecewiseConstant, error) {
+	if e := checkPiecewise("PiecewiseConstant", edges, weights, len(edges)-1); e != nil {
+		return nil, e
+	}
+
+	r := new(PiecewiseConstant)
+	r.edges = append([]float64(nil), edges...)
+	r.p = make([]float64, len(weights))
+	r.cum = make([]float64, len(edges))
+	r.sur = make([]float64, len(edges))
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+
+	for i, w := range weights {
+		r.p[i] = w / total
+		r.cum[i+1] = r.cum[i] + r.p[i]
+	}
+	r.cum[len(weights)] = 1
+	for i := len(weights) - 1; i > 0; i-- {
+		r.sur[i] = r.sur[i+1] + r.p[i]
+	}
+	r.sur[0] = 1
+	r.table = newAlias(r.p)
+	r.src = src
+	r.moments()
+
+	return r, nil
+}
+
+func NewPiecewiseConstantFromData(x []float64, rule BinRule) (*PiecewiseConstant, error) {
+	return NewPiecewiseConstantFromDataWithSource(x, rule, nil)
+}
+
+// NewPiecewiseConstantFromDataWithSource returns the histogram of x over the bins rule picks, see Bins.
+func NewPiecewiseConstantFromDataWithSource(x []float64, rule BinRule, src rand.Source) (*PiecewiseConstant, error) {
+	edges, e := Bins(x, rule)
+	if e != nil {
+		return nil, e
+	}
+
+	return NewPiecewiseConstantWithSource(edges, histogram(x, edges), src)
+}
+
+// Checks that edges are finite and increasing, and that there are n weights, finite and nonnegative, of
+// positive sum.
+func checkPiecewise(name string, edges, weights []float64, n int) error {
+	if len(edges) < 2 {
+		return err.New(err.EINVAL, fmt.Sprintf("%s: %v edges are fewer than 2", name, len(edges)))
+	}
+
+	for i, v := range edges {
+		if math.IsNaN(v) || math.IsInf(v, 0) || i > 0 && !(v > edges[i-1]) {
+			return err.New(err.EINVAL, fmt.Sprintf("%s: edges %v are not finite and increasing", name, edges))
+		}
+	}
+
+	if len(weights) != n {
+		return err.New(err.EINVAL, fmt.Sprintf("%s: %v weights, not %v", name, len(weights), n))
+	}
+
+	var total float64
+	for _, w := range weights {
+		if !(w >= 0) || math.IsInf(w, 1) {
+			return err.New(err.EINVAL, fmt.Sprintf("%s: weight %v is not finite and nonnegative", name, w))
+		}
+		total += w
+	}
+
+	if !(total > 0) || math.IsInf(total, 1) {
+		return err.New(err.EINVAL, fmt.Sprintf("%s: weights of sum %v", name, total))
+	}
+
+	return nil
+}
+
+// The mean and central moments, as of a mixture of uniforms.
+func (pc *PiecewiseConstant) moments() {
+	var t [5]float64
+	for k := range t {
+		t[k] = 1 / float64(k+1) // E[Uᵏ]
+	}
+
+	for i, p := range pc.p {
+		pc.mean += p * shiftedMoments(pc.edges[i], pc.edges[i+1]-pc.edges[i], t)[1]
+	}
+
+	var mu [5]float64
+	for i, p := range pc.p {
+		m := shiftedMoments(pc.edges[i]-pc.mean, pc.edges[i+1]-pc.edges[i], t)
+		for r := 2; r < 5; r++ {
+			mu[r] += p * m[r]
+		}
+	}
+	pc.m2, pc.m3, pc.m4 = mu[2], mu[3], mu[4]
+}
+
+// E[(a + wT)ʳ] for r ≤ 4, from the moments t of T.
+func shiftedMoments(a, w float64, t [5]float64) [5]float64 {
+	binomial := [5][5]float64{{1}, {1, 1}, {1, 2, 1}, {1, 3, 3, 1}, {1, 4, 6, 4, 1}}
+	var m [5]float64
+	for r := range m {
+		for k := 0; k <= r; k++ {
+			m[r] += binomial[r][k] * math.Pow(a, float64(r-k)) * math.Pow(w, float64(k)) * t[k]
+		}
+	}
+
+	return m
+}
+
+// String describes pc, but as its edges and weights are not part of it, it is not a spec that Parse
+// accepts.
+func (pc *PiecewiseConstant) String() string {
+	return fmt.Sprintf("PiecewiseConstant(%d bins on [%v, %v])", len(pc.p), pc.edges[0], pc.edges[len(pc.edges)-1])
+}
+
+// Edges returns a copy of the edges of the bins.
+func (pc *PiecewiseConstant) Edges() []float64 {
+	return append([]float64(nil), pc.edges...)
+}
+
+// Weights returns the probability of each bin, the weights normalised.
+func (pc *PiecewiseConstant) Weights() []float64 {
+	return append([]float64(nil), pc.p...)
+}
+
+// x ∈ [e₀,eₙ]
+func (pc *PiecewiseConstant) Support() stats.Interval {
+	return stats.Interval{pc.edges[0], pc.edges[len(pc.edges)-1], false, false}
+}
+
+// Index of the bin of x between edges, each closed on the left and the last on the right too, -1 outside.
+func binOf(edges []float64, x float64) int {
+	n := len(edges) - 1
+	if !(x >= edges[0] && x <= edges[n]) {
+		return -1
+	}
+
+	i := sort.Search(n, func(i int) bool { return edges[i+1] > x })
+	if i == n {
+		i--
+	}
+
+	return i
+}
+
+func (pc *PiecewiseConstant) Probability(x float64) float64 {
+	i := binOf(pc.edges, x)
+	if i < 0 {
+		return 0
+	}
+
+	return pc.p[i] / (pc.edges[i+1] - pc.edges[i])
+}
+
+func (pc *PiecewiseConstant) Distribution(x float64) float64 {
+	i := binOf(pc.edges, x)
+	switch {
+	case i >= 0:
+		return math.Min(1, pc.cum[i]+pc.p[i]*(x-pc.edges[i])/(pc.edges[i+1]-pc.edges[i]))
+	case x > pc.edges[0]:
+		return 1
+	}
+
+	return 0
+}
+
+func (pc *PiecewiseConstant) Inverse(p float64) float64 {
+	n := len(pc.p)
+	if p <= 0 {
+		return pc.edges[0]
+	}
+
+	if p >= 1 {
+		return pc.edges[n]
+	}
+
+	// the first bin to reach p, which has some weight
+	i := sort.Search(n, func(i int) bool { return pc.cum[i+1] >= p })
+	x := pc.edges[i] + (p-pc.cum[i])/pc.p[i]*(pc.edges[i+1]-pc.edges[i])
+
+	return math.Min(x, pc.edges[i+1])
+}
+
+// InverseSurvival counts q down from the upper end, so that it keeps its digits.
+func (pc *PiecewiseConstant) InverseSurvival(q float64) float64 {
+	n := len(pc.p)
+	if q >= 1 {
+		return pc.edges[0]
+	}
+
+	if q <= 0 {
+		return pc.edges[n]
+	}
+
+	// the last bin to hold q above its start, which has some weight
+	i := sort.Search(n, func(i int) bool { return pc.sur[i+1] < q })
+	x := pc.edges[i+1] - (q-pc.sur[i+1])/pc.p[i]*(pc.edges[i+1]-pc.edges[i])
+
+	return math.Max(x, pc.edges[i])
+}
+
+func (pc *PiecewiseConstant) Mean() float64 {
+	return pc.mean
+}
+
+func (pc *PiecewiseConstant) Median() float64 {
+	return pc.Inverse(.5)
+}
+
+// Mode returns the middle of the bin of highest density, the first of them if there are several.
+func (pc *PiecewiseConstant) Mode() float64 {
+	best := 0
+	for i := range pc.p {
+		if pc.Probability(pc.edges[i]) > pc.Probability(pc.edges[best]) {
+			best = i
+		}
+	}
+
+	return (pc.edges[best] + pc.edges[best+1]) / 2
+}
+
+func (pc *PiecewiseConstant) Variance() float64 {
+	return pc.m2
+}
+
+func (pc *PiecewiseConstant) Skewness() float64 {
+	return pc.m3 / math.Pow(pc.m2, 1.5)
+}
+
+func (pc *PiecewiseConstant) ExKurtosis() float64 {
+	return pc.m4/(pc.m2*pc.m2) - 3
+}
+
+func (pc *PiecewiseConstant) Rand() float64 {
+	var rnd *rand.Rand
+	if pc.src != nil {
+		rnd = rand.New(pc.src)
+	}
+
+	i := pc.table.draw(randFloat64(rnd), randFloat64(rnd))
+	return pc.edges[i] + randFloat64(rnd)*(pc.edges[i+1]-pc.edges[i])
+}
+
+func randFloat64(rnd *rand.Rand) float64 {
+	if rnd != nil {
+		return rnd.Float64()
+	}
+
+	return rand.Float64()
+}
@@ -0,0 +1,109 @@
+package continuous
+
+import (
+	stattest "github.com/jtejido/stats/testing"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestPiecewiseConstant(t *testing.T) {
+	// bins [0,1), [1,3), [3,4), [4,6] of probability .1, .4, 0, .5
+	pc, e := NewPiecewiseConstant([]float64{0, 1, 3, 4, 6}, []float64{1, 4, 0, 5})
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	cases := []struct {
+		x, pdf, cdf float64
+	}{
+		{-1, 0, 0},
+		{0, .1, 0},
+		{.5, .1, .05},
+		{1, .2, .1},
+		{2, .2, .3},
+		{3.5, 0, .5},
+		{4, .25, .5},
+		{5, .25, .75},
+		{6, .25, 1},
+		{7, 0, 1},
+	}
+
+	for _, c := range cases {
+		run_test(t, pc.Probability(c.x), c.pdf, 1e-15, "PiecewiseConstant Probability")
+		run_test(t, pc.Distribution(c.x), c.cdf, 1e-15, "PiecewiseConstant Distribution")
+	}
+
+	// the empty bin is skipped
+	for _, c := range []struct{ p, x float64 }{{0, 0}, {.05, .5}, {.3, 2}, {.5, 3}, {.5 + 1e-12, 4 + 4e-12}, {.75, 5}, {1, 6}} {
+		run_test(t, pc.Inverse(c.p), c.x, 1e-11, "PiecewiseConstant Inverse")
+	}
+
+	// counted from the top, where the empty bin is skipped the other way
+	for _, c := range []struct{ q, x float64 }{{1, 0}, {.95, .5}, {.7, 2}, {.5, 4}, {.5 - 1e-12, 4 + 4e-12}, {.25, 5}, {1e-12, 6 - 4e-12}, {0, 6}} {
+		run_test(t, pc.InverseSurvival(c.q), c.x, 1e-11, "PiecewiseConstant InverseSurvival")
+	}
+
+	// a mixture of uniforms
+	mean := .1*.5 + .4*2 + .5*5
+	m2 := .1*(1./12+.25) + .4*(4./12+4) + .5*(4./12+25) - mean*mean
+	run_test(t, pc.Mean(), mean, 1e-15, "PiecewiseConstant Mean")
+	run_test(t, pc.Variance(), m2, 1e-14, "PiecewiseConstant Variance")
+	run_test(t, pc.Median(), 3, 1e-15, "PiecewiseConstant Median")
+	run_test(t, pc.Mode(), 5, 1e-15, "PiecewiseConstant Mode")
+}
+
+func TestPiecewiseConstantConformance(t *testing.T) {
+	pc, _ := NewPiecewiseConstantWithSource([]float64{-2, -1, 0, .5, 3}, []float64{1, 3, 2, 4}, rand.NewSource(1))
+	stattest.Conformance(t, pc, stattest.Config{Tol: 1e-7})
+}
+
+// The alias table draws each bin as often as its weight says, the empty one never.
+func TestPiecewiseConstantRand(t *testing.T) {
+	pc, _ := NewPiecewiseConstantWithSource([]float64{0, 1, 2, 3, 4}, []float64{.5, 0, 3, 1.5}, rand.NewSource(2))
+	const n = 100000
+	var counts [4]float64
+	for i := 0; i < n; i++ {
+		counts[int(pc.Rand())]++
+	}
+
+	for i, p := range pc.Weights() {
+		if math.Abs(counts[i]/n-p) > 4*math.Sqrt(p*(1-p)/n) {
+			t.Errorf("Mismatch. bin %d want: %v, got: %v", i, p, counts[i]/n)
+		}
+	}
+}
+
+func TestPiecewiseConstantFromData(t *testing.T) {
+	pc, e := NewPiecewiseConstantFromData(empiricalData, SturgesBins)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	// 5 bins of width 1.7 from .7: 3, 2, 2, 1, 2 observations
+	want := []float64{.3, .2, .2, .1, .2}
+	for i, p := range pc.Weights() {
+		run_test(t, p, want[i], 1e-15, "PiecewiseConstant from data")
+	}
+	run_test(t, pc.Edges()[5], 9.2, 1e-15, "PiecewiseConstant from data")
+}
+
+func TestPiecewiseConstantErrors(t *testing.T) {
+	cases := []struct {
+		edges, weights []float64
+	}{
+		{[]float64{1}, nil},
+		{[]float64{0, 1, 1}, []float64{1, 1}},
+		{[]float64{0, math.Inf(1)}, []float64{1}},
+		{[]float64{0, 1, 2}, []float64{1}},
+		{[]float64{0, 1, 2}, []float64{1, -1}},
+		{[]float64{0, 1, 2}, []float64{0, 0}},
+		{[]float64{0, 1, 2}, []float64{1, math.NaN()}},
+	}
+
+	for _, c := range cases {
+		if _, e := NewPiecewiseConstant(c.edges, c.weights); e == nil {
+			t.Errorf("Mismatch. PiecewiseConstant%v want: error, got: nil", c)
+		}
+	}
+}
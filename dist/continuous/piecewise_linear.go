@@ -0,0 +1,258 @@
+package continuous
+
+import (
+	"fmt"
+	"github.com/jtejido/stats"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Piecewise linear distribution, whose density is linear between consecutive edges through weights in
+// proportion to the density at each edge, as C++'s std::piecewise_linear_distribution. Rand picks a
+// segment from an alias table and a point within it by inversion.
+// https://en.cppreference.com/w/cpp/numeric/random/piecewise_linear_distribution
+type PiecewiseLinear struct {
+	baseContinuousWithSource
+	edges, f         []float64 // density at each edge
+	p, cum, sur      []float64 // probability of each segment, below each edge and above it
+	table            alias
+	mean, m2, m3, m4 float64
+}
+
+func NewPiecewiseLinear(edges, weights []float64) (*PiecewiseLinear, error) {
+	return NewPiecewiseLinearWithSource(edges, weights, nil)
+}
+
+// NewPiecewiseLinearWithSource returns the distribution whose density at edges[i] is in proportion to
+// weights[i]. The edges are finite and increasing and the weights nonnegative, of positive sum. Both
+// are copied.
+func NewPiecewiseLinearWithSource(edges, weights []float64, src rand.Source) (*PiecewiseLinear, error) {
+	if e := checkPiecewise("PiecewiseLinear", edges, weights, len(edges)); e != nil {
+		return nil, e
+	}
+
+	r := new(PiecewiseLinear)
+	r.edges = append([]float64(nil), edges...)
+	n := len(edges) - 1
+	r.f = make([]float64, n+1)
+	r.p = make([]float64, n)
+	r.cum = make([]float64, n+1)
+	r.sur = make([]float64, n+1)
+	var area float64
+	for i := 0; i < n; i++ {
+		area += (weights[i] + weights[i+1]) / 2 * (edges[i+1] - edges[i])
+	}
+
+	for i, w := range weights {
+		r.f[i] = w / area
+	}
+
+	for i := range r.p {
+		r.p[i] = (r.f[i] + r.f[i+1]) / 2 * (edges[i+1] - edges[i])
+		r.cum[i+1] = r.cum[i] + r.p[i]
+	}
+	r.cum[n] = 1
+	for i := n - 1; i > 0; i-- {
+		r.sur[i] = r.sur[i+1] + r.p[i]
+	}
+	r.sur[0] = 1
+	r.table = newAlias(r.p)
+	r.src = src
+	r.moments()
+
+	return r, nil
+}
+
+func NewPiecewiseLinearFromData(x []float64, rule BinRule) (*PiecewiseLinear, error) {
+	return NewPiecewiseLinearFromDataWithSource(x, rule, nil)
+}
+
+// NewPiecewiseLinearFromDataWithSource returns the frequency polygon of x over the bins rule picks, see
+// Bins: the density joins the heights of the histogram at the middle of each bin, falling to 0 at the
+// middle of an empty bin added at each end, so that it has the area of the histogram and its mean.
+func NewPiecewiseLinearFromDataWithSource(x []float64, rule BinRule, src rand.Source) (*PiecewiseLinear, error) {
+	bins, e := Bins(x, rule)
+	if e != nil {
+		return nil, e
+	}
+
+	counts := histogram(x, bins)
+	w := bins[1] - bins[0]
+	edges := make([]float64, len(counts)+2)
+	weights := make([]float64, len(counts)+2)
+	edges[0] = bins[0] - w/2
+	for i, c := range counts {
+		edges[i+1] = (bins[i] + bins[i+1]) / 2
+		weights[i+1] = c
+	}
+	edges[len(edges)-1] = bins[len(bins)-1] + w/2
+
+	return NewPiecewiseLinearWithSource(edges, weights, src)
+}
+
+// The mean and central moments, each segment being a mixture of two triangular distributions, of the
+// density at either end.
+func (pl *PiecewiseLinear) moments() {
+	var down, up [5]float64
+	for k := range down {
+		down[k] = 2 / float64((k+1)*(k+2)) // E[Tᵏ] for T ~ Beta(1, 2)
+		up[k] = 2 / float64(k+2)           // and Beta(2, 1)
+	}
+
+	raw := func(c float64) (mu [5]float64) {
+		for i := range pl.p {
+			w := pl.edges[i+1] - pl.edges[i]
+			d, u := shiftedMoments(pl.edges[i]-c, w, down), shiftedMoments(pl.edges[i]-c, w, up)
+			for r := range mu {
+				mu[r] += pl.f[i]*w/2*d[r] + pl.f[i+1]*w/2*u[r]
+			}
+		}
+
+		return mu
+	}
+
+	pl.mean = raw(0)[1]
+	mu := raw(pl.mean)
+	pl.m2, pl.m3, pl.m4 = mu[2], mu[3], mu[4]
+}
+
+// String describes pl, but as its edges and weights are not part of it, it is not a spec that Parse
+// accepts.
+func (pl *PiecewiseLinear) String() string {
+	return fmt.Sprintf("PiecewiseLinear(%d segments on [%v, %v])", len(pl.p), pl.edges[0], pl.edges[len(pl.edges)-1])
+}
+
+// Edges returns a copy of the edges of the segments.
+func (pl *PiecewiseLinear) Edges() []float64 {
+	return append([]float64(nil), pl.edges...)
+}
+
+// Densities returns the density at each edge, the weights normalised.
+func (pl *PiecewiseLinear) Densities() []float64 {
+	return append([]float64(nil), pl.f...)
+}
+
+// x ∈ [e₀,eₙ]
+func (pl *PiecewiseLinear) Support() stats.Interval {
+	return stats.Interval{pl.edges[0], pl.edges[len(pl.edges)-1], false, false}
+}
+
+func (pl *PiecewiseLinear) Probability(x float64) float64 {
+	i := binOf(pl.edges, x)
+	if i < 0 {
+		return 0
+	}
+
+	return interpolate(x, pl.edges[i], pl.edges[i+1], pl.f[i], pl.f[i+1])
+}
+
+// Linear interpolation at t between (t0, y0) and (t1, y1), t0 < t1.
+func interpolate(t, t0, t1, y0, y1 float64) float64 {
+	return y0 + (y1-y0)*(t-t0)/(t1-t0)
+}
+
+func (pl *PiecewiseLinear) Distribution(x float64) float64 {
+	i := binOf(pl.edges, x)
+	switch {
+	case i >= 0:
+		s := x - pl.edges[i]
+		return math.Min(1, pl.cum[i]+s*(pl.f[i]+pl.Probability(x))/2)
+	case x > pl.edges[0]:
+		return 1
+	}
+
+	return 0
+}
+
+func (pl *PiecewiseLinear) Inverse(p float64) float64 {
+	n := len(pl.p)
+	if p <= 0 {
+		return pl.edges[0]
+	}
+
+	if p >= 1 {
+		return pl.edges[n]
+	}
+
+	// the first segment to reach p, which has some weight
+	i := sort.Search(n, func(i int) bool { return pl.cum[i+1] >= p })
+	return pl.edges[i] + pl.within(i, p-pl.cum[i])
+}
+
+// InverseSurvival counts q down from the upper end, so that it keeps its digits.
+func (pl *PiecewiseLinear) InverseSurvival(q float64) float64 {
+	n := len(pl.p)
+	if q >= 1 {
+		return pl.edges[0]
+	}
+
+	if q <= 0 {
+		return pl.edges[n]
+	}
+
+	// the last segment to hold q above its start, which has some weight
+	i := sort.Search(n, func(i int) bool { return pl.sur[i+1] < q })
+	w := pl.edges[i+1] - pl.edges[i]
+	return pl.edges[i+1] - reach(pl.f[i+1], (pl.f[i]-pl.f[i+1])/w, w, q-pl.sur[i+1])
+}
+
+// The distance s into segment i at which the probability from its start reaches m.
+func (pl *PiecewiseLinear) within(i int, m float64) float64 {
+	w := pl.edges[i+1] - pl.edges[i]
+	return reach(pl.f[i], (pl.f[i+1]-pl.f[i])/w, w, m)
+}
+
+// The distance s ≤ w from an end of density f₀ at which the density, changing by slope away from it,
+// holds m, solving f₀s + slope s²/2 = m in a form stable for either slope. m = 0 is reached at once, even
+// where f₀ = 0.
+func reach(f0, slope, w, m float64) float64 {
+	if m <= 0 {
+		return 0
+	}
+
+	s := 2 * m / (f0 + math.Sqrt(math.Max(0, f0*f0+2*slope*m)))
+	return math.Min(s, w)
+}
+
+func (pl *PiecewiseLinear) Mean() float64 {
+	return pl.mean
+}
+
+func (pl *PiecewiseLinear) Median() float64 {
+	return pl.Inverse(.5)
+}
+
+// Mode returns the edge of highest density, the first of them if there are several.
+func (pl *PiecewiseLinear) Mode() float64 {
+	best := 0
+	for i, f := range pl.f {
+		if f > pl.f[best] {
+			best = i
+		}
+	}
+
+	return pl.edges[best]
+}
+
+func (pl *PiecewiseLinear) Variance() float64 {
+	return pl.m2
+}
+
+func (pl *PiecewiseLinear) Skewness() float64 {
+	return pl.m3 / math.Pow(pl.m2, 1.5)
+}
+
+func (pl *PiecewiseLinear) ExKurtosis() float64 {
+	return pl.m4/(pl.m2*pl.m2) - 3
+}
+
+func (pl *PiecewiseLinear) Rand() float64 {
+	var rnd *rand.Rand
+	if pl.src != nil {
+		rnd = rand.New(pl.src)
+	}
+
+	i := pl.table.draw(randFloat64(rnd), randFloat64(rnd))
+	return pl.edges[i] + pl.within(i, randFloat64(rnd)*pl.p[i])
+}
@@ -0,0 +1,106 @@
+package continuous
+
+import (
+	stattest "github.com/jtejido/stats/testing"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestPiecewiseLinear(t *testing.T) {
+	// a triangle on [0, 2] rising to 1 at 1, then a flat stretch of .25 to 4
+	pl, e := NewPiecewiseLinear([]float64{0, 1, 2, 4}, []float64{0, 4, 1, 1})
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	// area 2 + 2.5 + 2 = 6.5 before normalising
+	cases := []struct {
+		x, pdf, cdf float64
+	}{
+		{-1, 0, 0},
+		{0, 0, 0},
+		{.5, 2 / 6.5, .5 / 6.5},
+		{1, 4 / 6.5, 2 / 6.5},
+		{1.5, 2.5 / 6.5, (2 + 1.625) / 6.5},
+		{3, 1 / 6.5, 5.5 / 6.5},
+		{4, 1 / 6.5, 1},
+		{5, 0, 1},
+	}
+
+	for _, c := range cases {
+		run_test(t, pl.Probability(c.x), c.pdf, 1e-15, "PiecewiseLinear Probability")
+		run_test(t, pl.Distribution(c.x), c.cdf, 1e-15, "PiecewiseLinear Distribution")
+		if c.cdf > 0 && c.cdf < 1 {
+			run_test(t, pl.Inverse(c.cdf), c.x, 1e-14, "PiecewiseLinear Inverse")
+			run_test(t, pl.InverseSurvival(1-c.cdf), c.x, 1e-14, "PiecewiseLinear InverseSurvival")
+		}
+	}
+
+	// no mass is reached at once where the density starts at 0, rather than at 0/0
+	if s := pl.within(0, 0); s != 0 {
+		t.Errorf("Mismatch. within(0, 0) want: 0, got: %v", s)
+	}
+
+	// the flat stretch at .25/6.5, 1e-12 below the top
+	run_test(t, pl.InverseSurvival(1e-12), 4-6.5e-12, 1e-15, "PiecewiseLinear InverseSurvival")
+
+	run_test(t, pl.Mode(), 1, 1e-15, "PiecewiseLinear Mode")
+
+	// against the moments of the density by Simpson's rule, exact for it on each segment up to r = 2
+	edges, f := pl.Edges(), pl.Densities()
+	var mu [3]float64
+	for i := 0; i+1 < len(edges); i++ {
+		a, b := edges[i], edges[i+1]
+		m, fm := (a+b)/2, (f[i]+f[i+1])/2
+		for r := range mu {
+			p := float64(r)
+			mu[r] += (b - a) / 6 * (math.Pow(a, p)*f[i] + 4*math.Pow(m, p)*fm + math.Pow(b, p)*f[i+1])
+		}
+	}
+
+	run_test(t, mu[0], 1, 1e-15, "PiecewiseLinear area")
+	run_test(t, pl.Mean(), mu[1], 1e-14, "PiecewiseLinear Mean")
+	run_test(t, pl.Variance(), mu[2]-mu[1]*mu[1], 1e-14, "PiecewiseLinear Variance")
+}
+
+func TestPiecewiseLinearConformance(t *testing.T) {
+	pl, _ := NewPiecewiseLinearWithSource([]float64{-2, -1, 0, .5, 3}, []float64{0, 3, 1, 4, .5}, rand.NewSource(1))
+	stattest.Conformance(t, pl, stattest.Config{Tol: 1e-7})
+}
+
+// The frequency polygon keeps the area and the mean of the histogram.
+func TestPiecewiseLinearFromData(t *testing.T) {
+	for _, rule := range []BinRule{SturgesBins, FreedmanDiaconisBins, KnuthBins} {
+		pc, _ := NewPiecewiseConstantFromData(empiricalData, rule)
+		pl, e := NewPiecewiseLinearFromData(empiricalData, rule)
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		run_test(t, pl.Mean(), pc.Mean(), 1e-14, "PiecewiseLinear from data Mean")
+		edges := pc.Edges()
+		w := edges[1] - edges[0]
+		for i, p := range pc.Weights() {
+			run_test(t, pl.Probability((edges[i]+edges[i+1])/2), p/w, 1e-14, "PiecewiseLinear from data Probability")
+		}
+	}
+}
+
+func TestPiecewiseLinearErrors(t *testing.T) {
+	cases := []struct {
+		edges, weights []float64
+	}{
+		{[]float64{1}, []float64{1}},
+		{[]float64{0, 2, 1}, []float64{1, 1, 1}},
+		{[]float64{0, 1}, []float64{1}},
+		{[]float64{0, 1}, []float64{0, 0}},
+		{[]float64{0, 1}, []float64{1, math.Inf(1)}},
+	}
+
+	for _, c := range cases {
+		if _, e := NewPiecewiseLinear(c.edges, c.weights); e == nil {
+			t.Errorf("Mismatch. PiecewiseLinear%v want: error, got: nil", c)
+		}
+	}
+}
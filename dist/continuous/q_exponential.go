@@ -2,7 +2,6 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -20,20 +19,48 @@ func NewQExponential(rate, q float64) (*QExponential, error) {
 }
 
 func NewQExponentialWithSource(rate, q float64, src rand.Source) (*QExponential, error) {
-	if rate <= 0 || q >= 2 {
-		return nil, err.Invalid()
-	}
-
 	r := new(QExponential)
 	r.rate = rate
 	r.q = q
 	r.src = src
 
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
 	return r, nil
 }
 
+func (q *QExponential) spec() (string, []Common, []float64) {
+	return "QExponential", nil, []float64{q.rate, q.q}
+}
+
 func (q *QExponential) String() string {
-	return "QExponential: Parameters - " + q.Parameters().String() + ", Support(x) - " + q.Support().String()
+	return specString(q.spec())
+}
+
+func (q *QExponential) MarshalJSON() ([]byte, error) {
+	return marshalJSON(q)
+}
+
+func (q *QExponential) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, q, q.src)
+}
+
+func (q *QExponential) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+func (q *QExponential) UnmarshalText(text []byte) error {
+	return unmarshalText(text, q, q.src)
+}
+
+func (q *QExponential) ParameterValues() map[string]float64 {
+	return parameterValues(q)
+}
+
+func (q *QExponential) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(q, params, q.src)
 }
 
 // λ  ∈ (0,∞)
@@ -64,6 +91,10 @@ func (q *QExponential) Probability(x float64) float64 {
 }
 
 func (q *QExponential) Distribution(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+
 	qp := 1 / (2 - q.q)
 	return 1 - smath.Expq(-(q.rate*x)/qp, qp)
 }
@@ -81,6 +112,23 @@ func (q *QExponential) Inverse(p float64) float64 {
 		return 1 / (q.rate * (1 - q.q))
 	}
 
+	qp := 1 / (2 - q.q)
+	return (-qp * smath.Logq(1-p, qp)) / q.rate
+}
+
+func (q *QExponential) InverseSurvival(p float64) float64 {
+	if p >= 1 {
+		return 0
+	}
+
+	if p <= 0 {
+		if q.q >= 1 {
+			return math.Inf(1)
+		}
+
+		return 1 / (q.rate * (1 - q.q))
+	}
+
 	qp := 1 / (2 - q.q)
 	return (-qp * smath.Logq(p, qp)) / q.rate
 }
@@ -1,10 +1,8 @@
 package continuous
 
 import (
-	integ "github.com/jtejido/ggsl/integration"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -22,21 +20,49 @@ func NewQGaussian(mean, scale, q float64) (*QGaussian, error) {
 }
 
 func NewQGaussianWithSource(mean, scale, q float64, src rand.Source) (*QGaussian, error) {
-	if scale <= 0 || q >= 3 {
-		return nil, err.Invalid()
-	}
-
 	r := new(QGaussian)
 	r.mean = mean
 	r.scale = scale
 	r.q = q
 	r.src = src
 
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
 	return r, nil
 }
 
+func (q *QGaussian) spec() (string, []Common, []float64) {
+	return "QGaussian", nil, []float64{q.mean, q.scale, q.q}
+}
+
 func (q *QGaussian) String() string {
-	return "QGaussian: Parameters - " + q.Parameters().String() + ", Support(x) - " + q.Support().String()
+	return specString(q.spec())
+}
+
+func (q *QGaussian) MarshalJSON() ([]byte, error) {
+	return marshalJSON(q)
+}
+
+func (q *QGaussian) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, q, q.src)
+}
+
+func (q *QGaussian) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+func (q *QGaussian) UnmarshalText(text []byte) error {
+	return unmarshalText(text, q, q.src)
+}
+
+func (q *QGaussian) ParameterValues() map[string]float64 {
+	return parameterValues(q)
+}
+
+func (q *QGaussian) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(q, params, q.src)
 }
 
 // μ  ∈ (-∞,∞)
@@ -51,14 +77,14 @@ func (q *QGaussian) Parameters() stats.Limits {
 }
 
 // x  ∈ (-∞,∞) for 1 <= q < 3
-// x  ∈ [-1/sqrt(b(1-q)),1/sqrt(b(1-q))] for q < 1
+// x  ∈ [μ-b√(2/(1-q)),μ+b√(2/(1-q))] for q < 1
 func (q *QGaussian) Support() stats.Interval {
 	if 1 <= q.q && q.q < 3 {
 		return stats.Interval{math.Inf(-1), math.Inf(1), true, true}
 	}
 
-	return stats.Interval{-(1 / math.Sqrt(q.scale*(1-q.q))), 1 / math.Sqrt(q.scale*(1-q.q)), false, false}
-
+	r := q.scale * math.Sqrt(2/(1-q.q))
+	return stats.Interval{q.mean - r, q.mean + r, false, false}
 }
 
 func (q *QGaussian) Probability(x float64) float64 {
@@ -81,22 +107,66 @@ func (q *QGaussian) Probability(x float64) float64 {
 	return 0
 }
 
-type integrand struct {
-	pdf func(float64) float64
+// For 1 < q < 3, (x-μ)/(b√(2/(3-q))) is Student-t with ν = (3-q)/(q-1) degrees of freedom.
+// For q < 1, (x-μ)/(b√(2/(1-q))) is 2Y-1 with Y ~ Beta(α,α), α = (2-q)/(1-q).
+func (q *QGaussian) Distribution(x float64) float64 {
+	if q.q == 1 {
+		n := &Normal{q.mean, q.scale, nil, nil}
+		return n.Distribution(x)
+	} else if 1 < q.q && q.q < 3 {
+		st := &StudentT{(3 - q.q) / (q.q - 1), nil}
+		return st.Distribution((x - q.mean) / (q.scale * math.Sqrt(2/(3-q.q))))
+	}
+
+	sup := q.Support()
+	if x <= sup.Lower {
+		return 0
+	}
+
+	if x >= sup.Upper {
+		return 1
+	}
+
+	α := (2 - q.q) / (1 - q.q)
+	b := &Beta{alpha: α, beta: α}
+	return b.Distribution((1 + (x-q.mean)/(q.scale*math.Sqrt(2/(1-q.q)))) / 2)
 }
 
-func (i *integrand) Evaluate(x float64) float64 {
-	return i.pdf(x)
+func (q *QGaussian) Inverse(p float64) float64 {
+	sup := q.Support()
+	if p <= 0 {
+		return sup.Lower
+	}
+
+	if p >= 1 {
+		return sup.Upper
+	}
+
+	if q.q == 1 {
+		n := &Normal{q.mean, q.scale, nil, nil}
+		return n.Inverse(p)
+	} else if 1 < q.q && q.q < 3 {
+		st := &StudentT{(3 - q.q) / (q.q - 1), nil}
+		return q.mean + q.scale*math.Sqrt(2/(3-q.q))*st.Inverse(p)
+	}
+
+	α := (2 - q.q) / (1 - q.q)
+	b := &Beta{alpha: α, beta: α}
+	return q.mean + q.scale*math.Sqrt(2/(1-q.q))*(2*b.Inverse(p)-1)
 }
 
-// We don't have closed-form for this. The limit value should be changeable
-func (q *QGaussian) Distribution(x float64) float64 {
-	f := &integrand{pdf: q.Probability}
-	workspace, _ := integ.NewWorkspace(30)
-	var cdf, abserr float64
-	integ.Qagil(f, x, abserr, 1e-12, 30, workspace, &cdf, &abserr)
+func (q *QGaussian) InverseSurvival(p float64) float64 {
+	sup := q.Support()
+	if p >= 1 {
+		return sup.Lower
+	}
+
+	if p <= 0 {
+		return sup.Upper
+	}
 
-	return cdf
+	// symmetric about μ
+	return 2*q.mean - q.Inverse(p)
 }
 
 func (q *QGaussian) Mean() float64 {
@@ -153,5 +223,6 @@ func (q *QGaussian) Rand() float64 {
 	u2 := rnd()
 
 	z := math.Sqrt(-2*smath.Logq(u1, qGen)) * math.Cos(2*math.Pi*u2)
-	return q.mean + (z / math.Sqrt(q.scale*(3-q.q)))
+	// z is the q-Gaussian of β = 1/(3-q), the density above has β = 1/(2b²)
+	return q.mean + z*q.scale*math.Sqrt(2/(3-q.q))
 }
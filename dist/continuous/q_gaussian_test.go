@@ -0,0 +1,50 @@
+package continuous
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+)
+
+// q = 2 is the Cauchy distribution with scale b√2.
+func TestQGaussianInverse(t *testing.T) {
+	tol := 0.000000001
+	cases := []struct {
+		p, μ, b, q float64
+		expected   float64
+	}{
+		{.75, 0, 1, 2, math.Sqrt2},
+		{.25, 1, 2, 2, 1 - 2*math.Sqrt2},
+		{.975, 0, 1, 1, 1.959963984540054},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			q, _ := NewQGaussian(c.μ, c.b, c.q)
+			res := q.Inverse(c.p)
+			run_test(t, res, c.expected, tol, "QGaussianInverse")
+		})
+	}
+}
+
+func TestQGaussianInverseDistribution(t *testing.T) {
+	tol := 0.000000001
+	cases := []struct {
+		μ, b, q float64
+	}{
+		{0, 1, -1},
+		{1, .5, .5},
+		{0, 1, 1},
+		{-2, 3, 1.5},
+		{0, 1, 2.5},
+	}
+
+	for _, c := range cases {
+		for _, p := range []float64{.001, .1, .5, .9, .999} {
+			q, _ := NewQGaussian(c.μ, c.b, c.q)
+			res := q.Distribution(q.Inverse(p))
+			run_test(t, res, p, tol, fmt.Sprintf("QGaussianInverseDistribution(%v, %v, %v, %v)", c.μ, c.b, c.q, p))
+		}
+	}
+}
@@ -3,7 +3,6 @@ package continuous
 import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -21,21 +20,49 @@ func NewQWeibull(rate, shape, q float64) (*QWeibull, error) {
 }
 
 func NewQWeibullWithSource(rate, shape, q float64, src rand.Source) (*QWeibull, error) {
-	if rate <= 0 || shape <= 0 || q >= 2 {
-		return nil, err.Invalid()
-	}
-
 	r := new(QWeibull)
 	r.rate = rate
 	r.shape = shape
 	r.q = q
 	r.src = src
 
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
 	return r, nil
 }
 
+func (q *QWeibull) spec() (string, []Common, []float64) {
+	return "QWeibull", nil, []float64{q.rate, q.shape, q.q}
+}
+
 func (q *QWeibull) String() string {
-	return "QWeibull: Parameters - " + q.Parameters().String() + ", Support(x) - " + q.Support().String()
+	return specString(q.spec())
+}
+
+func (q *QWeibull) MarshalJSON() ([]byte, error) {
+	return marshalJSON(q)
+}
+
+func (q *QWeibull) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, q, q.src)
+}
+
+func (q *QWeibull) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+func (q *QWeibull) UnmarshalText(text []byte) error {
+	return unmarshalText(text, q, q.src)
+}
+
+func (q *QWeibull) ParameterValues() map[string]float64 {
+	return parameterValues(q)
+}
+
+func (q *QWeibull) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(q, params, q.src)
 }
 
 // λ  ∈ (0,∞)
@@ -93,6 +120,22 @@ func (q *QWeibull) Inverse(p float64) float64 {
 	return math.Pow((1-math.Pow(1-p, (1-q.q)/(2-q.q)))/(1-q.q), 1/q.shape) * q.rate
 }
 
+func (q *QWeibull) InverseSurvival(p float64) float64 {
+	if p >= 1 {
+		return 0
+	}
+
+	if p <= 0 {
+		if q.q >= 1 {
+			return math.Inf(1)
+		}
+
+		return q.rate / math.Pow(1-q.q, 1/q.shape)
+	}
+
+	return math.Pow((1-math.Pow(p, (1-q.q)/(2-q.q)))/(1-q.q), 1/q.shape) * q.rate
+}
+
 func (q *QWeibull) Mean() float64 {
 	if q.q < 1 {
 		return q.rate * (2 + (1 / (1 - q.q)) + (1 / q.shape)) * math.Pow(1-q.q, -1/q.shape) * specfunc.Beta(1+(1/q.shape), 2+(1/(1-q.q)))
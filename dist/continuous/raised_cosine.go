@@ -3,7 +3,6 @@ package continuous
 import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"github.com/jtejido/trig"
 	"math"
 	"math/rand"
@@ -21,11 +20,44 @@ func NewRaisedCosine(location, scale float64) (*RaisedCosine, error) {
 }
 
 func NewRaisedCosineWithSource(location, scale float64, src rand.Source) (*RaisedCosine, error) {
-	if scale <= 0 {
-		return nil, err.Invalid()
+	r := &RaisedCosine{location, scale, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &RaisedCosine{location, scale, src}, nil
+	return r, nil
+}
+
+func (rs *RaisedCosine) spec() (string, []Common, []float64) {
+	return "RaisedCosine", nil, []float64{rs.location, rs.scale}
+}
+
+func (rs *RaisedCosine) String() string {
+	return specString(rs.spec())
+}
+
+func (rs *RaisedCosine) MarshalJSON() ([]byte, error) {
+	return marshalJSON(rs)
+}
+
+func (rs *RaisedCosine) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, rs, rs.src)
+}
+
+func (rs *RaisedCosine) MarshalText() ([]byte, error) {
+	return []byte(rs.String()), nil
+}
+
+func (rs *RaisedCosine) UnmarshalText(text []byte) error {
+	return unmarshalText(text, rs, rs.src)
+}
+
+func (rs *RaisedCosine) ParameterValues() map[string]float64 {
+	return parameterValues(rs)
+}
+
+func (rs *RaisedCosine) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(rs, params, rs.src)
 }
 
 // Distribution parameter bounds limits
@@ -55,6 +87,17 @@ func (rs *RaisedCosine) Probability(x float64) float64 {
 
 // Cumulative distribution function
 func (rs *RaisedCosine) Distribution(x float64) float64 {
+	if x >= rs.Support().Upper {
+		return 1
+	}
+
+	if x < rs.location && rs.Support().IsWithinInterval(x) {
+		// (πv - sin πv)/2π in the distance v from the lower end, which does not cancel in the tail, where
+		// Inverse and the InverseSurvival reflected off it are taken
+		v := (x - rs.location + rs.scale) / rs.scale
+		return minusSin(math.Pi*v) / (2 * math.Pi)
+	}
+
 	if rs.Support().IsWithinInterval(x) {
 		return .5 * (1 + ((x - rs.location) / rs.scale) + (1/math.Pi)*math.Sin(((x-rs.location)/rs.scale)*math.Pi))
 	}
@@ -62,6 +105,51 @@ func (rs *RaisedCosine) Distribution(x float64) float64 {
 	return 0
 }
 
+// y - sin y, by its series y³/3! - y⁵/5! + ... where the difference would cancel
+func minusSin(y float64) float64 {
+	if math.Abs(y) > 1 {
+		return y - math.Sin(y)
+	}
+
+	t := y * y * y / 6
+	s := t
+	for k := 4.; math.Abs(t) > gsl.Float64Eps*math.Abs(s); k += 2 {
+		t *= -y * y / (k * (k + 1))
+		s += t
+	}
+
+	return s
+}
+
+// Inverse cumulative distribution function
+func (rs *RaisedCosine) Inverse(p float64) float64 {
+	sup := rs.Support()
+	if p <= 0 {
+		return sup.Lower
+	}
+
+	if p >= 1 {
+		return sup.Upper
+	}
+
+	// start from the uniform on the same support
+	return inverse(nil, rs.Distribution, rs.Probability, sup.Lower, sup.Upper, sup.Lower+2*rs.scale*p, p)
+}
+
+func (rs *RaisedCosine) InverseSurvival(q float64) float64 {
+	sup := rs.Support()
+	if q >= 1 {
+		return sup.Lower
+	}
+
+	if q <= 0 {
+		return sup.Upper
+	}
+
+	// symmetric about μ
+	return 2*rs.location - rs.Inverse(q)
+}
+
 // ExKurtosis of the distribution.
 func (rs *RaisedCosine) ExKurtosis() float64 {
 	return (6 * (90 - math.Pow(math.Pi, 4.))) / (5 * math.Pow(math.Pow(math.Pi, 2.)-6, 2.))
@@ -105,6 +193,8 @@ func (rs *RaisedCosine) Rand() float64 {
 		rnd = rand.New(rs.src).Float64
 	}
 
+	// y is a variate of the standard raised cosine on [-π, π]
+	std := func(y float64) float64 { return rs.location + rs.scale*y/math.Pi }
 	x := math.Pi*rnd() - gsl.PiOver2
 	xSq := x * x
 	u := rnd()
@@ -120,7 +210,7 @@ func (rs *RaisedCosine) Rand() float64 {
 		v *= xSq / float64(a*b)
 		w += v
 		if u >= w {
-			return x*rs.location + rs.scale
+			return std(x)
 		}
 
 		a += 2
@@ -128,15 +218,11 @@ func (rs *RaisedCosine) Rand() float64 {
 		v *= xSq / float64(a*b)
 		w -= v
 		if u <= w {
-			if x == 0.0 {
-				return rs.location + rs.scale
-			}
-
-			if x > 0.0 {
-				return (math.Pi-x)*rs.location + rs.scale
+			if x < 0.0 {
+				return std(-math.Pi - x)
 			}
 
-			return (-math.Pi-x)*rs.location + rs.scale
+			return std(math.Pi - x)
 		}
 
 		iter++
@@ -0,0 +1,25 @@
+package continuous
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRaisedCosineInverseDistribution(t *testing.T) {
+	tol := 0.000000001
+	cases := []struct {
+		μ, s float64
+	}{
+		{0, 1},
+		{2, .5},
+		{-3, 4},
+	}
+
+	for _, c := range cases {
+		for _, p := range []float64{.001, .1, .5, .9, .999} {
+			rs, _ := NewRaisedCosine(c.μ, c.s)
+			res := rs.Distribution(rs.Inverse(p))
+			run_test(t, res, p, tol, fmt.Sprintf("RaisedCosineInverseDistribution(%v, %v, %v)", c.μ, c.s, p))
+		}
+	}
+}
@@ -3,7 +3,6 @@ package continuous
 import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -20,11 +19,44 @@ func NewRayleigh(scale float64) (*Rayleigh, error) {
 }
 
 func NewRayleighWithSource(scale float64, src rand.Source) (*Rayleigh, error) {
-	if scale <= 0 {
-		return nil, err.Invalid()
+	r := &Rayleigh{scale, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Rayleigh{scale, src}, nil
+	return r, nil
+}
+
+func (r *Rayleigh) spec() (string, []Common, []float64) {
+	return "Rayleigh", nil, []float64{r.scale}
+}
+
+func (r *Rayleigh) String() string {
+	return specString(r.spec())
+}
+
+func (r *Rayleigh) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *Rayleigh) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r, r.src)
+}
+
+func (r *Rayleigh) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Rayleigh) UnmarshalText(text []byte) error {
+	return unmarshalText(text, r, r.src)
+}
+
+func (r *Rayleigh) ParameterValues() map[string]float64 {
+	return parameterValues(r)
+}
+
+func (r *Rayleigh) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(r, params, r.src)
 }
 
 // σ ∈ (0,∞)
@@ -81,6 +113,18 @@ func (r *Rayleigh) Inverse(p float64) float64 {
 	return math.Sqrt(-2.0 * (r.scale * r.scale) * math.Log(1.0-p))
 }
 
+func (r *Rayleigh) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return math.Sqrt(-2.0 * (r.scale * r.scale) * math.Log(q))
+}
+
 func (r *Rayleigh) Mean() float64 {
 	return r.scale * math.Sqrt(math.Pi/2.)
 }
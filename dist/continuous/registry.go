@@ -0,0 +1,733 @@
+package continuous
+
+import (
+	"context"
+	"fmt"
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Param describes a numeric argument of a registered distribution.
+type Param struct {
+	Name     string  // ASCII name used in specs, e.g. "shape"
+	Symbol   string  // key of the same parameter in Parameters(), if it is declared there
+	Integer  bool    // only whole numbers are accepted
+	Optional bool    // the argument may be left out, in which case Default is used
+	Default  float64 // value of an omitted optional argument
+}
+
+// Entry describes how a distribution is built from a spec such as "Gamma(shape=2, rate=0.5)".
+// The spec takes Dists distribution arguments first, followed by Params.
+type Entry struct {
+	Name   string
+	Dists  int
+	Params []Param
+
+	// New builds the distribution from its distribution arguments and parameter values, given in
+	// the order of Params. The distribution is only meaningful when the error is nil.
+	New func(dists []Common, params []float64, src rand.Source) (Common, error)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Entry)
+)
+
+func init() {
+	for _, e := range builtinEntries() {
+		registry[e.Name] = e
+	}
+}
+
+// Register adds e to the registry, making it available to Parse under e.Name.
+// Names are unique, an existing entry cannot be replaced.
+func Register(e Entry) error {
+	if e.Name == "" || e.New == nil || e.Dists < 0 {
+		return err.New(err.EINVAL, "entry needs a name and a constructor")
+	}
+
+	for i, r := range e.Name {
+		if !isIdentRune(r) || i == 0 && unicode.IsDigit(r) {
+			return err.New(err.EINVAL, fmt.Sprintf("%q is not a valid distribution name", e.Name))
+		}
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[e.Name]; ok {
+		return err.New(err.EINVAL, fmt.Sprintf("%s is already registered", e.Name))
+	}
+
+	registry[e.Name] = e
+	return nil
+}
+
+// Lookup returns the registry entry for name.
+func Lookup(name string) (Entry, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	e, ok := registry[name]
+	return e, ok
+}
+
+// Names returns the names of all registered distributions, sorted.
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for n := range registry {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Parse builds a distribution from a spec of the form Name(args...), where distribution arguments
+// come first and are themselves specs, e.g. "Truncated(Normal(0, 1), -2, 2)". Parameters are given
+// by position, by name ("Gamma(shape=2, rate=0.5)") or by the symbol used in Parameters()
+// ("Gamma(k=2, rate=0.5)"), positional ones first. Each value is checked against the limits the
+// distribution declares for it. The String method of every distribution in this package returns a
+// spec that Parse turns back into an equal distribution, save for those built from a sample
+// (Empirical, KDE, PiecewiseConstant and PiecewiseLinear), which are not registered and whose String
+// only describes them.
+func Parse(spec string) (Common, error) {
+	return ParseWithSource(spec, nil)
+}
+
+// ParseWithSource is Parse with src handed to every distribution built, nested ones included.
+func ParseWithSource(spec string, src rand.Source) (Common, error) {
+	return parse(spec, src, nil)
+}
+
+// ParseContext is ParseWithSource under the err.Config carried by ctx, if any, which the distributions
+// built keep for the problems their numeric methods report, such as a series that fails to converge.
+// Errors in the spec are returned like those of Parse, and never raised.
+func ParseContext(ctx context.Context, spec string, src rand.Source) (Common, error) {
+	return parse(spec, src, err.FromContext(ctx))
+}
+
+func parse(spec string, src rand.Source, errs *err.Config) (Common, error) {
+	p := &specParser{spec: spec, src: src, errs: errs}
+	p.next()
+
+	d, e := p.parseSpec()
+	if e != nil {
+		return nil, e
+	}
+
+	if p.tok != tokEOF {
+		return nil, p.unexpected()
+	}
+
+	return d, nil
+}
+
+const (
+	tokEOF = iota
+	tokIdent
+	tokNumber
+	tokLParen
+	tokRParen
+	tokComma
+	tokEquals
+	tokInvalid
+)
+
+type specParser struct {
+	spec     string
+	src      rand.Source
+	errs     *err.Config
+	pos, off int // position after the current token, and offset of the current token
+	tok      int
+	lit      string
+}
+
+// advances to the next token
+func (p *specParser) next() {
+	for p.pos < len(p.spec) && strings.IndexByte(" \t\r\n", p.spec[p.pos]) >= 0 {
+		p.pos++
+	}
+
+	p.off = p.pos
+	if p.pos == len(p.spec) {
+		p.tok, p.lit = tokEOF, ""
+		return
+	}
+
+	c := p.spec[p.pos]
+	switch {
+	case c == '(':
+		p.tok = tokLParen
+		p.pos++
+	case c == ')':
+		p.tok = tokRParen
+		p.pos++
+	case c == ',':
+		p.tok = tokComma
+		p.pos++
+	case c == '=':
+		p.tok = tokEquals
+		p.pos++
+	case c >= utf8.RuneSelf || isIdentRune(rune(c)) && !(c >= '0' && c <= '9'):
+		p.tok = tokIdent
+		for p.pos < len(p.spec) {
+			r, size := utf8.DecodeRuneInString(p.spec[p.pos:])
+			if !isIdentRune(r) {
+				break
+			}
+			p.pos += size
+		}
+
+		if p.pos == p.off {
+			p.tok = tokInvalid
+			_, size := utf8.DecodeRuneInString(p.spec[p.pos:])
+			p.pos += size
+		}
+	case c >= '0' && c <= '9' || c == '.' || c == '+' || c == '-':
+		// sign, digits, letters (exponent, Inf, NaN) and signs following an exponent
+		p.tok = tokNumber
+		p.pos++
+		for p.pos < len(p.spec) {
+			c := p.spec[p.pos]
+			prev := p.spec[p.pos-1]
+			if !(c < utf8.RuneSelf && isIdentRune(rune(c)) || c == '.' || (c == '+' || c == '-') && (prev == 'e' || prev == 'E')) {
+				break
+			}
+			p.pos++
+		}
+	default:
+		p.tok = tokInvalid
+		p.pos++
+	}
+
+	p.lit = p.spec[p.off:p.pos]
+}
+
+func (p *specParser) unexpected() error {
+	if p.tok == tokEOF {
+		return err.New(err.EINVAL, fmt.Sprintf("unexpected end of spec %q", p.spec))
+	}
+
+	return err.New(err.EINVAL, fmt.Sprintf("unexpected %q at offset %d of spec %q", p.lit, p.off, p.spec))
+}
+
+func (p *specParser) expect(tok int) error {
+	if p.tok != tok {
+		return p.unexpected()
+	}
+
+	p.next()
+	return nil
+}
+
+// spec := name ["(" [arg {"," arg}] ")"]
+// arg  := spec | [key "="] number
+func (p *specParser) parseSpec() (Common, error) {
+	if p.tok != tokIdent {
+		return nil, p.unexpected()
+	}
+
+	name := p.lit
+	entry, ok := Lookup(name)
+	if !ok {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("unknown distribution %q", name))
+	}
+	p.next()
+
+	var (
+		dists  []Common
+		values = make([]float64, len(entry.Params))
+		set    = make([]bool, len(entry.Params))
+		named  bool
+		n      int // number of arguments so far
+	)
+
+	if p.tok == tokLParen {
+		p.next()
+		for p.tok != tokRParen {
+			if n > 0 {
+				if e := p.expect(tokComma); e != nil {
+					return nil, e
+				}
+			}
+
+			if n < entry.Dists {
+				d, e := p.parseSpec()
+				if e != nil {
+					return nil, e
+				}
+
+				dists = append(dists, d)
+				n++
+				continue
+			}
+
+			i := n - entry.Dists
+			if p.tok == tokIdent && !isNumberLiteral(p.lit) {
+				key := p.lit
+				p.next()
+				if e := p.expect(tokEquals); e != nil {
+					return nil, e
+				}
+
+				if i = entry.param(key); i < 0 {
+					return nil, err.New(err.EINVAL, fmt.Sprintf("%s has no parameter %q", name, key))
+				}
+
+				named = true
+			} else if named {
+				return nil, err.New(err.EINVAL, fmt.Sprintf("positional argument after named ones in %s", name))
+			} else if i >= len(entry.Params) {
+				return nil, err.New(err.EINVAL, fmt.Sprintf("too many arguments to %s", name))
+			}
+
+			if set[i] {
+				return nil, err.New(err.EINVAL, fmt.Sprintf("%s.%s given more than once", name, entry.Params[i].Name))
+			}
+
+			v, e := p.parseNumber()
+			if e != nil {
+				return nil, e
+			}
+
+			values[i], set[i] = v, true
+			n++
+		}
+		p.next()
+	}
+
+	if len(dists) < entry.Dists {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("%s needs %d distribution arguments", name, entry.Dists))
+	}
+
+	return entry.build(dists, values, set, p.src, p.errs)
+}
+
+func (p *specParser) parseNumber() (float64, error) {
+	if p.tok != tokNumber && p.tok != tokIdent {
+		return 0, p.unexpected()
+	}
+
+	v, e := strconv.ParseFloat(p.lit, 64)
+	if e != nil {
+		return 0, err.New(err.EINVAL, fmt.Sprintf("%q at offset %d of spec %q is not a number", p.lit, p.off, p.spec))
+	}
+
+	p.next()
+	return v, nil
+}
+
+// index of the parameter called key, by name or by symbol
+func (e Entry) param(key string) int {
+	for i, par := range e.Params {
+		if par.Name == key || par.Symbol != "" && par.Symbol == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Builds the distribution from parameters keyed by name or symbol.
+func (e Entry) fromMap(dists []Common, params map[string]float64, src rand.Source, errs *err.Config) (Common, error) {
+	values := make([]float64, len(e.Params))
+	set := make([]bool, len(e.Params))
+	for key, v := range params {
+		i := e.param(key)
+		if i < 0 {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s has no parameter %q", e.Name, key))
+		}
+
+		if set[i] && values[i] != v {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s.%s given twice with different values", e.Name, e.Params[i].Name))
+		}
+
+		values[i], set[i] = v, true
+	}
+
+	return e.build(dists, values, set, src, errs)
+}
+
+// Builds the distribution from the values marked as set, filling in defaults, and checks them against
+// the limits it declares.
+func (e Entry) build(dists []Common, values []float64, set []bool, src rand.Source, errs *err.Config) (Common, error) {
+	for i, par := range e.Params {
+		if !set[i] {
+			if !par.Optional {
+				return nil, err.New(err.EINVAL, fmt.Sprintf("%s.%s is missing", e.Name, par.Name))
+			}
+			values[i] = par.Default
+		}
+
+		if math.IsNaN(values[i]) {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s.%s is NaN", e.Name, par.Name))
+		}
+
+		if par.Integer && (values[i] != math.Trunc(values[i]) || math.Abs(values[i]) > math.MaxInt32) {
+			return nil, err.New(err.EINVAL, fmt.Sprintf("%s.%s must be a whole number", e.Name, par.Name))
+		}
+	}
+
+	d, ee := e.New(dists, values, src)
+	if ee != nil {
+		return nil, ee
+	}
+
+	if c, ok := d.(configured); ok && errs != nil {
+		c.setConfig(errs)
+	}
+
+	if l, ok := d.(interface{ Parameters() stats.Limits }); ok {
+		limits := l.Parameters()
+		for i, par := range e.Params {
+			if lim, ok := limits[par.Symbol]; ok && !lim.IsWithinInterval(values[i]) {
+				return nil, err.New(err.EINVAL, fmt.Sprintf("%s.%s = %v is outside %s", e.Name, par.Name, values[i], lim))
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// Letters, digits and underscores, along with subscripts and other numeric runes found in symbols such as x₀.
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// Identifiers that strconv reads as numbers, e.g. Inf and NaN.
+func isNumberLiteral(s string) bool {
+	_, e := strconv.ParseFloat(s, 64)
+	return e == nil
+}
+
+// Spec of a distribution as read by Parse: distribution arguments first, then the parameters
+// under their registered names, leaving out optional ones at their default.
+func specString(name string, dists []Common, params []float64) string {
+	entry, _ := Lookup(name)
+
+	args := make([]string, 0, len(dists)+len(params))
+	for _, d := range dists {
+		args = append(args, fmt.Sprint(d))
+	}
+
+	for i, v := range params {
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if i < len(entry.Params) {
+			par := entry.Params[i]
+			if par.Optional && v == par.Default {
+				continue
+			}
+			s = par.Name + "=" + s
+		}
+		args = append(args, s)
+	}
+
+	return name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func circularSupport(lower float64) stats.Interval {
+	return stats.Interval{lower, lower + defaultLength, false, false}
+}
+
+func builtinEntries() []Entry {
+	lower := Param{Name: "lower", Optional: true, Default: DefaultCircularSupport.Lower}
+
+	return []Entry{
+		{Name: "AlphaStable", Params: []Param{{Name: "alpha", Symbol: "α"}, {Name: "beta", Symbol: "β"}, {Name: "scale", Symbol: "γ"}, {Name: "location", Symbol: "δ"}, {Name: "parameterization", Symbol: "S", Integer: true, Optional: true}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewAlphaStableWithSource(p[0], p[1], p[2], p[3], StableParameterization(p[4]), src)
+			}},
+		{Name: "Arcsine",
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewArcsineWithSource(src)
+			}},
+		{Name: "ArcsineBounded", Params: []Param{{Name: "min", Symbol: "A"}, {Name: "max", Symbol: "B"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewArcsineBoundedWithSource(p[0], p[1], src)
+			}},
+		{Name: "AssymetricLaplace", Params: []Param{{Name: "location", Symbol: "m"}, {Name: "scale", Symbol: "λ"}, {Name: "asymmetry", Symbol: "κ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewAssymetricLaplaceWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "Bates", Params: []Param{{Name: "a", Symbol: "a"}, {Name: "b", Symbol: "b"}, {Name: "n", Symbol: "n", Integer: true}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewBatesWithSource(p[0], p[1], uint(math.Max(p[2], 0)), src)
+			}},
+		{Name: "Benini", Params: []Param{{Name: "alpha", Symbol: "α"}, {Name: "beta", Symbol: "β"}, {Name: "sigma", Symbol: "σ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewBeniniWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "BenktanderType1", Params: []Param{{Name: "a", Symbol: "a"}, {Name: "b", Symbol: "b"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewBenktanderType1WithSource(p[0], p[1], src)
+			}},
+		{Name: "BenktanderType2", Params: []Param{{Name: "a", Symbol: "a"}, {Name: "b", Symbol: "b"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewBenktanderType2WithSource(p[0], p[1], src)
+			}},
+		{Name: "Beta", Params: []Param{{Name: "alpha", Symbol: "α"}, {Name: "beta", Symbol: "β"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewBetaWithSource(p[0], p[1], src)
+			}},
+		{Name: "BetaPrime", Params: []Param{{Name: "alpha", Symbol: "α"}, {Name: "beta", Symbol: "β"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewBetaPrimeWithSource(p[0], p[1], src)
+			}},
+		{Name: "BirnbaumSaunders", Params: []Param{{Name: "shape", Symbol: "α"}, {Name: "scale", Symbol: "γ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewBirnbaumSaundersWithSource(p[0], p[1], src)
+			}},
+		{Name: "Burr", Params: []Param{{Name: "c", Symbol: "c"}, {Name: "k", Symbol: "k"}, {Name: "scale", Symbol: "λ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewBurrWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "Cauchy", Params: []Param{{Name: "location", Symbol: "x₀"}, {Name: "scale", Symbol: "γ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewCauchyWithSource(p[0], p[1], src)
+			}},
+		{Name: "Chi", Params: []Param{{Name: "dof", Symbol: "k", Integer: true}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewChiWithSource(int(p[0]), src)
+			}},
+		{Name: "ChiSquared", Params: []Param{{Name: "dof", Symbol: "k", Integer: true}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewChiSquaredWithSource(int(p[0]), src)
+			}},
+		{Name: "Dagum", Params: []Param{{Name: "p", Symbol: "p"}, {Name: "a", Symbol: "a"}, {Name: "scale", Symbol: "b"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewDagumWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "Erlang", Params: []Param{{Name: "shape", Symbol: "k", Integer: true}, {Name: "rate", Symbol: "λ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewErlangWithSource(int(p[0]), p[1], src)
+			}},
+		{Name: "Exponential", Params: []Param{{Name: "rate", Symbol: "λ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewExponentialWithSource(p[0], src)
+			}},
+		{Name: "F", Params: []Param{{Name: "d1", Symbol: "d₁", Integer: true}, {Name: "d2", Symbol: "d₂", Integer: true}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewFWithSource(int(p[0]), int(p[1]), src)
+			}},
+		{Name: "Frechet", Params: []Param{{Name: "shape", Symbol: "α"}, {Name: "scale", Symbol: "s"}, {Name: "location", Symbol: "m"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewFrechetWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "Gamma", Params: []Param{{Name: "shape", Symbol: "k"}, {Name: "rate", Symbol: "β"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewGammaWithSource(p[0], p[1], src)
+			}},
+		{Name: "GB1", Params: []Param{{Name: "alpha", Symbol: "α"}, {Name: "beta", Symbol: "β"}, {Name: "p", Symbol: "p"}, {Name: "q", Symbol: "q"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewGB1WithSource(p[0], p[1], p[2], p[3], src)
+			}},
+		{Name: "GB2", Params: []Param{{Name: "alpha", Symbol: "α"}, {Name: "beta", Symbol: "β"}, {Name: "p", Symbol: "p"}, {Name: "q", Symbol: "q"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewGB2WithSource(p[0], p[1], p[2], p[3], src)
+			}},
+		{Name: "GEV", Params: []Param{{Name: "location", Symbol: "μ"}, {Name: "scale", Symbol: "σ"}, {Name: "shape", Symbol: "ξ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewGEVWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "Gompertz", Params: []Param{{Name: "shape", Symbol: "η"}, {Name: "scale", Symbol: "b"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewGompertzWithSource(p[0], p[1], src)
+			}},
+		{Name: "GPD", Params: []Param{{Name: "location", Symbol: "μ"}, {Name: "scale", Symbol: "σ"}, {Name: "shape", Symbol: "ξ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewGPDWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "Gumbel", Params: []Param{{Name: "location", Symbol: "μ"}, {Name: "scale", Symbol: "β"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewGumbelWithSource(p[0], p[1], src)
+			}},
+		{Name: "HyperbolicSecant",
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewHyperbolicSecantWithSource(src)
+			}},
+		{Name: "InverseChiSquared", Params: []Param{{Name: "dof", Symbol: "v"}, {Name: "scale", Symbol: "σ2"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewInverseChiSquaredWithSource(p[0], p[1], src)
+			}},
+		{Name: "InverseGamma", Params: []Param{{Name: "shape", Symbol: "α"}, {Name: "scale", Symbol: "β"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewInverseGammaWithSource(p[0], p[1], src)
+			}},
+		{Name: "InverseGaussian", Params: []Param{{Name: "mean", Symbol: "μ"}, {Name: "shape", Symbol: "λ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewInverseGaussianWithSource(p[0], p[1], src)
+			}},
+		{Name: "IrwinHall", Params: []Param{{Name: "n", Symbol: "n", Integer: true}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewIrwinHallWithSource(uint(math.Max(p[0], 0)), src)
+			}},
+		{Name: "JohnsonSL", Params: johnsonParams(),
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewJohnsonSLWithSource(p[0], p[1], p[2], p[3], src)
+			}},
+		{Name: "JohnsonSN", Params: johnsonParams(),
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewJohnsonSNWithSource(p[0], p[1], p[2], p[3], src)
+			}},
+		{Name: "JohnsonSU", Params: johnsonParams(),
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewJohnsonSUWithSource(p[0], p[1], p[2], p[3], src)
+			}},
+		{Name: "Kumaraswamy", Params: []Param{{Name: "a", Symbol: "a"}, {Name: "b", Symbol: "b"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewKumaraswamyWithSource(p[0], p[1], src)
+			}},
+		{Name: "Laplace", Params: []Param{{Name: "location", Symbol: "μ"}, {Name: "scale", Symbol: "b"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewLaplaceWithSource(p[0], p[1], src)
+			}},
+		{Name: "Levy", Params: []Param{{Name: "location", Symbol: "μ"}, {Name: "scale", Symbol: "c"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewLevyWithSource(p[0], p[1], src)
+			}},
+		{Name: "LogLogistic", Params: []Param{{Name: "scale", Symbol: "α"}, {Name: "shape", Symbol: "β"}, {Name: "location", Symbol: "γ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewLogLogisticWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "LogNormal", Params: []Param{{Name: "location", Symbol: "μ"}, {Name: "scale", Symbol: "σ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewLogNormalWithSource(p[0], p[1], src)
+			}},
+		{Name: "Logistic", Params: []Param{{Name: "location", Symbol: "μ"}, {Name: "scale", Symbol: "s"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewLogisticWithSource(p[0], p[1], src)
+			}},
+		{Name: "MaxwellBoltzmann", Params: []Param{{Name: "scale", Symbol: "σ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewMaxwellBoltzmannWithSource(p[0], src)
+			}},
+		{Name: "ModifiedPERT", Params: []Param{{Name: "min", Symbol: "a"}, {Name: "max", Symbol: "c"}, {Name: "mode", Symbol: "b"}, {Name: "shape", Symbol: "λ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewModifiedPERTWithSource(p[0], p[1], p[2], p[3], src)
+			}},
+		{Name: "Nakagami", Params: []Param{{Name: "shape", Symbol: "m"}, {Name: "spread", Symbol: "Ω"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewNakagamiWithSource(p[0], p[1], src)
+			}},
+		{Name: "NonCentralBeta", Params: []Param{{Name: "alpha", Symbol: "α"}, {Name: "beta", Symbol: "β"}, {Name: "lambda", Symbol: "λ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewNonCentralBetaWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "NonCentralChi", Params: []Param{{Name: "dof", Symbol: "k", Integer: true}, {Name: "lambda", Symbol: "λ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewNonCentralChiWithSource(int(p[0]), p[1], src)
+			}},
+		{Name: "NonCentralChiSquared", Params: []Param{{Name: "dof", Symbol: "k", Integer: true}, {Name: "lambda", Symbol: "λ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewNonCentralChiSquaredWithSource(int(p[0]), p[1], src)
+			}},
+		{Name: "NonCentralGamma", Params: []Param{{Name: "shape", Symbol: "k"}, {Name: "scale", Symbol: "θ"}, {Name: "lambda", Symbol: "λ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewNonCentralGammaWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "NonCentralT", Params: []Param{{Name: "dof", Symbol: "ν"}, {Name: "lambda", Symbol: "μ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewNonCentralTWithSource(p[0], p[1], src)
+			}},
+		{Name: "Normal", Params: []Param{{Name: "location", Symbol: "μ"}, {Name: "scale", Symbol: "σ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewNormalWithSource(p[0], p[1], src)
+			}},
+		{Name: "Pareto", Params: []Param{{Name: "shape", Symbol: "α"}, {Name: "xmin", Symbol: "xm"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewParetoWithSource(p[0], p[1], src)
+			}},
+		{Name: "ParetoBounded", Params: []Param{{Name: "min", Symbol: "L"}, {Name: "max", Symbol: "H"}, {Name: "shape", Symbol: "α"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewParetoBoundedWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "ParetoType2", Params: []Param{{Name: "xmin", Symbol: "xm"}, {Name: "shape", Symbol: "α"}, {Name: "location", Symbol: "μ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewParetoType2WithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "PERT", Params: []Param{{Name: "min", Symbol: "a"}, {Name: "max", Symbol: "c"}, {Name: "mode", Symbol: "b"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewPERTWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "QExponential", Params: []Param{{Name: "rate", Symbol: "λ"}, {Name: "q", Symbol: "q"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewQExponentialWithSource(p[0], p[1], src)
+			}},
+		{Name: "QGaussian", Params: []Param{{Name: "mean", Symbol: "μ"}, {Name: "scale", Symbol: "b"}, {Name: "q", Symbol: "q"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewQGaussianWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "QWeibull", Params: []Param{{Name: "rate", Symbol: "λ"}, {Name: "shape", Symbol: "κ"}, {Name: "q", Symbol: "q"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewQWeibullWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "RaisedCosine", Params: []Param{{Name: "location", Symbol: "μ"}, {Name: "scale", Symbol: "s"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewRaisedCosineWithSource(p[0], p[1], src)
+			}},
+		{Name: "Rayleigh", Params: []Param{{Name: "scale", Symbol: "σ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewRayleighWithSource(p[0], src)
+			}},
+		{Name: "ReverseWeibull", Params: []Param{{Name: "shape", Symbol: "α"}, {Name: "scale", Symbol: "s"}, {Name: "location", Symbol: "m"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewReverseWeibullWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "Rice", Params: []Param{{Name: "distance", Symbol: "v"}, {Name: "spread", Symbol: "σ"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewRiceWithSource(p[0], p[1], src)
+			}},
+		{Name: "ShiftedGompertz", Params: []Param{{Name: "scale", Symbol: "b"}, {Name: "shape", Symbol: "η"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewShiftedGompertzWithSource(p[0], p[1], src)
+			}},
+		{Name: "StudentT", Params: []Param{{Name: "dof", Symbol: "ν"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewStudentTWithSource(p[0], src)
+			}},
+		{Name: "Triangular", Params: []Param{{Name: "min", Symbol: "min"}, {Name: "max", Symbol: "max"}, {Name: "mode", Symbol: "mode"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewTriangularWithSource(p[0], p[1], p[2], src)
+			}},
+		{Name: "Truncated", Dists: 1, Params: []Param{{Name: "min", Symbol: "min"}, {Name: "max", Symbol: "max"}},
+			New: func(d []Common, p []float64, src rand.Source) (Common, error) {
+				return NewTruncatedWithSource(d[0], p[0], p[1], src)
+			}},
+		{Name: "Uniform", Params: []Param{{Name: "min", Symbol: "min"}, {Name: "max", Symbol: "max"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewUniformWithSource(p[0], p[1], src)
+			}},
+		{Name: "VonMises", Params: []Param{{Name: "mean", Symbol: "μ"}, {Name: "concentration", Symbol: "κ"}, lower},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewVonMisesWithSource(p[0], p[1], circularSupport(p[2]), src)
+			}},
+		{Name: "Weibull", Params: []Param{{Name: "scale", Symbol: "λ"}, {Name: "shape", Symbol: "k"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewWeibullWithSource(p[0], p[1], src)
+			}},
+		{Name: "WignerSemiCircle", Params: []Param{{Name: "radius", Symbol: "R"}, {Name: "center", Symbol: "a"}},
+			New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+				return NewWignerSemiCircleWithSource(p[0], p[1], src)
+			}},
+		{Name: "Wrapped", Dists: 1, Params: []Param{{Name: "k", Symbol: "k", Integer: true, Optional: true, Default: 1000}, lower},
+			New: func(d []Common, p []float64, _ rand.Source) (Common, error) {
+				return NewWrapped(d[0], int(p[0]), circularSupport(p[1]))
+			}},
+	}
+}
+
+func johnsonParams() []Param {
+	return []Param{{Name: "gamma", Symbol: "γ"}, {Name: "delta", Symbol: "δ"}, {Name: "location", Symbol: "μ"}, {Name: "scale", Symbol: "σ"}}
+}
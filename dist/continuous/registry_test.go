@@ -0,0 +1,299 @@
+package continuous
+
+import (
+	"context"
+	"fmt"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var registrySpecs = map[string]string{
+	"AlphaStable":          "AlphaStable(1.5, 0.5, 2, 1)",
+	"Arcsine":              "Arcsine",
+	"ArcsineBounded":       "ArcsineBounded(-1, 3)",
+	"AssymetricLaplace":    "AssymetricLaplace(1, 2, 0.5)",
+	"Bates":                "Bates(-1, 2, 4)",
+	"Benini":               "Benini(2, 3, 1.5)",
+	"BenktanderType1":      "BenktanderType1(2, 0.5)",
+	"BenktanderType2":      "BenktanderType2(2, 0.5)",
+	"Beta":                 "Beta(2, 3)",
+	"BetaPrime":            "BetaPrime(2, 3)",
+	"BirnbaumSaunders":     "BirnbaumSaunders(0.5, 2)",
+	"Burr":                 "Burr(2, 3, 1.5)",
+	"Cauchy":               "Cauchy(1, 2)",
+	"Chi":                  "Chi(3)",
+	"ChiSquared":           "ChiSquared(4)",
+	"Dagum":                "Dagum(2, 3, 1.5)",
+	"Erlang":               "Erlang(3, 0.5)",
+	"Exponential":          "Exponential(rate=0.25)",
+	"F":                    "F(5, 7)",
+	"Frechet":              "Frechet(3, 2, 1)",
+	"Gamma":                "Gamma(shape=2, rate=0.5)",
+	"GB1":                  "GB1(2, 3, 1.5, 2.5)",
+	"GB2":                  "GB2(2, 3, 1.5, 2.5)",
+	"GEV":                  "GEV(1, 2, 0.2)",
+	"Gompertz":             "Gompertz(0.5, 2)",
+	"GPD":                  "GPD(1, 2, -0.2)",
+	"Gumbel":               "Gumbel(1, 2)",
+	"HyperbolicSecant":     "HyperbolicSecant()",
+	"InverseChiSquared":    "InverseChiSquared(5, 0.5)",
+	"InverseGamma":         "InverseGamma(3, 2)",
+	"InverseGaussian":      "InverseGaussian(1, 3)",
+	"IrwinHall":            "IrwinHall(5)",
+	"JohnsonSL":            "JohnsonSL(0.5, 2, 1, 3)",
+	"JohnsonSN":            "JohnsonSN(0.5, 2, 1, 3)",
+	"JohnsonSU":            "JohnsonSU(0.5, 2, 1, 3)",
+	"Kumaraswamy":          "Kumaraswamy(2, 5)",
+	"Laplace":              "Laplace(1, 2)",
+	"Levy":                 "Levy(1, 2)",
+	"LogLogistic":          "LogLogistic(2, 3, 1)",
+	"LogNormal":            "LogNormal(0.5, 0.25)",
+	"Logistic":             "Logistic(1, 2)",
+	"MaxwellBoltzmann":     "MaxwellBoltzmann(2)",
+	"ModifiedPERT":         "ModifiedPERT(1, 5, 2, 4)",
+	"Nakagami":             "Nakagami(2, 3)",
+	"NonCentralBeta":       "NonCentralBeta(2, 3, 1.5)",
+	"NonCentralChi":        "NonCentralChi(3, 1.5)",
+	"NonCentralChiSquared": "NonCentralChiSquared(3, 1.5)",
+	"NonCentralGamma":      "NonCentralGamma(2, 3, 1.5)",
+	"NonCentralT":          "NonCentralT(5, 1.5)",
+	"Normal":               "Normal(1, 2)",
+	"Pareto":               "Pareto(3, 2)",
+	"ParetoBounded":        "ParetoBounded(1, 10, 2)",
+	"ParetoType2":          "ParetoType2(2, 3, 1)",
+	"PERT":                 "PERT(1, 5, 2)",
+	"QExponential":         "QExponential(2, 1.5)",
+	"QGaussian":            "QGaussian(1, 2, 1.5)",
+	"QWeibull":             "QWeibull(2, 3, 1.5)",
+	"RaisedCosine":         "RaisedCosine(1, 2)",
+	"Rayleigh":             "Rayleigh(2)",
+	"ReverseWeibull":       "ReverseWeibull(3, 2, 1)",
+	"Rice":                 "Rice(1, 2)",
+	"ShiftedGompertz":      "ShiftedGompertz(0.5, 2)",
+	"StudentT":             "StudentT(5)",
+	"Triangular":           "Triangular(1, 5, 2)",
+	"Truncated":            "Truncated(Normal(0, 1), -2, 2)",
+	"Uniform":              "Uniform(-1, 3)",
+	"VonMises":             "VonMises(0.5, 2)",
+	"Weibull":              "Weibull(2, 3)",
+	"WignerSemiCircle":     "WignerSemiCircle(2, 1)",
+	"Wrapped":              "Wrapped(Cauchy(0.5, 1), 100)",
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	for _, name := range Names() {
+		spec, ok := registrySpecs[name]
+		if !ok {
+			t.Errorf("no spec for %s", name)
+			continue
+		}
+
+		t.Run(name, func(t *testing.T) {
+			d, e := Parse(spec)
+			if e != nil {
+				t.Fatalf("Parse(%q): %v", spec, e)
+			}
+
+			s := fmt.Sprint(d)
+			d2, e := Parse(s)
+			if e != nil {
+				t.Fatalf("Parse(%q): %v", s, e)
+			}
+
+			if s2 := fmt.Sprint(d2); s2 != s {
+				t.Errorf("String() mismatch, want: %s, got: %s", s, s2)
+			}
+
+			for _, p := range []float64{.1, .5, .9} {
+				x := d.Inverse(p)
+				if x2 := d2.Inverse(p); x2 != x && !(math.IsNaN(x) && math.IsNaN(x2)) {
+					t.Errorf("Inverse(%v) mismatch, want: %v, got: %v", p, x, x2)
+				}
+
+				run_test(t, d2.Distribution(x), d.Distribution(x), 0, fmt.Sprintf("%s.Distribution(%v)", s, x))
+			}
+		})
+	}
+}
+
+func TestParseSpecs(t *testing.T) {
+	cases := []struct {
+		spec, expected string
+	}{
+		{"Gamma(shape=2, rate=0.5)", "Gamma(shape=2, rate=0.5)"},
+		{"Gamma(2, rate=0.5)", "Gamma(shape=2, rate=0.5)"},
+		{"Gamma(β=0.5, k=2)", "Gamma(shape=2, rate=0.5)"},
+		{"Cauchy(x₀=1, γ=2)", "Cauchy(location=1, scale=2)"},
+		{" Normal ( 1e-3 ,2E+1 ) ", "Normal(location=0.001, scale=20)"},
+		{"Truncated(Normal(0,1), -2, 2)", "Truncated(Normal(location=0, scale=1), min=-2, max=2)"},
+		{"Truncated(Normal(0,1), -Inf, 2)", "Truncated(Normal(location=0, scale=1), min=-Inf, max=2)"},
+		{"Truncated(Truncated(Laplace(0, 1), -3, 3), max=1, min=-1)", "Truncated(Truncated(Laplace(location=0, scale=1), min=-3, max=3), min=-1, max=1)"},
+		{"Wrapped(Normal(0, 1))", "Wrapped(Normal(location=0, scale=1))"},
+		{"Wrapped(Normal(0, 1), 10, 0)", "Wrapped(Normal(location=0, scale=1), k=10, lower=0)"},
+		{"VonMises(0, 1, lower=-3.141592653589793)", "VonMises(mean=0, concentration=1)"},
+		{"Chi(dof=3)", "Chi(dof=3)"},
+		{"Arcsine", "Arcsine()"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.spec, func(t *testing.T) {
+			d, e := Parse(c.spec)
+			if e != nil {
+				t.Fatalf("Parse(%q): %v", c.spec, e)
+			}
+
+			if s := fmt.Sprint(d); s != c.expected {
+				t.Errorf("Mismatch. want: %s, got: %s", c.expected, s)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	// returned without raising them, so that none of these panics under the default handler
+	cases := []string{
+		"",
+		"Gamma",
+		"Gamma(2)",
+		"Gamma(2, 0.5, 1)",
+		"Gamma(2, 0.5",
+		"Gamma(2 0.5)",
+		"Gamma(2, 0.5))",
+		"Gamma(shape=2, 0.5)",
+		"Gamma(shape=2, shape=3)",
+		"Gamma(2, scale=0.5)",
+		"Gamma(-2, 0.5)",
+		"Gamma(2, x)",
+		"Gamma(NaN, 0.5)",
+		"Gamma(2, 0.5#)",
+		"Gama(2, 0.5)",
+		"Chi(2.5)",
+		"Chi(-1)",
+		"IrwinHall(-3)",
+		"Truncated(0, 1, 2)",
+		"Truncated(Normal(0, 1))",
+		"Truncated(Normal(0, 1), Normal(0, 1), 2)",
+		"Normal(0, 1)(",
+	}
+
+	for _, spec := range cases {
+		t.Run(spec, func(t *testing.T) {
+			d, e := Parse(spec)
+			if e == nil {
+				t.Fatalf("Parse(%q) want error, got: %v", spec, d)
+			}
+
+			if e.(err.StatsError).Status() != err.EINVAL {
+				t.Errorf("Parse(%q) want EINVAL, got: %v", spec, e)
+			}
+		})
+	}
+}
+
+func TestParseContext(t *testing.T) {
+	// errors in the spec are returned, and nothing reaches the policy of the context
+	d := new(err.Diagnostics)
+	ctx := err.NewContext(context.Background(), &err.Config{Policy: err.PolicyCollect, Diagnostics: d})
+	for _, spec := range []string{"Gamma(shape=-1, rate=1)", "Gama(2, 1)", "Normal(0, 1"} {
+		if dist, e := ParseContext(ctx, spec, nil); e == nil {
+			t.Errorf("ParseContext(%q) want error, got: %v", spec, dist)
+		}
+	}
+
+	if d.Len() != 0 {
+		t.Errorf("Mismatch. want no errors collected, got: %v", d.List())
+	}
+
+	if dist, e := ParseContext(ctx, "Gamma(2, 1)", nil); e != nil || d.Len() != 0 {
+		t.Errorf("ParseContext(Gamma(2, 1)) want no error, got: %v, %v", dist, e)
+	}
+}
+
+func TestConfigReports(t *testing.T) {
+	// a series of NonCentralT that stops short of its error bound far out in the tail, reported to the
+	// Config of the distribution and not to the collector installed
+	global := new(err.Diagnostics)
+	defer err.SetDiagnostics(err.SetDiagnostics(global))
+
+	d := new(err.Diagnostics)
+	c := &err.Config{Diagnostics: d}
+	ctx := err.NewContext(context.Background(), c)
+	nct, e := NewNonCentralTContext(ctx, 5, 40, nil)
+	if e != nil {
+		t.Fatalf("NewNonCentralTContext: %v", e)
+	}
+
+	// NonCentralT(5, 40) has a cdf of 0 throughout, which Truncated refuses, so the nested one is moved
+	// there after parsing, keeping its Config
+	parsed, e := ParseContext(ctx, "Truncated(NonCentralT(5, 1), -10, 10)", nil)
+	if e != nil {
+		t.Fatalf("ParseContext: %v", e)
+	}
+	nested := *parsed.(*Truncated).dist.(*NonCentralT)
+	nested.lambda = 40
+
+	with, e := nct.WithParameters(map[string]float64{"ν": 5})
+	if e != nil {
+		t.Fatalf("WithParameters: %v", e)
+	}
+
+	var decoded NonCentralT
+	decoded.setConfig(c)
+	if e := decoded.UnmarshalText([]byte("NonCentralT(5, 40)")); e != nil {
+		t.Fatalf("UnmarshalText: %v", e)
+	}
+
+	for i, f := range []func(float64) float64{
+		nct.Distribution, nested.Distribution, with.(*NonCentralT).Distribution, decoded.Distribution,
+	} {
+		f(40)
+		if d.Len() != i+1 {
+			t.Errorf("Mismatch. case %d, errors reported to the Config want: %v, got: %v", i, i+1, d.Len())
+		}
+	}
+
+	if global.Len() != 0 {
+		t.Errorf("Mismatch. want nothing reported to the installed collector, got: %v", global.List())
+	}
+
+	plain, _ := NewNonCentralT(5, 40)
+	plain.Distribution(40)
+	if global.Len() != 1 {
+		t.Errorf("Mismatch. without a Config, reports to the installed collector want: 1, got: %v", global.Len())
+	}
+}
+
+func TestRegister(t *testing.T) {
+	if e := Register(Entry{Name: "Normal", New: registry["Normal"].New}); e == nil {
+		t.Errorf("Register accepted a duplicate name")
+	}
+
+	if e := Register(Entry{Name: "Bad Name", New: registry["Normal"].New}); e == nil {
+		t.Errorf("Register accepted an invalid name")
+	}
+
+	e := Register(Entry{
+		Name:   "testStandardNormal",
+		Params: []Param{{Name: "location", Optional: true}},
+		New: func(_ []Common, p []float64, src rand.Source) (Common, error) {
+			return NewNormalWithSource(p[0], 1, src)
+		},
+	})
+	if e != nil {
+		t.Fatalf("Register: %v", e)
+	}
+	defer func() {
+		registryMu.Lock()
+		delete(registry, "testStandardNormal")
+		registryMu.Unlock()
+	}()
+
+	d, e := Parse("Truncated(testStandardNormal(), 0, 1)")
+	if e != nil {
+		t.Fatalf("Parse: %v", e)
+	}
+
+	run_test(t, d.Distribution(.5), (stdNormalCdf(.5)-.5)/(stdNormalCdf(1)-.5), 1e-15, "Truncated(testStandardNormal(), 0, 1).Distribution(.5)")
+}
@@ -0,0 +1,176 @@
+package continuous
+
+import (
+	gsl "github.com/jtejido/ggsl"
+	"github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats"
+	"math"
+	"math/rand"
+)
+
+// Reverse Weibull distribution, of m - X for X Weibull
+// Generalized Extreme Value distribution Type-III
+// https://en.wikipedia.org/wiki/Weibull_distribution#Reverse_Weibull_distribution
+type ReverseWeibull struct {
+	baseContinuousWithSource
+	shape, scale, location float64 // α, s, m
+}
+
+func NewReverseWeibull(shape, scale, location float64) (*ReverseWeibull, error) {
+	return NewReverseWeibullWithSource(shape, scale, location, nil)
+}
+
+func NewReverseWeibullWithSource(shape, scale, location float64, src rand.Source) (*ReverseWeibull, error) {
+	r := new(ReverseWeibull)
+	r.shape = shape
+	r.scale = scale
+	r.location = location
+	r.src = src
+
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
+	return r, nil
+}
+
+func (w *ReverseWeibull) spec() (string, []Common, []float64) {
+	return "ReverseWeibull", nil, []float64{w.shape, w.scale, w.location}
+}
+
+func (w *ReverseWeibull) String() string {
+	return specString(w.spec())
+}
+
+func (w *ReverseWeibull) MarshalJSON() ([]byte, error) {
+	return marshalJSON(w)
+}
+
+func (w *ReverseWeibull) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, w, w.src)
+}
+
+func (w *ReverseWeibull) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+func (w *ReverseWeibull) UnmarshalText(text []byte) error {
+	return unmarshalText(text, w, w.src)
+}
+
+func (w *ReverseWeibull) ParameterValues() map[string]float64 {
+	return parameterValues(w)
+}
+
+func (w *ReverseWeibull) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(w, params, w.src)
+}
+
+// α ∈ (0,∞)
+// s ∈ (0,∞)
+// m ∈ (-∞,∞)
+func (w *ReverseWeibull) Parameters() stats.Limits {
+	return stats.Limits{
+		"α": stats.Interval{0, math.Inf(1), true, true},
+		"s": stats.Interval{0, math.Inf(1), true, true},
+		"m": stats.Interval{math.Inf(-1), math.Inf(1), true, true},
+	}
+}
+
+// x ∈ (-∞,m]
+func (w *ReverseWeibull) Support() stats.Interval {
+	return stats.Interval{math.Inf(-1), w.location, true, false}
+}
+
+func (w *ReverseWeibull) Probability(x float64) float64 {
+	if x > w.location {
+		return 0
+	}
+
+	z := (w.location - x) / w.scale
+	return (w.shape / w.scale) * math.Pow(z, w.shape-1) * math.Exp(-math.Pow(z, w.shape))
+}
+
+func (w *ReverseWeibull) Distribution(x float64) float64 {
+	if x >= w.location {
+		return 1
+	}
+
+	return math.Exp(-math.Pow((w.location-x)/w.scale, w.shape))
+}
+
+func (w *ReverseWeibull) Inverse(p float64) float64 {
+	if p <= 0 {
+		return math.Inf(-1)
+	}
+
+	if p >= 1 {
+		return w.location
+	}
+
+	return w.location - w.scale*math.Pow(-math.Log(p), 1/w.shape)
+}
+
+func (w *ReverseWeibull) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return w.location
+	}
+
+	return w.location - w.scale*math.Pow(-math.Log1p(-q), 1/w.shape)
+}
+
+func (w *ReverseWeibull) Entropy() float64 {
+	return gsl.Euler*(1-1/w.shape) + math.Log(w.scale/w.shape) + 1
+}
+
+func (w *ReverseWeibull) Mean() float64 {
+	return w.location - w.scale*specfunc.Gamma(1+1/w.shape)
+}
+
+func (w *ReverseWeibull) Median() float64 {
+	return w.location - w.scale*math.Pow(math.Ln2, 1/w.shape)
+}
+
+func (w *ReverseWeibull) Mode() float64 {
+	if w.shape > 1 {
+		return w.location - w.scale*math.Pow((w.shape-1)/w.shape, 1/w.shape)
+	}
+
+	return w.location
+}
+
+// of the Weibull, by the raw moments Γ(1 + k/α) of its standard form, and negated
+func (w *ReverseWeibull) Skewness() float64 {
+	g1, g2, g3 := w.gk(1), w.gk(2), w.gk(3)
+	return -(g3 - 3*g1*g2 + 2*g1*g1*g1) / math.Pow(g2-g1*g1, 1.5)
+}
+
+func (w *ReverseWeibull) ExKurtosis() float64 {
+	g1, g2, g3, g4 := w.gk(1), w.gk(2), w.gk(3), w.gk(4)
+	v := g2 - g1*g1
+	return (g4-4*g1*g3+6*g1*g1*g2-3*g1*g1*g1*g1)/(v*v) - 3
+}
+
+func (w *ReverseWeibull) Variance() float64 {
+	g1 := w.gk(1)
+	return w.scale * w.scale * (w.gk(2) - g1*g1)
+}
+
+func (w *ReverseWeibull) gk(k float64) float64 {
+	return specfunc.Gamma(1 + k/w.shape)
+}
+
+func (w *ReverseWeibull) Rand() float64 {
+	var rnd float64
+	if w.src != nil {
+		rnd = rand.New(w.src).Float64()
+	} else {
+		rnd = rand.Float64()
+	}
+
+	return w.Inverse(rnd)
+}
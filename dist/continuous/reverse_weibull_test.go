@@ -0,0 +1,42 @@
+package continuous
+
+import (
+	"fmt"
+	"testing"
+)
+
+// X is reverse Weibull iff m - X is Weibull
+func TestReverseWeibull(t *testing.T) {
+	tol := 1e-13
+	for _, c := range []struct {
+		α, s, m float64
+	}{
+		{3, 2, 1},
+		{.7, 1, 0},
+		{1, .5, -2},
+	} {
+		r := &ReverseWeibull{shape: c.α, scale: c.s, location: c.m}
+		w := &Weibull{scale: c.s, shape: c.α}
+		for _, y := range []float64{.01, .4, 1, 2.5} {
+			x := c.m - y
+			desc := fmt.Sprintf("ReverseWeibull(%v, %v, %v).%%s(%v)", c.α, c.s, c.m, x)
+			run_test(t, r.Probability(x), w.Probability(y), tol, fmt.Sprintf(desc, "Probability"))
+			run_test(t, r.Distribution(x), 1-w.Distribution(y), tol, fmt.Sprintf(desc, "Distribution"))
+		}
+
+		for _, p := range []float64{.001, .3, .5, .95} {
+			run_test(t, r.Inverse(p), c.m-w.Inverse(1-p), tol, fmt.Sprintf("ReverseWeibull(%v, %v, %v).Inverse(%v)", c.α, c.s, c.m, p))
+		}
+
+		desc := fmt.Sprintf("ReverseWeibull(%v, %v, %v).%%s", c.α, c.s, c.m)
+		run_test(t, r.Mean(), c.m-w.Mean(), tol, fmt.Sprintf(desc, "Mean"))
+		run_test(t, r.Variance(), w.Variance(), tol, fmt.Sprintf(desc, "Variance"))
+		run_test(t, r.Skewness(), -w.Skewness(), 1e-10, fmt.Sprintf(desc, "Skewness"))
+		run_test(t, r.ExKurtosis(), w.ExKurtosis(), 1e-10, fmt.Sprintf(desc, "ExKurtosis"))
+	}
+
+	r := &ReverseWeibull{shape: 3, scale: 2, location: 1}
+	if p, d := r.Probability(1.5), r.Distribution(1.5); p != 0 || d != 1 {
+		t.Errorf("Mismatch. ReverseWeibull(3, 2, 1) above its support, want: 0, 1, got: %v, %v", p, d)
+	}
+}
@@ -1,6 +1,7 @@
 package continuous
 
 import (
+	"context"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
 	"github.com/jtejido/stats/err"
@@ -14,6 +15,7 @@ import (
 type Rice struct {
 	distance, spread float64 // v, σ
 	src              rand.Source
+	errs             *err.Config
 }
 
 func NewRice(distance, spread float64) (*Rice, error) {
@@ -21,18 +23,70 @@ func NewRice(distance, spread float64) (*Rice, error) {
 }
 
 func NewRiceWithSource(distance, spread float64, src rand.Source) (*Rice, error) {
-	if distance < 0 || spread < 0 {
-		return nil, err.Invalid()
+	r := &Rice{distance, spread, src, nil}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Rice{distance, spread, src}, nil
+	return r, nil
 }
 
-// μ ∈ [0,∞)
-// σ ∈ [0,∞)
+// NewRiceContext is NewRiceWithSource reporting to the err.Config carried by ctx, if any.
+func NewRiceContext(ctx context.Context, distance, spread float64, src rand.Source) (*Rice, error) {
+	r, e := NewRiceWithSource(distance, spread, src)
+	if e != nil {
+		return nil, e
+	}
+
+	r.errs = err.FromContext(ctx)
+	return r, nil
+}
+
+func (r *Rice) spec() (string, []Common, []float64) {
+	return "Rice", nil, []float64{r.distance, r.spread}
+}
+
+func (r *Rice) String() string {
+	return specString(r.spec())
+}
+
+func (r *Rice) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *Rice) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r, r.src)
+}
+
+func (r *Rice) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Rice) UnmarshalText(text []byte) error {
+	return unmarshalText(text, r, r.src)
+}
+
+func (r *Rice) ParameterValues() map[string]float64 {
+	return parameterValues(r)
+}
+
+func (r *Rice) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(r, params, r.src)
+}
+
+func (r *Rice) config() *err.Config {
+	return r.errs
+}
+
+func (r *Rice) setConfig(c *err.Config) {
+	r.errs = c
+}
+
+// v ∈ [0,∞)
+// σ ∈ (0,∞)
 func (r *Rice) Parameters() stats.Limits {
 	return stats.Limits{
-		"v": stats.Interval{0, math.Inf(1), true, true},
+		"v": stats.Interval{0, math.Inf(1), false, true},
 		"σ": stats.Interval{0, math.Inf(1), true, true},
 	}
 }
@@ -44,7 +98,7 @@ func (r *Rice) Support() stats.Interval {
 
 func (r *Rice) Probability(x float64) float64 {
 	if r.Support().IsWithinInterval(x) {
-		return (x / (r.spread * r.spread)) * math.Exp(-((x*x)+(r.distance*r.distance))/(2*(r.spread*r.spread))) * specfunc.Bessel_I0((x*r.distance)/(r.spread*r.spread))
+		return (x / (r.spread * r.spread)) * math.Exp(-((x*x)+(r.distance*r.distance))/(2*(r.spread*r.spread))) * checkSpecial(r.errs, "Rice: I0", x, specfunc.Bessel_I0((x*r.distance)/(r.spread*r.spread)))
 	}
 
 	return 0
@@ -52,7 +106,7 @@ func (r *Rice) Probability(x float64) float64 {
 
 func (r *Rice) Distribution(x float64) float64 {
 	if r.Support().IsWithinInterval(x) {
-		return 1 - smath.MarcumQ(1, r.distance/r.spread, x/r.spread)
+		return 1 - smath.MarcumQWithConfig(1, r.distance/r.spread, x/r.spread, r.errs)
 	}
 
 	return 0
@@ -67,16 +121,29 @@ func (r *Rice) Inverse(p float64) float64 {
 		return math.Inf(1)
 	}
 
-	ncs := NonCentralChiSquared{2, math.Pow(r.distance/r.spread, 2), nil}
+	ncs := NonCentralChiSquared{2, math.Pow(r.distance/r.spread, 2), nil, r.errs}
 	return math.Sqrt(ncs.Inverse(p)) * r.spread
 }
 
+func (r *Rice) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	ncs := NonCentralChiSquared{2, math.Pow(r.distance/r.spread, 2), nil, r.errs}
+	return math.Sqrt(ncs.InverseSurvival(q)) * r.spread
+}
+
 func (r *Rice) Mean() float64 {
-	return r.spread * math.Sqrt(math.Pi/2) * smath.Laguerre(1./2, math.Pow(-r.distance, 2.)/(2*(r.spread*r.spread)))
+	return r.spread * math.Sqrt(math.Pi/2) * smath.Laguerre(1./2, -(r.distance*r.distance)/(2*(r.spread*r.spread)))
 }
 
 func (r *Rice) Variance() float64 {
-	return 2*(r.spread*r.spread) + (r.distance * r.distance) - ((math.Pi*(r.spread*r.spread))/2)*math.Pow(smath.Laguerre(1./2, math.Pow(-r.distance, 2.)/(2*(r.spread*r.spread))), 2.)
+	return 2*(r.spread*r.spread) + (r.distance * r.distance) - ((math.Pi*(r.spread*r.spread))/2)*math.Pow(smath.Laguerre(1./2, -(r.distance*r.distance)/(2*(r.spread*r.spread))), 2.)
 }
 
 func (r *Rice) Skewness() float64 {
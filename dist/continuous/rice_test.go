@@ -23,7 +23,7 @@ func TestRiceProbability(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			b := Rice{c.v, c.σ, nil}
+			b := Rice{c.v, c.σ, nil, nil}
 
 			res := b.Probability(c.x)
 			if math.Abs(res-c.expected) > tol {
@@ -51,7 +51,7 @@ func TestRiceDistribution(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			b := Rice{c.v, c.σ, nil}
+			b := Rice{c.v, c.σ, nil, nil}
 
 			res := b.Distribution(c.x)
 			if math.Abs(res-c.expected) > tol {
@@ -77,7 +77,7 @@ func TestRiceInverse(t *testing.T) {
 
 	for i, c := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			b := Rice{c.v, c.σ, nil}
+			b := Rice{c.v, c.σ, nil, nil}
 
 			res := b.Inverse(c.x)
 			if math.Abs(res-c.expected) > tol {
@@ -4,7 +4,6 @@ import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -21,11 +20,44 @@ func NewShiftedGompertz(scale, shape float64) (*ShiftedGompertz, error) {
 }
 
 func NewShiftedGompertzWithSource(scale, shape float64, src rand.Source) (*ShiftedGompertz, error) {
-	if scale < 0 || shape < 0 {
-		return nil, err.Invalid()
+	r := &ShiftedGompertz{scale, shape, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &ShiftedGompertz{scale, shape, src}, nil
+	return r, nil
+}
+
+func (sg *ShiftedGompertz) spec() (string, []Common, []float64) {
+	return "ShiftedGompertz", nil, []float64{sg.scale, sg.shape}
+}
+
+func (sg *ShiftedGompertz) String() string {
+	return specString(sg.spec())
+}
+
+func (sg *ShiftedGompertz) MarshalJSON() ([]byte, error) {
+	return marshalJSON(sg)
+}
+
+func (sg *ShiftedGompertz) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, sg, sg.src)
+}
+
+func (sg *ShiftedGompertz) MarshalText() ([]byte, error) {
+	return []byte(sg.String()), nil
+}
+
+func (sg *ShiftedGompertz) UnmarshalText(text []byte) error {
+	return unmarshalText(text, sg, sg.src)
+}
+
+func (sg *ShiftedGompertz) ParameterValues() map[string]float64 {
+	return parameterValues(sg)
+}
+
+func (sg *ShiftedGompertz) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(sg, params, sg.src)
 }
 
 // η ∈ [0,∞)
@@ -94,6 +126,24 @@ func (sg *ShiftedGompertz) Inverse(p float64) float64 {
 	return -(math.Log(1-(specfunc.Lambert_W0(math.Exp(sg.shape)*p*sg.shape)/sg.shape)) / sg.scale)
 }
 
+func (sg *ShiftedGompertz) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// S(x) = -expm1(ln F(x)), keeping relative accuracy as F(x) → 1
+	sf := func(x float64) float64 {
+		e := math.Exp(-sg.scale * x)
+		return -math.Expm1(math.Log1p(-e) - sg.shape*e)
+	}
+
+	return inverseSurvival(nil, sf, sg.Probability, 0, math.Inf(1), math.NaN(), q)
+}
+
 func (sg *ShiftedGompertz) Rand() float64 {
 	var rnd float64
 	if sg.src == nil {
@@ -4,7 +4,6 @@ import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
 	"math/rand"
@@ -22,11 +21,44 @@ func NewStudentT(dof float64) (*StudentT, error) {
 }
 
 func NewStudentTWithSource(dof float64, src rand.Source) (*StudentT, error) {
-	if dof <= 0 {
-		return nil, err.Invalid()
+	r := &StudentT{dof, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &StudentT{dof, src}, nil
+	return r, nil
+}
+
+func (st *StudentT) spec() (string, []Common, []float64) {
+	return "StudentT", nil, []float64{st.dof}
+}
+
+func (st *StudentT) String() string {
+	return specString(st.spec())
+}
+
+func (st *StudentT) MarshalJSON() ([]byte, error) {
+	return marshalJSON(st)
+}
+
+func (st *StudentT) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, st, st.src)
+}
+
+func (st *StudentT) MarshalText() ([]byte, error) {
+	return []byte(st.String()), nil
+}
+
+func (st *StudentT) UnmarshalText(text []byte) error {
+	return unmarshalText(text, st, st.src)
+}
+
+func (st *StudentT) ParameterValues() map[string]float64 {
+	return parameterValues(st)
+}
+
+func (st *StudentT) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(st, params, st.src)
 }
 
 // ν ∈ (0,∞)
@@ -105,6 +137,19 @@ func (st *StudentT) Inverse(p float64) float64 {
 
 }
 
+func (st *StudentT) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return math.Inf(-1)
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	// symmetric about 0
+	return -st.Inverse(q)
+}
+
 func (st *StudentT) Mean() float64 {
 	if st.dof > 1 {
 		return 0
@@ -0,0 +1,1048 @@
+#!/usr/bin/env python3
+"""Generates golden.csv, the reference values checked by TestGolden.
+
+Every value is computed with the standard library's decimal module at 60 significant digits, from the
+textbook closed forms of each density and cdf, or the regularised incomplete gamma and beta functions, Bessel
+series and Poisson mixtures of them where there are none; quantiles are found by bisecting the cdf,
+independently of any closed form. Values are written with 21 significant digits, more than a float64 holds.
+
+    python3 testdata/gen_golden.py > testdata/golden.csv
+"""
+
+import csv
+import sys
+from decimal import Decimal as D, getcontext, localcontext
+
+getcontext().prec = 60
+
+PI = D("3.14159265358979323846264338327950288419716939937510582097494459")
+ZERO, ONE, TWO, HALF = D(0), D(1), D(2), D("0.5")
+INF = D("Infinity")
+
+
+def exp(x):
+    return D(x).exp()
+
+
+def ln(x):
+    return D(x).ln()
+
+
+def sqrt(x):
+    return D(x).sqrt()
+
+
+def pow_(x, y):
+    x, y = D(x), D(y)
+    if x == 0:
+        return ZERO if y > 0 else INF
+    if y == y.to_integral_value():
+        return x ** int(y)
+    return exp(y * ln(x))
+
+
+def sin(x):
+    x = D(x) % (2 * PI)
+    term, total, n = x, x, 1
+    while abs(term) > D(10) ** -70:
+        term *= -x * x / ((2 * n) * (2 * n + 1))
+        total += term
+        n += 1
+    return total
+
+
+def cos(x):
+    return sin(D(x) + PI / 2)
+
+
+def atan(x):
+    x = D(x)
+    if x < 0:
+        return -atan(-x)
+    if x > 1:
+        return PI / 2 - atan(1 / x)
+    # halve the argument twice, atan(x) = 2 atan(x / (1 + √(1+x²)))
+    for _ in range(2):
+        x = x / (1 + sqrt(1 + x * x))
+    term, total, n = x, x, 1
+    while abs(term) > D(10) ** -70:
+        term *= -x * x
+        total += term / (2 * n + 1)
+        n += 1
+    return 4 * total
+
+
+def asin(x):
+    x = D(x)
+    if abs(x) == 1:
+        return x * PI / 2
+    return atan(x / sqrt(1 - x * x))
+
+
+def erfc(x):
+    x = D(x)
+    if x < 3:
+        # 1 - erf(x) from its Maclaurin series, at enough extra precision to absorb the cancellation
+        with localcontext() as ctx:
+            ctx.prec = 90
+            term, total, n = x, x, 0
+            while abs(term) > D(10) ** -100:
+                n += 1
+                term *= -x * x / n
+                total += term / (2 * n + 1)
+            r = 1 - 2 / sqrt(PI) * total
+        return +r
+    # continued fraction erfc(x) = exp(-x²)/√π · 1/(x + 1/2/(x + 1/(x + 3/2/(x + ...))))
+    f = x
+    for n in range(400, 0, -1):
+        f = x + D(n) / 2 / f
+    return exp(-x * x) / sqrt(PI) / f
+
+
+def normal_cdf(z):
+    return erfc(-D(z) / sqrt(2)) / 2
+
+
+def normal_pdf(z):
+    return exp(-D(z) * D(z) / 2) / sqrt(2 * PI)
+
+
+def asinh(x):
+    x = D(x)
+    if x < 0:
+        return -asinh(-x)
+    return ln(x + sqrt(x * x + 1))
+
+
+def bernoulli(n):
+    """B₂, B₄, ..., B₂ₙ, exactly, from the recurrence Σ C(m+1, j) Bⱼ = 0."""
+    from fractions import Fraction
+    b = [Fraction(1)]
+    for m in range(1, 2 * n + 1):
+        s, c = Fraction(0), 1
+        for j in range(m):
+            s += c * b[j]
+            c = c * (m + 1 - j) // (j + 1)
+        b.append(-s / (m + 1))
+    return [D(v.numerator) / D(v.denominator) for v in b[2::2]]
+
+
+BERNOULLI = None
+
+
+def lgamma(x):
+    """log Γ(x) for x > 0, by Stirling's series after shifting x past 50."""
+    global BERNOULLI
+    if BERNOULLI is None:
+        with localcontext() as ctx:
+            ctx.prec = 90
+            BERNOULLI = bernoulli(30)
+    x = D(x)
+    with localcontext() as ctx:
+        ctx.prec = 90
+        shift = ONE
+        while x < 50:
+            shift *= x
+            x += 1
+        s = (x - HALF) * ln(x) - x + ln(2 * PI) / 2
+        xp = x
+        for k, b in enumerate(BERNOULLI, 1):
+            s += b / (2 * k * (2 * k - 1) * xp)
+            xp *= x * x
+        r = s - ln(shift)
+    return +r
+
+
+def gamma(x):
+    return exp(lgamma(x))
+
+
+def lbeta(a, b):
+    return lgamma(a) + lgamma(b) - lgamma(D(a) + D(b))
+
+
+def gamma_pq(a, x):
+    """The regularised incomplete gammas P(a, x) and Q(a, x), by the series for P below a+1 and the
+    continued fraction for Q above it, so that neither is found by cancellation."""
+    a, x = D(a), D(x)
+    if x <= 0:
+        return ZERO, ONE
+    with localcontext() as ctx:
+        ctx.prec = 90
+        front = exp(a * ln(x) - x - lgamma(a))
+        if x < a + 1:
+            term = total = 1 / a
+            n = 1
+            while term > total * D(10) ** -88:
+                term *= x / (a + n)
+                total += term
+                n += 1
+            p = front * total
+            q = 1 - p
+        else:
+            tiny = D(10) ** -300
+            b = x + 1 - a
+            c, d = 1 / tiny, 1 / b
+            h, i = d, 1
+            while True:
+                an = -i * (i - a)
+                b += 2
+                d = an * d + b
+                d = 1 / (d if d != 0 else tiny)
+                c = b + an / c
+                c = c if c != 0 else tiny
+                h *= d * c
+                if abs(d * c - 1) < D(10) ** -88:
+                    break
+                i += 1
+            q = front * h
+            p = 1 - q
+    return +p, +q
+
+
+def gamma_p(a, x):
+    return gamma_pq(a, x)[0]
+
+
+def gamma_q(a, x):
+    return gamma_pq(a, x)[1]
+
+
+def beta_inc(x, a, b):
+    """The regularised incomplete beta Iₓ(a, b), by its continued fraction on whichever side of the
+    mean it converges fastest, 1 - I₁₋ₓ(b, a) on the other."""
+    x, a, b = D(x), D(a), D(b)
+    if x <= 0:
+        return ZERO
+    if x >= 1:
+        return ONE
+    if x > (a + 1) / (a + b + 2):
+        with localcontext() as ctx:
+            ctx.prec = 90
+            r = 1 - beta_inc(1 - x, b, a)
+        return +r
+    with localcontext() as ctx:
+        ctx.prec = 90
+        front = exp(a * ln(x) + b * ln(1 - x) - lbeta(a, b)) / a
+        tiny = D(10) ** -300
+        c, d = ONE, 1 - (a + b) * x / (a + 1)
+        d = 1 / (d if d != 0 else tiny)
+        h, m = d, 1
+        while True:
+            for num in (m * (b - m) * x / ((a + 2 * m - 1) * (a + 2 * m)),
+                        -(a + m) * (a + b + m) * x / ((a + 2 * m) * (a + 2 * m + 1))):
+                d = 1 + num * d
+                d = 1 / (d if d != 0 else tiny)
+                c = 1 + num / c
+                c = c if c != 0 else tiny
+                h *= d * c
+            if abs(d * c - 1) < D(10) ** -88:
+                break
+            m += 1
+        r = front * h
+    return +r
+
+
+def bessel_i(n, z):
+    """The modified Bessel function Iₙ(z) of integer order, from its power series."""
+    z = D(z) / 2
+    term = z ** n / gamma(n + 1)
+    total, m = term, 1
+    while term > total * D(10) ** -70:
+        term *= z * z / (m * (m + n))
+        total += term
+        m += 1
+    return total
+
+
+def poisson(mean):
+    """The Poisson weights e⁻ᵐmʲ/j!, to well past the mean and below 1e-80."""
+    mean = D(mean)
+    w, j, ws = exp(-mean), 0, []
+    while j <= mean or w > D(10) ** -80:
+        ws.append(w)
+        j += 1
+        w *= mean / j
+    return ws
+
+
+def gamma_mixture(ws, a, x):
+    """Σ wⱼ P(a+j, x), stepping P(a+j+1, x) = P(a+j, x) - xᵃ⁺ʲe⁻ˣ/Γ(a+j+1) down the weights."""
+    a, x = D(a), D(x)
+    if x <= 0:
+        return ZERO
+    with localcontext() as ctx:
+        ctx.prec = 90
+        p = gamma_p(a, x)
+        t = exp(a * ln(x) - x - lgamma(a + 1))
+        total = ZERO
+        for j, w in enumerate(ws):
+            total += w * p
+            p -= t
+            t *= x / (a + j + 1)
+    return +total
+
+
+def beta_mixture(ws, x, a, b):
+    """Σ wⱼ Iₓ(a+j, b), stepping Iₓ(a+j+1, b) = Iₓ(a+j, b) - xᵃ⁺ʲ(1-x)ᵇ/((a+j) B(a+j, b)) down the weights."""
+    x, a, b = D(x), D(a), D(b)
+    if x <= 0:
+        return ZERO
+    if x >= 1:
+        return sum(ws)
+    with localcontext() as ctx:
+        ctx.prec = 90
+        i = beta_inc(x, a, b)
+        t = exp(a * ln(x) + b * ln(1 - x) - lbeta(a, b)) / a
+        total = ZERO
+        for j, w in enumerate(ws):
+            total += w * i
+            i -= t
+            t *= x * (a + b + j) / (a + j + 1)
+    return +total
+
+
+def circular_cdf(theta, mu, lower, coefficients):
+    """∫ from lower to θ of the density 1/2π (1 + 2 Σ cⱼ cos(j(x-μ))) with Fourier coefficients cⱼ."""
+    theta, mu, lower = D(theta), D(mu), D(lower)
+    total = (theta - lower) / (2 * PI)
+    for j, c in enumerate(coefficients, 1):
+        total += c / (j * PI) * (sin(j * (theta - mu)) - sin(j * (lower - mu)))
+    return total
+
+
+class Dist:
+    """A distribution with its registry spec, density, cdf and support."""
+
+    def __init__(self, name, params, pdf, cdf, lower=-INF, upper=INF):
+        self.name, self.params = name, params
+        self.pdf, self.cdf = pdf, cdf
+        self.lower, self.upper = D(lower), D(upper)
+
+    def quantile(self, p):
+        """Bisects the cdf, first bracketing p by doubling steps out of an infinite support."""
+        p = D(p)
+        lo, hi = self.lower, self.upper
+        if hi == INF:
+            start, step = (lo if lo > -INF else ZERO), ONE
+            hi = start + step
+            while self.cdf(hi) < p:
+                step *= 2
+                hi = start + step
+        if lo == -INF:
+            step = ONE
+            lo = hi - step
+            while self.cdf(lo) > p:
+                step *= 2
+                lo = hi - step
+        for _ in range(240):
+            mid = (lo + hi) / 2
+            if self.cdf(mid) < p:
+                lo = mid
+            else:
+                hi = mid
+        q = (lo + hi) / 2
+        # bisection only gets within 2⁻²⁴⁰ of a quantile at 0
+        return ZERO if abs(q) < D(10) ** -50 else q
+
+
+def between(x, lo, hi, f, below=ZERO, above=ZERO):
+    x = D(x)
+    if x < lo:
+        return below
+    if x > hi:
+        return above
+    return f(x)
+
+
+def normal(mu, s):
+    mu, s = D(mu), D(s)
+    return Dist("Normal", f"location={mu}, scale={s}",
+                lambda x: normal_pdf((x - mu) / s) / s,
+                lambda x: normal_cdf((x - mu) / s))
+
+
+def log_normal(mu, s):
+    mu, s = D(mu), D(s)
+    return Dist("LogNormal", f"location={mu}, scale={s}",
+                lambda x: between(x, 0, INF, lambda x: normal_pdf((ln(x) - mu) / s) / (s * x)),
+                lambda x: between(x, 0, INF, lambda x: normal_cdf((ln(x) - mu) / s), above=ONE), 0)
+
+
+def exponential(rate):
+    l = D(rate)
+    return Dist("Exponential", f"rate={l}",
+                lambda x: between(x, 0, INF, lambda x: l * exp(-l * x)),
+                lambda x: between(x, 0, INF, lambda x: 1 - exp(-l * x)), 0)
+
+
+def weibull(scale, shape):
+    l, k = D(scale), D(shape)
+    return Dist("Weibull", f"scale={l}, shape={k}",
+                lambda x: between(x, 0, INF, lambda x: k / l * pow_(x / l, k - 1) * exp(-pow_(x / l, k))),
+                lambda x: between(x, 0, INF, lambda x: 1 - exp(-pow_(x / l, k))), 0)
+
+
+def gumbel(mu, beta):
+    mu, b = D(mu), D(beta)
+    return Dist("Gumbel", f"location={mu}, scale={b}",
+                lambda x: exp(-(x - mu) / b - exp(-(x - mu) / b)) / b,
+                lambda x: exp(-exp(-(x - mu) / b)))
+
+
+def logistic(mu, s):
+    mu, s = D(mu), D(s)
+    return Dist("Logistic", f"location={mu}, scale={s}",
+                lambda x: exp(-(x - mu) / s) / (s * (1 + exp(-(x - mu) / s)) ** 2),
+                lambda x: 1 / (1 + exp(-(x - mu) / s)))
+
+
+def cauchy(x0, g):
+    x0, g = D(x0), D(g)
+    return Dist("Cauchy", f"location={x0}, scale={g}",
+                lambda x: 1 / (PI * g * (1 + ((x - x0) / g) ** 2)),
+                lambda x: HALF + atan((x - x0) / g) / PI)
+
+
+def laplace(mu, b):
+    mu, b = D(mu), D(b)
+    return Dist("Laplace", f"location={mu}, scale={b}",
+                lambda x: exp(-abs(x - mu) / b) / (2 * b),
+                lambda x: exp((x - mu) / b) / 2 if x < mu else 1 - exp(-(x - mu) / b) / 2)
+
+
+def rayleigh(s):
+    s = D(s)
+    return Dist("Rayleigh", f"scale={s}",
+                lambda x: between(x, 0, INF, lambda x: x / s ** 2 * exp(-x * x / (2 * s * s))),
+                lambda x: between(x, 0, INF, lambda x: 1 - exp(-x * x / (2 * s * s))), 0)
+
+
+def maxwell(a):
+    a = D(a)
+    return Dist("MaxwellBoltzmann", f"scale={a}",
+                lambda x: between(x, 0, INF, lambda x: sqrt(2 / PI) * x * x * exp(-x * x / (2 * a * a)) / a ** 3),
+                lambda x: between(x, 0, INF, lambda x: 1 - erfc(x / (sqrt(2) * a)) - sqrt(2 / PI) * x * exp(-x * x / (2 * a * a)) / a), 0)
+
+
+def pareto(alpha, xm):
+    a, xm = D(alpha), D(xm)
+    return Dist("Pareto", f"shape={a}, xmin={xm}",
+                lambda x: between(x, xm, INF, lambda x: a * pow_(xm, a) / pow_(x, a + 1)),
+                lambda x: between(x, xm, INF, lambda x: 1 - pow_(xm / x, a)), xm)
+
+
+def pareto_type_2(xm, alpha, mu):
+    l, a, mu = D(xm), D(alpha), D(mu)
+    return Dist("ParetoType2", f"xmin={l}, shape={a}, location={mu}",
+                lambda x: between(x, mu, INF, lambda x: a / l * pow_(1 + (x - mu) / l, -(a + 1))),
+                lambda x: between(x, mu, INF, lambda x: 1 - pow_(1 + (x - mu) / l, -a)), mu)
+
+
+def uniform(a, b):
+    a, b = D(a), D(b)
+    return Dist("Uniform", f"min={a}, max={b}",
+                lambda x: between(x, a, b, lambda x: 1 / (b - a)),
+                lambda x: between(x, a, b, lambda x: (x - a) / (b - a), above=ONE), a, b)
+
+
+def triangular(a, b, c):
+    a, b, c = D(a), D(b), D(c)
+
+    def cdf(x):
+        if x <= c:
+            return (x - a) ** 2 / ((b - a) * (c - a))
+        return 1 - (b - x) ** 2 / ((b - a) * (b - c))
+
+    def pdf(x):
+        if x <= c:
+            return 2 * (x - a) / ((b - a) * (c - a))
+        return 2 * (b - x) / ((b - a) * (b - c))
+
+    return Dist("Triangular", f"min={a}, max={b}, mode={c}",
+                lambda x: between(x, a, b, pdf), lambda x: between(x, a, b, cdf, above=ONE), a, b)
+
+
+def arcsine_bounded(a, b):
+    a, b = D(a), D(b)
+    return Dist("ArcsineBounded", f"min={a}, max={b}",
+                lambda x: between(x, a, b, lambda x: 1 / (PI * sqrt((x - a) * (b - x)))),
+                lambda x: between(x, a, b, lambda x: 2 / PI * asin(sqrt((x - a) / (b - a))), above=ONE), a, b)
+
+
+def kumaraswamy(a, b):
+    a, b = D(a), D(b)
+    return Dist("Kumaraswamy", f"a={a}, b={b}",
+                lambda x: between(x, 0, 1, lambda x: a * b * pow_(x, a - 1) * pow_(1 - pow_(x, a), b - 1)),
+                lambda x: between(x, 0, 1, lambda x: 1 - pow_(1 - pow_(x, a), b), above=ONE), 0, 1)
+
+
+def log_logistic(alpha, beta, gamma):
+    a, b, g = D(alpha), D(beta), D(gamma)
+    return Dist("LogLogistic", f"scale={a}, shape={b}, location={g}",
+                lambda x: between(x, g, INF, lambda x: (b / a) * pow_((x - g) / a, b - 1) / (1 + pow_((x - g) / a, b)) ** 2),
+                lambda x: between(x, g, INF, lambda x: 1 / (1 + pow_((x - g) / a, -b))), g)
+
+
+def frechet(alpha, s, m):
+    a, s, m = D(alpha), D(s), D(m)
+    return Dist("Frechet", f"shape={a}, scale={s}, location={m}",
+                lambda x: between(x, m, INF, lambda x: a / s * pow_((x - m) / s, -1 - a) * exp(-pow_((x - m) / s, -a))),
+                lambda x: between(x, m, INF, lambda x: exp(-pow_((x - m) / s, -a))), m)
+
+
+def burr(c, k, l):
+    c, k, l = D(c), D(k), D(l)
+    return Dist("Burr", f"c={c}, k={k}, scale={l}",
+                lambda x: between(x, 0, INF, lambda x: c * k / l * pow_(x / l, c - 1) * pow_(1 + pow_(x / l, c), -k - 1)),
+                lambda x: between(x, 0, INF, lambda x: 1 - pow_(1 + pow_(x / l, c), -k)), 0)
+
+
+def dagum(p, a, b):
+    p, a, b = D(p), D(a), D(b)
+    return Dist("Dagum", f"p={p}, a={a}, scale={b}",
+                lambda x: between(x, 0, INF, lambda x: a * p / x * pow_(x / b, a * p) / pow_(pow_(x / b, a) + 1, p + 1)),
+                lambda x: between(x, 0, INF, lambda x: pow_(1 + pow_(x / b, -a), -p)), 0)
+
+
+def levy(mu, c):
+    mu, c = D(mu), D(c)
+    return Dist("Levy", f"location={mu}, scale={c}",
+                lambda x: between(x, mu, INF, lambda x: sqrt(c / (2 * PI)) * exp(-c / (2 * (x - mu))) / pow_(x - mu, D("1.5"))),
+                lambda x: between(x, mu, INF, lambda x: erfc(sqrt(c / (2 * (x - mu))))), mu)
+
+
+def hyperbolic_secant():
+    return Dist("HyperbolicSecant", "",
+                lambda x: 1 / (exp(PI * x / 2) + exp(-PI * x / 2)),
+                lambda x: 2 / PI * atan(exp(PI * x / 2)))
+
+
+def raised_cosine(mu, s):
+    mu, s = D(mu), D(s)
+    return Dist("RaisedCosine", f"location={mu}, scale={s}",
+                lambda x: between(x, mu - s, mu + s, lambda x: (1 + cos(PI * (x - mu) / s)) / (2 * s)),
+                lambda x: between(x, mu - s, mu + s, lambda x: (1 + (x - mu) / s + sin(PI * (x - mu) / s) / PI) / 2, above=ONE),
+                mu - s, mu + s)
+
+
+def wigner(r, a):
+    r, a = D(r), D(a)
+    return Dist("WignerSemiCircle", f"radius={r}, center={a}",
+                lambda x: between(x, a - r, a + r, lambda x: 2 / (PI * r * r) * sqrt(r * r - (x - a) ** 2)),
+                lambda x: between(x, a - r, a + r, lambda x: HALF + (x - a) * sqrt(r * r - (x - a) ** 2) / (PI * r * r) + asin((x - a) / r) / PI, above=ONE),
+                a - r, a + r)
+
+
+# in the parametrisation of the handbook gompertz.go cites, η being the hazard at 0
+def gompertz(eta, b):
+    eta, b = D(eta), D(b)
+    return Dist("Gompertz", f"shape={eta}, scale={b}",
+                lambda x: between(x, 0, INF, lambda x: eta * exp(b * x) * exp(-eta / b * (exp(b * x) - 1))),
+                lambda x: between(x, 0, INF, lambda x: 1 - exp(-eta / b * (exp(b * x) - 1))), 0)
+
+
+def shifted_gompertz(b, eta):
+    b, eta = D(b), D(eta)
+    return Dist("ShiftedGompertz", f"scale={b}, shape={eta}",
+                lambda x: between(x, 0, INF, lambda x: b * exp(-b * x) * exp(-eta * exp(-b * x)) * (1 + eta * (1 - exp(-b * x)))),
+                lambda x: between(x, 0, INF, lambda x: (1 - exp(-b * x)) * exp(-eta * exp(-b * x))), 0)
+
+
+def asymmetric_laplace(m, l, k):
+    m, l, k = D(m), D(l), D(k)
+
+    def pdf(x):
+        if x < m:
+            return l / (k + 1 / k) * exp(l / k * (x - m))
+        return l / (k + 1 / k) * exp(-l * k * (x - m))
+
+    def cdf(x):
+        if x <= m:
+            return k * k / (1 + k * k) * exp(l / k * (x - m))
+        return 1 - 1 / (1 + k * k) * exp(-l * k * (x - m))
+
+    return Dist("AssymetricLaplace", f"location={m}, scale={l}, asymmetry={k}", pdf, cdf)
+
+
+def gev(mu, s, xi):
+    mu, s, xi = D(mu), D(s), D(xi)
+
+    def t(x):
+        if xi == 0:
+            return exp(-(x - mu) / s)
+        e = -ln(1 + xi * (x - mu) / s) / xi
+        # so far below the mode that the density and cdf underflow
+        return INF if e > 10000 else exp(e)
+
+    end = mu - s / xi if xi != 0 else ZERO
+    lower, upper = (end, INF) if xi > 0 else (-INF, end) if xi < 0 else (-INF, INF)
+    return Dist("GEV", f"location={mu}, scale={s}, shape={xi}",
+                lambda x: between(x, lower, upper, lambda x: ZERO if t(x) == INF else pow_(t(x), xi + 1) * exp(-t(x)) / s),
+                lambda x: between(x, lower, upper, lambda x: exp(-t(x)), above=ONE), lower, upper)
+
+
+def gpd(mu, s, xi):
+    mu, s, xi = D(mu), D(s), D(xi)
+
+    def sf(x):
+        if xi == 0:
+            return exp(-(x - mu) / s)
+        return exp(-ln(1 + xi * (x - mu) / s) / xi)
+
+    upper = mu - s / xi if xi < 0 else INF
+    return Dist("GPD", f"location={mu}, scale={s}, shape={xi}",
+                lambda x: between(x, mu, upper, lambda x: pow_(sf(x), xi + 1) / s),
+                lambda x: between(x, mu, upper, lambda x: 1 - sf(x), above=ONE), mu, upper)
+
+
+def reverse_weibull(alpha, s, m):
+    a, s, m = D(alpha), D(s), D(m)
+    return Dist("ReverseWeibull", f"shape={a}, scale={s}, location={m}",
+                lambda x: between(x, -INF, m, lambda x: a / s * pow_((m - x) / s, a - 1) * exp(-pow_((m - x) / s, a))),
+                lambda x: between(x, -INF, m, lambda x: exp(-pow_((m - x) / s, a)), above=ONE), -INF, m)
+
+
+def gamma_dist(k, b):
+    k, b = D(k), D(b)
+    return Dist("Gamma", f"shape={k}, rate={b}",
+                lambda x: between(x, 0, INF, lambda x: exp(k * ln(b * x) - b * x - lgamma(k)) / x),
+                lambda x: between(x, 0, INF, lambda x: gamma_p(k, b * x)), 0)
+
+
+def erlang(k, l):
+    d = gamma_dist(k, l)
+    d.name, d.params = "Erlang", f"shape={k}, rate={D(l)}"
+    return d
+
+
+def chi_squared(k):
+    k = D(k)
+    return Dist("ChiSquared", f"dof={k}",
+                lambda x: between(x, 0, INF, lambda x: exp((k / 2 - 1) * ln(x) - x / 2 - k / 2 * ln(2) - lgamma(k / 2))),
+                lambda x: between(x, 0, INF, lambda x: gamma_p(k / 2, x / 2)), 0)
+
+
+def chi(k):
+    k = D(k)
+    return Dist("Chi", f"dof={k}",
+                lambda x: between(x, 0, INF, lambda x: exp((k - 1) * ln(x) - x * x / 2 - (k / 2 - 1) * ln(2) - lgamma(k / 2))),
+                lambda x: between(x, 0, INF, lambda x: gamma_p(k / 2, x * x / 2)), 0)
+
+
+def inverse_gamma(a, b):
+    a, b = D(a), D(b)
+    return Dist("InverseGamma", f"shape={a}, scale={b}",
+                lambda x: between(x, 0, INF, lambda x: exp(a * ln(b) - (a + 1) * ln(x) - b / x - lgamma(a))),
+                lambda x: between(x, 0, INF, lambda x: gamma_q(a, b / x)), 0)
+
+
+# scaled, so that σ² is the scale of the precision and ν σ²/2 that of the inverse gamma it is
+def inverse_chi_squared(v, s2):
+    v, s2 = D(v), D(s2)
+    d = inverse_gamma(v / 2, v * s2 / 2)
+    d.name, d.params = "InverseChiSquared", f"dof={v}, scale={s2}"
+    return d
+
+
+def nakagami(m, w):
+    m, w = D(m), D(w)
+    return Dist("Nakagami", f"shape={m}, spread={w}",
+                lambda x: between(x, 0, INF, lambda x: 2 * exp(m * ln(m / w) + (2 * m - 1) * ln(x) - m * x * x / w - lgamma(m))),
+                lambda x: between(x, 0, INF, lambda x: gamma_p(m, m * x * x / w)), 0)
+
+
+def beta_dist(a, b):
+    a, b = D(a), D(b)
+    return Dist("Beta", f"alpha={a}, beta={b}",
+                lambda x: between(x, 0, 1, lambda x: exp((a - 1) * ln(x) + (b - 1) * ln(1 - x) - lbeta(a, b))),
+                lambda x: between(x, 0, 1, lambda x: beta_inc(x, a, b), above=ONE), 0, 1)
+
+
+def beta_prime(a, b):
+    a, b = D(a), D(b)
+    return Dist("BetaPrime", f"alpha={a}, beta={b}",
+                lambda x: between(x, 0, INF, lambda x: exp((a - 1) * ln(x) - (a + b) * ln(1 + x) - lbeta(a, b))),
+                lambda x: between(x, 0, INF, lambda x: beta_inc(x / (1 + x), a, b)), 0)
+
+
+def student_t(v):
+    v = D(v)
+
+    def cdf(x):
+        tail = beta_inc(v / (v + x * x), v / 2, HALF) / 2
+        return tail if x < 0 else 1 - tail
+
+    return Dist("StudentT", f"dof={v}",
+                lambda x: exp(lgamma((v + 1) / 2) - lgamma(v / 2) - (v + 1) / 2 * ln(1 + x * x / v)) / sqrt(v * PI), cdf)
+
+
+def f_dist(d1, d2):
+    d1, d2 = D(d1), D(d2)
+    return Dist("F", f"d1={d1}, d2={d2}",
+                lambda x: between(x, 0, INF, lambda x: exp((d1 * ln(d1 * x) + d2 * ln(d2) - (d1 + d2) * ln(d1 * x + d2)) / 2 - lbeta(d1 / 2, d2 / 2)) / x),
+                lambda x: between(x, 0, INF, lambda x: beta_inc(d1 * x / (d1 * x + d2), d1 / 2, d2 / 2)), 0)
+
+
+# for 1 < q < 3, the Student t with ν = (3-q)/(q-1) scaled by b √(2/(3-q))
+def q_gaussian(mu, b, q):
+    mu, b, q = D(mu), D(b), D(q)
+    v, s = (3 - q) / (q - 1), b * sqrt(2 / (3 - q))
+    t = student_t(v)
+    return Dist("QGaussian", f"mean={mu}, scale={b}, q={q}",
+                lambda x: t.pdf((x - mu) / s) / s, lambda x: t.cdf((x - mu) / s))
+
+
+def q_exponential(l, q):
+    l, q = D(l), D(q)
+    upper = 1 / (l * (1 - q)) if q < 1 else INF
+    return Dist("QExponential", f"rate={l}, q={q}",
+                lambda x: between(x, 0, upper, lambda x: (2 - q) * l * pow_(1 - (1 - q) * l * x, 1 / (1 - q))),
+                lambda x: between(x, 0, upper, lambda x: 1 - pow_(1 - (1 - q) * l * x, (2 - q) / (1 - q)), above=ONE), 0, upper)
+
+
+def q_weibull(l, k, q):
+    l, k, q = D(l), D(k), D(q)
+    upper = l / pow_(1 - q, 1 / k) if q < 1 else INF
+    return Dist("QWeibull", f"rate={l}, shape={k}, q={q}",
+                lambda x: between(x, 0, upper, lambda x: (2 - q) * k / l * pow_(x / l, k - 1) * pow_(1 - (1 - q) * pow_(x / l, k), 1 / (1 - q))),
+                lambda x: between(x, 0, upper, lambda x: 1 - pow_(1 - (1 - q) * pow_(x / l, k), (2 - q) / (1 - q)), above=ONE), 0, upper)
+
+
+def arcsine():
+    return Dist("Arcsine", "",
+                lambda x: between(x, 0, 1, lambda x: 1 / (PI * sqrt(x * (1 - x)))),
+                lambda x: between(x, 0, 1, lambda x: 2 / PI * asin(sqrt(x)), above=ONE), 0, 1)
+
+
+def irwin_hall(n):
+    from math import comb
+
+    def pdf(x):
+        return sum((-1) ** k * comb(n, k) * (x - k) ** (n - 1) for k in range(int(x) + 1)) / gamma(n)
+
+    def cdf(x):
+        return sum((-1) ** k * comb(n, k) * (x - k) ** n for k in range(int(x) + 1)) / gamma(n + 1)
+
+    return Dist("IrwinHall", f"n={n}", lambda x: between(x, 0, n, pdf),
+                lambda x: between(x, 0, n, cdf, above=ONE), 0, n)
+
+
+# the mean of n standard uniforms, moved onto [a, b]
+def bates(a, b, n):
+    a, b = D(a), D(b)
+    ih = irwin_hall(n)
+    return Dist("Bates", f"a={a}, b={b}, n={n}",
+                lambda x: n / (b - a) * ih.pdf(n * (x - a) / (b - a)),
+                lambda x: ih.cdf(n * (x - a) / (b - a)), a, b)
+
+
+def benini(a, b, s):
+    a, b, s = D(a), D(b), D(s)
+    return Dist("Benini", f"alpha={a}, beta={b}, sigma={s}",
+                lambda x: between(x, s, INF, lambda x: exp(-a * ln(x / s) - b * ln(x / s) ** 2) * (a + 2 * b * ln(x / s)) / x),
+                lambda x: between(x, s, INF, lambda x: 1 - exp(-a * ln(x / s) - b * ln(x / s) ** 2)), s)
+
+
+def benktander_type_1(a, b):
+    a, b = D(a), D(b)
+    return Dist("BenktanderType1", f"a={a}, b={b}",
+                lambda x: between(x, 1, INF, lambda x: ((1 + 2 * b / a * ln(x)) * (1 + a + 2 * b * ln(x)) - 2 * b / a) * pow_(x, -(2 + a + b * ln(x)))),
+                lambda x: between(x, 1, INF, lambda x: 1 - (1 + 2 * b / a * ln(x)) * pow_(x, -(a + 1 + b * ln(x)))), 1)
+
+
+def benktander_type_2(a, b):
+    a, b = D(a), D(b)
+    return Dist("BenktanderType2", f"a={a}, b={b}",
+                lambda x: between(x, 1, INF, lambda x: exp(a / b * (1 - pow_(x, b))) * pow_(x, b - 2) * (a * pow_(x, b) - b + 1)),
+                lambda x: between(x, 1, INF, lambda x: 1 - pow_(x, b - 1) * exp(a / b * (1 - pow_(x, b)))), 1)
+
+
+# γ multiplies x, so it is the reciprocal of the textbook scale β
+def birnbaum_saunders(a, g):
+    a, g = D(a), D(g)
+    z = lambda x: (x * g - 1) / (a * sqrt(x * g))
+    return Dist("BirnbaumSaunders", f"shape={a}, scale={g}",
+                lambda x: between(x, 0, INF, lambda x: (x * g + 1) / (2 * a * x * sqrt(x * g)) * normal_pdf(z(x))),
+                lambda x: between(x, 0, INF, lambda x: normal_cdf(z(x))), 0)
+
+
+def gb1(a, b, p, q):
+    a, b, p, q = D(a), D(b), D(p), D(q)
+    return Dist("GB1", f"alpha={a}, beta={b}, p={p}, q={q}",
+                lambda x: between(x, 0, b, lambda x: abs(a) * exp((a * p - 1) * ln(x) + (q - 1) * ln(1 - pow_(x / b, a)) - a * p * ln(b) - lbeta(p, q))),
+                lambda x: between(x, 0, b, lambda x: beta_inc(pow_(x / b, a), p, q), above=ONE), 0, b)
+
+
+def gb2(a, b, p, q):
+    a, b, p, q = D(a), D(b), D(p), D(q)
+    return Dist("GB2", f"alpha={a}, beta={b}, p={p}, q={q}",
+                lambda x: between(x, 0, INF, lambda x: abs(a) * exp((a * p - 1) * ln(x) - a * p * ln(b) - (p + q) * ln(1 + pow_(x / b, a)) - lbeta(p, q))),
+                lambda x: between(x, 0, INF, lambda x: beta_inc(pow_(x / b, a) / (1 + pow_(x / b, a)), p, q)), 0)
+
+
+def inverse_gaussian(mu, l):
+    mu, l = D(mu), D(l)
+    return Dist("InverseGaussian", f"mean={mu}, shape={l}",
+                lambda x: between(x, 0, INF, lambda x: sqrt(l / (2 * PI * x ** 3)) * exp(-l * (x - mu) ** 2 / (2 * mu * mu * x))),
+                lambda x: between(x, 0, INF, lambda x: normal_cdf(sqrt(l / x) * (x / mu - 1)) + exp(2 * l / mu) * normal_cdf(-sqrt(l / x) * (x / mu + 1))), 0)
+
+
+def johnson(kind, g, d, xi, l):
+    g, d, xi, l = D(g), D(d), D(xi), D(l)
+    # the transform to a standard normal, and its derivative
+    t, dt, lower = {
+        "SL": (lambda z: ln(z), lambda z: 1 / z, xi),
+        "SN": (lambda z: z, lambda z: ONE, -INF),
+        "SU": (asinh, lambda z: 1 / sqrt(1 + z * z), -INF),
+    }[kind]
+    return Dist("Johnson" + kind, f"gamma={g}, delta={d}, location={xi}, scale={l}",
+                lambda x: between(x, lower, INF, lambda x: d / l * dt((x - xi) / l) * normal_pdf(g + d * t((x - xi) / l))),
+                lambda x: between(x, lower, INF, lambda x: normal_cdf(g + d * t((x - xi) / l))), lower)
+
+
+def pert(a, c, b, shape=4):
+    a, c, b, shape = D(a), D(c), D(b), D(shape)
+    p, q = 1 + shape * (b - a) / (c - a), 1 + shape * (c - b) / (c - a)
+    return Dist("PERT", f"min={a}, max={c}, mode={b}",
+                lambda x: between(x, a, c, lambda x: exp((p - 1) * ln(x - a) + (q - 1) * ln(c - x) - (p + q - 1) * ln(c - a) - lbeta(p, q))),
+                lambda x: between(x, a, c, lambda x: beta_inc((x - a) / (c - a), p, q), above=ONE), a, c)
+
+
+def modified_pert(a, c, b, shape):
+    d = pert(a, c, b, shape)
+    d.name, d.params = "ModifiedPERT", f"{d.params}, shape={D(shape)}"
+    return d
+
+
+def pareto_bounded(lo, hi, a):
+    lo, hi, a = D(lo), D(hi), D(a)
+    return Dist("ParetoBounded", f"min={lo}, max={hi}, shape={a}",
+                lambda x: between(x, lo, hi, lambda x: a * pow_(lo, a) * pow_(x, -a - 1) / (1 - pow_(lo / hi, a))),
+                lambda x: between(x, lo, hi, lambda x: (1 - pow_(lo, a) * pow_(x, -a)) / (1 - pow_(lo / hi, a)), above=ONE), lo, hi)
+
+
+# the Poisson(λ/2) mixture of χ²(k+2j)
+def non_central_chi_squared(k, l):
+    k, l = D(k), D(l)
+    ws = poisson(l / 2)
+
+    def pdf(x):
+        return sum(w * exp((k / 2 + j - 1) * ln(x) - x / 2 - (k / 2 + j) * ln(2) - lgamma(k / 2 + j)) for j, w in enumerate(ws))
+
+    return Dist("NonCentralChiSquared", f"dof={k}, lambda={l}",
+                lambda x: between(x, 0, INF, pdf),
+                lambda x: between(x, 0, INF, lambda x: gamma_mixture(ws, k / 2, x / 2)), 0)
+
+
+# whose square is χ'²(k, λ²)
+def non_central_chi(k, l):
+    k, l = D(k), D(l)
+    sq = non_central_chi_squared(k, l * l)
+    return Dist("NonCentralChi", f"dof={k}, lambda={l}",
+                lambda x: between(x, 0, INF, lambda x: 2 * x * sq.pdf(x * x)),
+                lambda x: between(x, 0, INF, lambda x: sq.cdf(x * x)), 0)
+
+
+# the Poisson(λ/2) mixture of Beta(α+j, β)
+def non_central_beta(a, b, l):
+    a, b, l = D(a), D(b), D(l)
+    ws = poisson(l / 2)
+
+    def pdf(x):
+        return sum(w * exp((a + j - 1) * ln(x) + (b - 1) * ln(1 - x) - lbeta(a + j, b)) for j, w in enumerate(ws))
+
+    return Dist("NonCentralBeta", f"alpha={a}, beta={b}, lambda={l}",
+                lambda x: between(x, 0, 1, pdf),
+                lambda x: between(x, 0, 1, lambda x: beta_mixture(ws, x, a, b), above=ONE), 0, 1)
+
+
+# the Poisson(λ) mixture of Gamma(k+j) with scale θ, as in the paper non_central_gamma.go cites
+def non_central_gamma(k, s, l):
+    k, s, l = D(k), D(s), D(l)
+    ws = poisson(l)
+
+    def pdf(x):
+        return sum(w * exp((k + j - 1) * ln(x / s) - x / s - lgamma(k + j)) for j, w in enumerate(ws)) / s
+
+    return Dist("NonCentralGamma", f"shape={k}, scale={s}, lambda={l}",
+                lambda x: between(x, 0, INF, pdf),
+                lambda x: between(x, 0, INF, lambda x: gamma_mixture(ws, k, x / s)), 0)
+
+
+def non_central_t(v, mu):
+    v, mu = D(v), D(mu)
+
+    # for x ≥ 0, Φ(-μ) plus ½ Σ (pⱼ Iᵧ(j+½, ν/2) + qⱼ Iᵧ(j+1, ν/2)) over y = x²/(x²+ν)
+    def upper(x, mu):
+        y = x * x / (x * x + v)
+        ws = poisson(mu * mu / 2)
+        qs = [w * mu * exp(lgamma(j + 1) - lgamma(j + D("1.5"))) / sqrt(2) for j, w in enumerate(ws)]
+        return normal_cdf(-mu) + (beta_mixture(ws, y, HALF, v / 2) + beta_mixture(qs, y, ONE, v / 2)) / 2
+
+    def cdf(x):
+        with localcontext() as ctx:
+            ctx.prec = 90
+            r = upper(x, mu) if x >= 0 else 1 - upper(-x, -mu)
+        return +r
+
+    # from the cdfs at ν and ν+2
+    def pdf(x):
+        if x == 0:
+            return exp(lgamma((v + 1) / 2) - lgamma(v / 2) - mu * mu / 2) / sqrt(v * PI)
+        with localcontext() as ctx:
+            ctx.prec = 90
+            r = v / x * (non_central_t(v + 2, mu).cdf(x * sqrt(1 + 2 / v)) - cdf(x))
+        return +r
+
+    return Dist("NonCentralT", f"dof={v}, lambda={mu}", pdf, cdf)
+
+
+def rice(v, s):
+    v, s = D(v), D(s)
+    sq = non_central_chi_squared(2, (v / s) ** 2)
+    return Dist("Rice", f"distance={v}, spread={s}",
+                lambda x: between(x, 0, INF, lambda x: x / (s * s) * exp(-(x * x + v * v) / (2 * s * s)) * bessel_i(0, x * v / (s * s))),
+                lambda x: between(x, 0, INF, lambda x: sq.cdf((x / s) ** 2)), 0)
+
+
+def circular(name, params, mu, coefficients, lower=-PI):
+    mu, lower = D(mu), D(lower)
+
+    def pdf(x):
+        return (1 + 2 * sum(c * cos(j * (x - mu)) for j, c in enumerate(coefficients, 1))) / (2 * PI)
+
+    return Dist(name, params, lambda x: between(x, lower, lower + 2 * PI, pdf),
+                lambda x: between(x, lower, lower + 2 * PI, lambda x: circular_cdf(x, mu, lower, coefficients), above=ONE),
+                lower, lower + 2 * PI)
+
+
+def von_mises(mu, k):
+    mu, k = D(mu), D(k)
+    i0, cs, j = bessel_i(0, k), [], 1
+    while not cs or cs[-1] > D(10) ** -70:
+        cs.append(bessel_i(j, k) / i0)
+        j += 1
+    return circular("VonMises", f"mean={mu}, concentration={k}", mu, cs)
+
+
+# Cauchy(x₀, γ) wrapped onto [-π, π] is the wrapped Cauchy with ρ = e^-γ, whatever the number of windings
+def wrapped_cauchy(x0, g, k):
+    x0, g = D(x0), D(g)
+    r, cs = exp(-g), []
+    while not cs or cs[-1] > D(10) ** -70:
+        cs.append(r ** (len(cs) + 1))
+    return circular("Wrapped", f"Cauchy(location={x0}, scale={g}), k={k}", x0, cs)
+
+
+def truncated_normal(mu, s, a, b):
+    mu, s, a, b = D(mu), D(s), D(a), D(b)
+    n = normal(mu, s)
+    z = n.cdf(b) - n.cdf(a)
+    return Dist("Truncated", f"Normal(location={mu}, scale={s}), min={a}, max={b}",
+                lambda x: between(x, a, b, lambda x: n.pdf(x) / z),
+                lambda x: between(x, a, b, lambda x: (n.cdf(x) - n.cdf(a)) / z, above=ONE), a, b)
+
+
+DISTS = [
+    (normal(0, 1), ["-8", "-3", "-0.5", "0", "1.25", "4"]),
+    (normal("1.5", "0.25"), ["0.5", "1.4", "2"]),
+    (log_normal("0.5", "0.75"), ["0.05", "1", "1.6487212707", "10"]),
+    (exponential("0.25"), ["0.001", "1", "4", "40"]),
+    (weibull(2, "1.5"), ["0.01", "1", "2", "7"]),
+    (gumbel(1, 2), ["-4", "0", "1", "12"]),
+    (logistic(1, 2), ["-20", "0", "1", "8"]),
+    (cauchy(1, 2), ["-100", "0", "1", "3", "1000"]),
+    (laplace(1, 2), ["-15", "0.5", "1", "4"]),
+    (rayleigh(2), ["0.01", "1", "2", "9"]),
+    (maxwell(2), ["0.1", "1", "3.2", "10"]),
+    (pareto(3, 2), ["2.001", "3", "10", "100"]),
+    (pareto_type_2(2, 3, 1), ["1.001", "2", "5", "50"]),
+    (uniform(-1, 3), ["-1", "0", "2.5"]),
+    (triangular(1, 5, 2), ["1.1", "2", "3.5", "4.99"]),
+    (arcsine_bounded(-1, 3), ["-0.999", "0", "1", "2.5"]),
+    (kumaraswamy(2, 5), ["0.01", "0.25", "0.5", "0.95"]),
+    (log_logistic(2, 3, 1), ["1.01", "2", "3", "20"]),
+    (frechet(3, 2, 1), ["1.5", "2", "3", "30"]),
+    (burr(2, 3, "1.5"), ["0.01", "0.5", "1", "6"]),
+    (dagum(2, 3, "1.5"), ["0.1", "1", "2", "20"]),
+    (levy(1, 2), ["1.1", "2", "5", "100"]),
+    (hyperbolic_secant(), ["-6", "-1", "0", "0.5", "3"]),
+    (raised_cosine(1, 2), ["-0.9", "0", "1", "2.5"]),
+    (wigner(2, 1), ["-0.99", "0", "1.5", "2.9"]),
+    (gompertz("0.5", 2), ["0.01", "0.5", "1", "2"]),
+    (shifted_gompertz("0.5", 2), ["0.01", "1", "3", "15"]),
+    (asymmetric_laplace(1, 2, "0.5"), ["-2", "0.5", "1", "2", "6"]),
+    (gev(1, 2, "0.2"), ["-3", "0", "1", "3", "40"]),
+    (gev(1, 2, "-0.3"), ["-6", "0", "1", "3", "7.6"]),
+    (gev(1, 2, "1e-10"), ["-4", "0", "1", "12"]),
+    (gpd(1, 2, "0.2"), ["1.001", "2", "5", "100"]),
+    (gpd(1, 2, "-0.3"), ["1.001", "2", "5", "7.6"]),
+    (gpd(0, 1, "-1e-9"), ["0.001", "1", "4", "40"]),
+    (reverse_weibull(3, 2, 1), ["-4", "-1", "0", "0.99"]),
+    (gamma_dist(2, "0.5"), ["0.01", "1", "4", "30"]),
+    (gamma_dist("0.5", 2), ["1e-4", "0.1", "1", "8"]),
+    (erlang(3, "0.5"), ["0.05", "2", "6", "40"]),
+    (chi_squared(4), ["0.01", "1", "4", "30"]),
+    (chi(3), ["0.05", "1", "1.6", "6"]),
+    (inverse_gamma(3, 2), ["0.05", "0.5", "1", "20"]),
+    (inverse_chi_squared(5, 2), ["0.2", "1", "2", "40"]),
+    (nakagami("1.5", 2), ["0.05", "1", "1.5", "5"]),
+    (beta_dist(2, 3), ["0.001", "0.25", "0.5", "0.99"]),
+    (beta_dist("0.5", "0.5"), ["0.01", "0.3", "0.9"]),
+    (beta_prime(2, 3), ["0.01", "0.5", "2", "50"]),
+    (student_t(5), ["-40", "-2", "0", "0.5", "3"]),
+    (student_t("1.5"), ["-100", "-1", "0.25", "10"]),
+    (f_dist(5, 7), ["0.01", "0.5", "1", "3", "40"]),
+    (q_gaussian(1, 2, "1.5"), ["-20", "0", "1", "4"]),
+    (q_exponential(2, "1.5"), ["0.01", "0.5", "2", "50"]),
+    (q_exponential(2, "0.5"), ["0.01", "0.3", "0.9"]),
+    (q_weibull(2, "1.5", "1.5"), ["0.01", "1", "3", "30"]),
+    (q_weibull(2, "1.5", "0.5"), ["0.01", "1", "2", "3"]),
+    (arcsine(), ["0.001", "0.25", "0.5", "0.9"]),
+    (irwin_hall(5), ["0.1", "1.5", "2.5", "4.2"]),
+    (bates(-1, 2, 4), ["-0.9", "0", "0.5", "1.8"]),
+    (benini(2, 3, "1.5"), ["1.51", "2", "3", "6"]),
+    (benktander_type_1(2, 3), ["1.01", "1.5", "2", "5"]),
+    (benktander_type_2(2, "0.5"), ["1.01", "1.5", "3", "10"]),
+    (birnbaum_saunders("0.5", 2), ["0.1", "0.5", "1", "3"]),
+    (gb1(2, 3, 2, 3), ["0.05", "1", "1.5", "2.9"]),
+    (gb2(2, 3, 2, 3), ["0.05", "1", "3", "20"]),
+    (inverse_gaussian(2, 3), ["0.05", "1", "2", "15"]),
+    (johnson("SL", 1, 2, 1, 2), ["1.1", "2", "3", "10"]),
+    (johnson("SN", 1, 2, 1, 2), ["-4", "0", "1", "3"]),
+    (johnson("SU", 1, 2, 1, 2), ["-20", "-1", "0", "1", "5"]),
+    (pert(1, 5, 2), ["1.1", "2", "3.5", "4.9"]),
+    (modified_pert(1, 5, 2, 3), ["1.1", "2", "3.5", "4.9"]),
+    (pareto_bounded(1, 4, 2), ["1.001", "1.5", "2", "3.9"]),
+    (non_central_chi_squared(3, "1.5"), ["0.01", "1", "4", "30"]),
+    (non_central_chi(3, "1.5"), ["0.1", "1", "2", "6"]),
+    (non_central_beta(2, 3, "1.5"), ["0.01", "0.25", "0.5", "0.95"]),
+    (non_central_gamma(2, 3, "1.5"), ["0.05", "2", "10", "60"]),
+    (non_central_t(5, "1.5"), ["-3", "0", "1", "2.5", "12"]),
+    (rice(1, 2), ["0.05", "1", "3", "10"]),
+    (von_mises("0.5", 2), ["-3", "-1", "0.5", "2", "3.1"]),
+    (wrapped_cauchy("0.5", 1, 100), ["-3", "-1", "0.5", "2", "3.1"]),
+    (truncated_normal(0, 1, -2, 2), ["-1.99", "-1", "0", "1.5"]),
+]
+
+PROBABILITIES = ["1e-6", "0.001", "0.1", "0.5", "0.9", "0.999"]
+
+
+def fmt(v):
+    return f"{v:.21g}"
+
+
+def main():
+    w = csv.writer(sys.stdout, lineterminator="\n")
+    w.writerow(["dist", "params", "x", "pdf", "cdf", "p", "quantile"])
+    for d, xs in DISTS:
+        for x in xs:
+            x = D(x)
+            w.writerow([d.name, d.params, x, fmt(d.pdf(x)), fmt(d.cdf(x)), "", ""])
+        for p in PROBABILITIES:
+            w.writerow([d.name, d.params, "", "", "", p, fmt(d.quantile(p))])
+
+
+if __name__ == "__main__":
+    main()
@@ -2,7 +2,6 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -19,11 +18,44 @@ func NewTriangular(min, max, mode float64) (*Triangular, error) {
 }
 
 func NewTriangularWithSource(min, max, mode float64, src rand.Source) (*Triangular, error) {
-	if min >= max || mode > min || mode > max {
-		return nil, err.Invalid()
+	r := &Triangular{min, max, mode, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Triangular{min, max, mode, src}, nil
+	return r, nil
+}
+
+func (t *Triangular) spec() (string, []Common, []float64) {
+	return "Triangular", nil, []float64{t.min, t.max, t.mode}
+}
+
+func (t *Triangular) String() string {
+	return specString(t.spec())
+}
+
+func (t *Triangular) MarshalJSON() ([]byte, error) {
+	return marshalJSON(t)
+}
+
+func (t *Triangular) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, t, t.src)
+}
+
+func (t *Triangular) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *Triangular) UnmarshalText(text []byte) error {
+	return unmarshalText(text, t, t.src)
+}
+
+func (t *Triangular) ParameterValues() map[string]float64 {
+	return parameterValues(t)
+}
+
+func (t *Triangular) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(t, params, t.src)
 }
 
 // a ∈ (-∞,∞)
@@ -126,6 +158,24 @@ func (t *Triangular) Inverse(p float64) float64 {
 
 }
 
+func (t *Triangular) InverseSurvival(q float64) float64 {
+	f := (t.max - t.mode) / (t.max - t.min)
+
+	if 0 <= q && q <= f {
+		return t.max - math.Sqrt(q*(t.max-t.min)*(t.max-t.mode))
+	}
+
+	if 1 >= q && q > f {
+		return t.min + math.Sqrt((1-q)*(t.max-t.min)*(t.mode-t.min))
+	}
+
+	if q < 0 {
+		return t.max
+	}
+
+	return t.min
+}
+
 func (t *Triangular) Rand() float64 {
 	var rnd float64
 	if t.src == nil {
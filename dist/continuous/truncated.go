@@ -1,6 +1,7 @@
 package continuous
 
 import (
+	"fmt"
 	"github.com/jtejido/stats"
 	"github.com/jtejido/stats/err"
 	"math"
@@ -20,7 +21,7 @@ func NewTruncated(dist Truncatable, min, max float64) (*Truncated, error) {
 }
 
 func NewTruncatedWithSource(dist Truncatable, min, max float64, src rand.Source) (*Truncated, error) {
-	if dist == nil || max < min {
+	if dist == nil {
 		return nil, err.Invalid()
 	}
 
@@ -30,9 +31,51 @@ func NewTruncatedWithSource(dist Truncatable, min, max float64, src rand.Source)
 	ret.max = max
 	ret.src = src
 
+	if e := validate(ret); e != nil {
+		return nil, e
+	}
+
+	// F(b) - F(a) divides the density and the cdf, and must not vanish, as it does where (a,b] misses the
+	// support of dist or holds less than the rounding of F
+	if ret.Support().IsEmpty() || !(dist.Distribution(max) > dist.Distribution(min)) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("Truncated: (%v, %v] holds no probability of %v", min, max, dist))
+	}
+
 	return ret, nil
 }
 
+func (t *Truncated) spec() (string, []Common, []float64) {
+	return "Truncated", []Common{t.dist}, []float64{t.min, t.max}
+}
+
+func (t *Truncated) String() string {
+	return specString(t.spec())
+}
+
+func (t *Truncated) MarshalJSON() ([]byte, error) {
+	return marshalJSON(t)
+}
+
+func (t *Truncated) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, t, t.src)
+}
+
+func (t *Truncated) MarshalText() ([]byte, error) {
+	return marshalText(t)
+}
+
+func (t *Truncated) UnmarshalText(text []byte) error {
+	return unmarshalText(text, t, t.src)
+}
+
+func (t *Truncated) ParameterValues() map[string]float64 {
+	return parameterValues(t)
+}
+
+func (t *Truncated) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(t, params, t.src)
+}
+
 // a ∈ (-∞,∞)
 // b ∈ (a,∞)
 func (t *Truncated) Parameters() stats.Limits {
@@ -42,9 +85,14 @@ func (t *Truncated) Parameters() stats.Limits {
 	}
 }
 
-// x ∈ (a,b]
+// x ∈ (a,b], within the support of the truncated distribution when it declares one
 func (t *Truncated) Support() stats.Interval {
-	return stats.Interval{t.min, t.max, true, false}
+	s := stats.Interval{t.min, t.max, true, false}
+	if d, ok := t.dist.(interface{ Support() stats.Interval }); ok {
+		s = s.Intersect(d.Support())
+	}
+
+	return s
 }
 
 func (t *Truncated) Probability(x float64) float64 {
@@ -56,23 +104,41 @@ func (t *Truncated) Probability(x float64) float64 {
 }
 
 func (t *Truncated) Distribution(x float64) float64 {
-	if t.Support().IsWithinInterval(x) {
-		return (t.dist.Distribution(x) - t.dist.Distribution(t.min)) / (t.dist.Distribution(t.max) - t.dist.Distribution(t.min))
-	}
-
-	return 0
+	// 0 below the support and 1 above it
+	x = t.Support().Closure().Clamp(x)
+	return (t.dist.Distribution(x) - t.dist.Distribution(t.min)) / (t.dist.Distribution(t.max) - t.dist.Distribution(t.min))
 }
 
 func (t *Truncated) Inverse(q float64) float64 {
 	if q <= 0 {
-		return t.min
+		return t.Support().Lower
+	}
+
+	if q >= 1 {
+		return t.Support().Upper
 	}
 
+	lo, hi := t.dist.Distribution(t.min), t.dist.Distribution(t.max)
+	return t.Support().Closure().Clamp(t.dist.Inverse(lo + q*(hi-lo)))
+}
+
+func (t *Truncated) InverseSurvival(q float64) float64 {
 	if q >= 1 {
-		return t.max
+		return t.Support().Lower
+	}
+
+	if q <= 0 {
+		return t.Support().Upper
+	}
+
+	lo, hi := t.dist.Distribution(t.min), t.dist.Distribution(t.max)
+	if d, ok := t.dist.(interface{ InverseSurvival(float64) float64 }); ok {
+		// in the upper tail of the base, where 1 - F(max) is exactly 0 for an unbounded max and q keeps all
+		// of its digits
+		return t.Support().Closure().Clamp(d.InverseSurvival((1 - hi) + q*(hi-lo)))
 	}
 
-	return t.dist.Inverse(t.dist.Distribution(t.min) + q*t.dist.Distribution(t.max) - t.dist.Distribution(t.min))
+	return t.Support().Closure().Clamp(t.dist.Inverse(hi - q*(hi-lo)))
 }
 
 func (t *Truncated) Rand() float64 {
@@ -0,0 +1,88 @@
+package continuous
+
+import (
+	"github.com/jtejido/stats"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestTruncatedSupport(t *testing.T) {
+	n, _ := NewNormal(0, 1)
+	e, _ := NewExponential(1)
+
+	cases := []struct {
+		dist     Truncatable
+		min, max float64
+		expected stats.Interval
+	}{
+		{n, -2, 2, stats.Interval{-2, 2, true, false}},
+		{e, -1, 2, stats.Interval{0, 2, false, false}},
+		{e, math.Inf(-1), math.Inf(1), stats.Interval{0, math.Inf(1), false, true}},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			tr, _ := NewTruncated(c.dist, c.min, c.max)
+			if res := tr.Support(); !res.Equal(c.expected) {
+				t.Errorf("Mismatch. Case %d, want: %v, got: %v", i, c.expected, res)
+			}
+		})
+	}
+}
+
+func TestTruncatedDistribution(t *testing.T) {
+	n, _ := NewNormal(0, 1)
+	tr, _ := NewTruncated(n, -1, 2)
+	z := n.Distribution(2) - n.Distribution(-1)
+
+	cases := []struct {
+		x, expected float64
+	}{
+		{-3, 0},
+		{-1, 0},
+		{0, (.5 - n.Distribution(-1)) / z},
+		{2, 1},
+		{5, 1},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			run_test(t, tr.Distribution(c.x), c.expected, 1e-15, "Truncated.Distribution")
+		})
+	}
+
+	e, _ := NewExponential(1)
+	te, _ := NewTruncated(e, -1, 2)
+	if res := te.Inverse(0); res != 0 {
+		t.Errorf("Mismatch. Inverse(0) want: 0, got: %v", res)
+	}
+}
+
+// A range holding no probability would leave F(b) - F(a) = 0 to divide by.
+func TestTruncatedErrors(t *testing.T) {
+	n, _ := NewNormal(0, 1)
+	e, _ := NewExponential(1)
+
+	cases := []struct {
+		dist     Truncatable
+		min, max float64
+	}{
+		{e, -5, -1},   // below the support
+		{e, -5, 0},    // touching it only where the mass is 0
+		{n, 50, 60},   // where F rounds to 1 at both ends
+		{n, -60, -50}, // and to 0
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			if tr, e := NewTruncated(c.dist, c.min, c.max); e == nil {
+				t.Errorf("Mismatch. Truncated(%v, %v, %v) want: error, got: %v", c.dist, c.min, c.max, tr)
+			}
+		})
+	}
+
+	if d, e := Parse("Truncated(Exponential(1), -5, -1)"); e == nil {
+		t.Errorf("Mismatch. Parse want: error, got: %v", d)
+	}
+}
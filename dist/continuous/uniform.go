@@ -2,8 +2,8 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
+	"math/cmplx"
 	"math/rand"
 )
 
@@ -19,11 +19,44 @@ func NewUniform(min, max float64) (*Uniform, error) {
 }
 
 func NewUniformWithSource(min, max float64, src rand.Source) (*Uniform, error) {
-	if min >= max {
-		return nil, err.Invalid()
+	r := &Uniform{min, max, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Uniform{min, max, src}, nil
+	return r, nil
+}
+
+func (u *Uniform) spec() (string, []Common, []float64) {
+	return "Uniform", nil, []float64{u.min, u.max}
+}
+
+func (u *Uniform) String() string {
+	return specString(u.spec())
+}
+
+func (u *Uniform) MarshalJSON() ([]byte, error) {
+	return marshalJSON(u)
+}
+
+func (u *Uniform) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, u, u.src)
+}
+
+func (u *Uniform) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *Uniform) UnmarshalText(text []byte) error {
+	return unmarshalText(text, u, u.src)
+}
+
+func (u *Uniform) ParameterValues() map[string]float64 {
+	return parameterValues(u)
+}
+
+func (u *Uniform) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(u, params, u.src)
 }
 
 // a ∈ (-∞,∞)
@@ -84,6 +117,18 @@ func (u *Uniform) Inverse(p float64) float64 {
 	return p*(u.max-u.min) + u.min
 }
 
+func (u *Uniform) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return u.min
+	}
+
+	if q <= 0 {
+		return u.max
+	}
+
+	return u.max - q*(u.max-u.min)
+}
+
 func (u *Uniform) Mean() float64 {
 	return (u.min + u.max) / 2
 }
@@ -100,6 +145,15 @@ func (u *Uniform) Variance() float64 {
 	return ((u.max - u.min) * (u.max - u.min)) / 12.
 }
 
+// (e^{itb} - e^{ita})/it(b - a)
+func (u *Uniform) CharacteristicFunction(t float64) complex128 {
+	if t == 0 {
+		return 1
+	}
+
+	return (cmplx.Exp(complex(0, t*u.max)) - cmplx.Exp(complex(0, t*u.min))) / complex(0, t*(u.max-u.min))
+}
+
 func (u *Uniform) Rand() float64 {
 	var rnd float64
 	if u.src == nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
+	"math/cmplx"
 	"math/rand"
 )
 
@@ -23,28 +24,59 @@ func NewVonMises(mean, concentration float64, support stats.Interval) (*VonMises
 }
 
 func NewVonMisesWithSource(mean, concentration float64, support stats.Interval, src rand.Source) (*VonMises, error) {
-	if support.Lower >= support.Upper {
-		return nil, err.Error("lower cannot be greater than or equal upper", err.EINVAL)
+	if support.IsEmpty() {
+		return nil, err.Error("support cannot be empty", err.EINVAL)
 	}
 
 	if !support.IsEqualLength(defaultLength) {
 		return nil, err.Error("length not equals 2π", err.EINVAL)
 	}
 
-	if !support.IsWithinInterval(mean) {
-		return nil, err.Error("mean is not within support range", err.EINVAL)
+	r := &VonMises{mean, concentration, support, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	if concentration <= 0 {
-		return nil, err.Error("concentration should be greater than 0", err.EINVAL)
-	}
+	return r, nil
+}
+
+func (vm *VonMises) spec() (string, []Common, []float64) {
+	return "VonMises", nil, []float64{vm.mean, vm.concentration, vm.support.Lower}
+}
+
+func (vm *VonMises) String() string {
+	return specString(vm.spec())
+}
+
+func (vm *VonMises) MarshalJSON() ([]byte, error) {
+	return marshalJSON(vm)
+}
+
+func (vm *VonMises) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, vm, vm.src)
+}
 
-	return &VonMises{mean, concentration, support, src}, nil
+func (vm *VonMises) MarshalText() ([]byte, error) {
+	return []byte(vm.String()), nil
 }
 
+func (vm *VonMises) UnmarshalText(text []byte) error {
+	return unmarshalText(text, vm, vm.src)
+}
+
+func (vm *VonMises) ParameterValues() map[string]float64 {
+	return parameterValues(vm)
+}
+
+func (vm *VonMises) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(vm, params, vm.src)
+}
+
+// μ ∈ support
 // κ ∈ (0,∞)
 func (vm *VonMises) Parameters() stats.Limits {
 	return stats.Limits{
+		"μ": vm.support,
 		"κ": stats.Interval{0, math.Inf(1), true, true},
 	}
 }
@@ -55,8 +87,7 @@ func (vm *VonMises) Support() stats.Interval {
 }
 
 func (vm *VonMises) Probability(x float64) float64 {
-	sup := vm.Support()
-	if x <= vm.mean+sup.Lower || x > vm.mean+sup.Upper {
+	if !vm.Support().IsWithinInterval(x) {
 		return 0
 	}
 
@@ -65,6 +96,10 @@ func (vm *VonMises) Probability(x float64) float64 {
 }
 
 func (vm *VonMises) Distribution(x float64) float64 {
+	if x >= vm.Support().Upper {
+		return 1
+	}
+
 	if vm.Support().IsWithinInterval(x) {
 		sup := vm.Support()
 		val := smath.Bessel_i0_inc(x-vm.mean, vm.concentration) - smath.Bessel_i0_inc(sup.Lower-vm.mean, vm.concentration)
@@ -78,6 +113,33 @@ func (vm *VonMises) Distribution(x float64) float64 {
 	return 0
 }
 
+func (vm *VonMises) Inverse(p float64) float64 {
+	sup := vm.Support()
+	if p <= 0 {
+		return sup.Lower
+	}
+
+	if p >= 1 {
+		return sup.Upper
+	}
+
+	return inverse(nil, vm.Distribution, vm.Probability, sup.Lower, sup.Upper, sup.Lower+2*math.Pi*p, p)
+}
+
+func (vm *VonMises) InverseSurvival(q float64) float64 {
+	sup := vm.Support()
+	if q >= 1 {
+		return sup.Lower
+	}
+
+	if q <= 0 {
+		return sup.Upper
+	}
+
+	sf := func(x float64) float64 { return arcSurvival(vm.Distribution, vm.Probability, x, sup.Upper) }
+	return inverseSurvival(nil, sf, vm.Probability, sup.Lower, sup.Upper, sup.Upper-2*math.Pi*q, q)
+}
+
 func (vm *VonMises) CircularMean() float64 {
 	return vm.mean
 }
@@ -95,7 +157,24 @@ func (vm *VonMises) Entropy() float64 {
 }
 
 func (vm *VonMises) CircularVariance() float64 {
-	return 1 - specfunc.Bessel_I1(vm.concentration)/specfunc.Bessel_I0(vm.concentration)
+	return 1 - vm.ResultantLength()
+}
+
+// I₁(κ)/I₀(κ)
+func (vm *VonMises) ResultantLength() float64 {
+	return specfunc.Bessel_I1(vm.concentration) / specfunc.Bessel_I0(vm.concentration)
+}
+
+func (vm *VonMises) CircularStdDev() float64 {
+	return math.Sqrt(-2 * math.Log(vm.ResultantLength()))
+}
+
+// φₚ = I|ₚ|(κ)/I₀(κ) e^{ipμ}
+func (vm *VonMises) TrigonometricMoment(p int) complex128 {
+	fp := float64(p)
+	r := specfunc.Bessel_Inu(math.Abs(fp), vm.concentration) / specfunc.Bessel_I0(vm.concentration)
+
+	return complex(r, 0) * cmplx.Exp(complex(0, fp*vm.mean))
 }
 
 func (vm *VonMises) Rand() float64 {
@@ -1,6 +1,7 @@
 package continuous
 
 import (
+	"fmt"
 	"github.com/jtejido/stats"
 	"math"
 	"strconv"
@@ -52,3 +53,23 @@ func TestVonMisesDistribution(t *testing.T) {
 		})
 	}
 }
+
+func TestVonMisesInverseDistribution(t *testing.T) {
+	tol := 0.0000001
+	cases := []struct {
+		μ, κ    float64
+		support stats.Interval
+	}{
+		{0, 1, DefaultCircularSupport},
+		{1, 3, DefaultCircularSupport},
+		{2, 5.86, stats.Interval{0, 2 * math.Pi, false, false}},
+	}
+
+	for _, c := range cases {
+		for _, p := range []float64{.001, .1, .5, .9, .999} {
+			b, _ := NewVonMises(c.μ, c.κ, c.support)
+			res := b.Distribution(b.Inverse(p))
+			run_test(t, res, p, tol, fmt.Sprintf("VonMisesInverseDistribution(%v, %v, %v)", c.μ, c.κ, p))
+		}
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"github.com/jtejido/ggsl/specfunc"
 	"github.com/jtejido/linear"
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -23,11 +22,44 @@ func NewWeibull(scale, shape float64) (*Weibull, error) {
 }
 
 func NewWeibullWithSource(scale, shape float64, src rand.Source) (*Weibull, error) {
-	if scale <= 0 || shape <= 0 {
-		return nil, err.Invalid()
+	r := &Weibull{scale, shape, src, nil}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &Weibull{scale, shape, src, nil}, nil
+	return r, nil
+}
+
+func (w *Weibull) spec() (string, []Common, []float64) {
+	return "Weibull", nil, []float64{w.scale, w.shape}
+}
+
+func (w *Weibull) String() string {
+	return specString(w.spec())
+}
+
+func (w *Weibull) MarshalJSON() ([]byte, error) {
+	return marshalJSON(w)
+}
+
+func (w *Weibull) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, w, w.src)
+}
+
+func (w *Weibull) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+func (w *Weibull) UnmarshalText(text []byte) error {
+	return unmarshalText(text, w, w.src)
+}
+
+func (w *Weibull) ParameterValues() map[string]float64 {
+	return parameterValues(w)
+}
+
+func (w *Weibull) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(w, params, w.src)
 }
 
 // λ ∈ (0,∞)
@@ -90,6 +122,18 @@ func (w *Weibull) Inverse(p float64) float64 {
 	return w.scale * math.Pow(-math.Log(1-p), 1/w.shape)
 }
 
+func (w *Weibull) InverseSurvival(q float64) float64 {
+	if q >= 1 {
+		return 0
+	}
+
+	if q <= 0 {
+		return math.Inf(1)
+	}
+
+	return w.scale * math.Pow(-math.Log(q), 1/w.shape)
+}
+
 func (w *Weibull) Skewness() float64 {
 	m1 := w.rm(1)
 	m2 := w.rm(2)
@@ -0,0 +1,39 @@
+package continuous
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestWeibullInverseSurvival(t *testing.T) {
+	tol := 1e-15
+	cases := []struct {
+		λ, k, q  float64
+		expected float64
+	}{
+		{2, 1.5, 0.5, 1.5664395375493028},
+		{2, 1.5, 0.001, 7.254173824678952},
+		{2, 1.5, 1e-12, 18.279372602618825},
+		{1, 0.5, 0.5, 0.4804530139182014},
+		{1, 0.5, 0.001, 47.71708299430558},
+		{1, 0.5, 1e-12, 763.4733279088892},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			w := Weibull{scale: c.λ, shape: c.k}
+
+			res := w.InverseSurvival(c.q)
+			run_test(t, res, c.expected, tol, "WeibullInverseSurvival")
+		})
+	}
+}
+
+func TestWeibullInverseDistribution(t *testing.T) {
+	tol := 1e-15
+	for _, p := range []float64{.001, .1, .5, .9, .999} {
+		w := Weibull{scale: 2, shape: 1.5}
+		run_test(t, w.Distribution(w.Inverse(p)), p, tol, fmt.Sprintf("WeibullInverseDistribution(%v)", p))
+	}
+}
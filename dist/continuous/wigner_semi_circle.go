@@ -2,7 +2,6 @@ package continuous
 
 import (
 	"github.com/jtejido/stats"
-	"github.com/jtejido/stats/err"
 	"math"
 	"math/rand"
 )
@@ -19,11 +18,44 @@ func NewWignerSemiCircle(radius, center float64) (*WignerSemiCircle, error) {
 }
 
 func NewWignerSemiCircleWithSource(radius, center float64, src rand.Source) (*WignerSemiCircle, error) {
-	if radius <= 0 {
-		return nil, err.Invalid()
+	r := &WignerSemiCircle{radius, center, src}
+	if e := validate(r); e != nil {
+		return nil, e
 	}
 
-	return &WignerSemiCircle{radius, center, src}, nil
+	return r, nil
+}
+
+func (ws *WignerSemiCircle) spec() (string, []Common, []float64) {
+	return "WignerSemiCircle", nil, []float64{ws.radius, ws.center}
+}
+
+func (ws *WignerSemiCircle) String() string {
+	return specString(ws.spec())
+}
+
+func (ws *WignerSemiCircle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(ws)
+}
+
+func (ws *WignerSemiCircle) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, ws, ws.src)
+}
+
+func (ws *WignerSemiCircle) MarshalText() ([]byte, error) {
+	return []byte(ws.String()), nil
+}
+
+func (ws *WignerSemiCircle) UnmarshalText(text []byte) error {
+	return unmarshalText(text, ws, ws.src)
+}
+
+func (ws *WignerSemiCircle) ParameterValues() map[string]float64 {
+	return parameterValues(ws)
+}
+
+func (ws *WignerSemiCircle) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(ws, params, ws.src)
 }
 
 // a ∈ (-∞,∞)
@@ -42,15 +74,20 @@ func (ws *WignerSemiCircle) Support() stats.Interval {
 
 func (ws *WignerSemiCircle) Probability(x float64) float64 {
 	if ws.Support().IsWithinInterval(x) {
-		return (2. / math.Pi * (ws.radius * ws.radius)) * math.Sqrt((ws.radius*ws.radius)-math.Pow(-ws.center+x, 2))
-	} else if x >= ws.center+ws.radius {
-		return 1
+		return (2. / (math.Pi * (ws.radius * ws.radius))) * math.Sqrt((ws.radius*ws.radius)-math.Pow(-ws.center+x, 2))
 	}
 
 	return 0
 }
 
 func (ws *WignerSemiCircle) Distribution(x float64) float64 {
+	if x < ws.center && ws.Support().IsWithinInterval(x) {
+		// (y - sin y)/2π for y = 4·asin√(v/2) in the distance v from the lower end, which does not cancel
+		// in the tail, where Inverse and the InverseSurvival reflected off it are taken
+		v := (x - ws.center + ws.radius) / ws.radius
+		return minusSin(4*math.Asin(math.Sqrt(v/2))) / (2 * math.Pi)
+	}
+
 	if ws.Support().IsWithinInterval(x) {
 		return .5 + ((-ws.center+x)*math.Sqrt((ws.radius*ws.radius)-math.Pow(-ws.center+x, 2)))/(math.Pi*(ws.radius*ws.radius)) + math.Asin((-ws.center+x)/ws.radius)/math.Pi
 	} else if x >= ws.center+ws.radius {
@@ -60,6 +97,33 @@ func (ws *WignerSemiCircle) Distribution(x float64) float64 {
 	return 0
 }
 
+func (ws *WignerSemiCircle) Inverse(p float64) float64 {
+	sup := ws.Support()
+	if p <= 0 {
+		return sup.Lower
+	}
+
+	if p >= 1 {
+		return sup.Upper
+	}
+
+	return inverse(nil, ws.Distribution, ws.Probability, sup.Lower, sup.Upper, sup.Lower+2*ws.radius*p, p)
+}
+
+func (ws *WignerSemiCircle) InverseSurvival(q float64) float64 {
+	sup := ws.Support()
+	if q >= 1 {
+		return sup.Lower
+	}
+
+	if q <= 0 {
+		return sup.Upper
+	}
+
+	// symmetric about the center
+	return 2*ws.center - ws.Inverse(q)
+}
+
 func (ws *WignerSemiCircle) Mean() float64 {
 	return ws.center
 }
@@ -88,14 +152,20 @@ func (ws *WignerSemiCircle) Variance() float64 {
 	return (ws.radius * ws.radius) / 4
 }
 
+// The abscissa of a point uniform on the unit disk follows the standard semicircle.
 func (ws *WignerSemiCircle) Rand() float64 {
-	var rnd float64
+	var rnd func() float64
 	if ws.src == nil {
-		rnd = rand.Float64()
+		rnd = rand.Float64
 	} else {
-		rnd = rand.New(ws.src).Float64()
+		rnd = rand.New(ws.src).Float64
 	}
 
-	rnd += rnd - 1
-	return ws.radius * rnd
+	for {
+		u := 2*rnd() - 1
+		v := 2*rnd() - 1
+		if u*u+v*v <= 1 {
+			return ws.center + ws.radius*u
+		}
+	}
 }
@@ -0,0 +1,45 @@
+package continuous
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestWignerSemiCircleInverse(t *testing.T) {
+	tol := 0.000000001
+	cases := []struct {
+		p, R, a  float64
+		expected float64
+	}{
+		{.5, 1, 0, 0},
+		{.5, 2, 3, 3},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			ws, _ := NewWignerSemiCircle(c.R, c.a)
+			res := ws.Inverse(c.p)
+			run_test(t, res, c.expected, tol, "WignerSemiCircleInverse")
+		})
+	}
+}
+
+func TestWignerSemiCircleInverseDistribution(t *testing.T) {
+	tol := 0.000000001
+	cases := []struct {
+		R, a float64
+	}{
+		{1, 0},
+		{2, 3},
+		{.5, -1},
+	}
+
+	for _, c := range cases {
+		for _, p := range []float64{.001, .1, .5, .9, .999} {
+			ws, _ := NewWignerSemiCircle(c.R, c.a)
+			res := ws.Distribution(ws.Inverse(p))
+			run_test(t, res, p, tol, fmt.Sprintf("WignerSemiCircleInverseDistribution(%v, %v, %v)", c.R, c.a, p))
+		}
+	}
+}
@@ -5,37 +5,90 @@ import (
 	"github.com/jtejido/stats/err"
 	smath "github.com/jtejido/stats/math"
 	"math"
+	"math/cmplx"
 )
 
-// Wrapped distribution
+// Wrapped distribution, of the angle of dist modulo 2π. The wrapped Normal, Cauchy, Exponential and
+// Laplace distributions are in closed form. Otherwise the density and cdf sum over the windings of dist,
+// from the one holding θ outwards, until the mass of dist beyond them is negligible, and at most k each
+// side.
 // https://en.wikipedia.org/wiki/Wrapped_distribution
 // K. V. Mardia and P. E. Jupp, Directional Statistics, 1st ed. Wiley, 1999
 type Wrapped struct {
 	dist    Wrappable
 	support stats.Interval // allows flexibility with Support as long as x ∈ [any interval of length 2π].
 	k       int
+	form    wrappedForm // nil for the sums
 }
 
 func NewWrapped(dist Wrappable, k int, support stats.Interval) (*Wrapped, error) {
-	if k <= 0 {
-		k = 1000
-	}
-
-	if support.Lower >= support.Upper {
-		return nil, err.Error("lower cannot be greater than or equal upper", err.EINVAL)
+	if support.IsEmpty() {
+		return nil, err.Error("support cannot be empty", err.EINVAL)
 	}
 
 	if !support.IsEqualLength(defaultLength) {
 		return nil, err.Error("length not equals 2π", err.EINVAL)
 	}
 
-	return &Wrapped{dist, support, k}, nil
+	r := &Wrapped{dist: dist, support: support, k: k}
+	if e := validate(r); e != nil {
+		return nil, e
+	}
+
+	if r.k == 0 {
+		r.k = 1000
+	}
+
+	switch d := dist.(type) {
+	case *Normal:
+		r.form = wrappedNormal{d.location, d.scale}
+	case *Cauchy:
+		r.form = wrappedCauchy{d.location, math.Exp(-d.scale)}
+	case *Exponential:
+		r.form = wrappedExponential{d.rate}
+	case *Laplace:
+		r.form = wrappedLaplace{d.location, d.scale}
+	}
+
+	return r, nil
+}
+
+func (w *Wrapped) spec() (string, []Common, []float64) {
+	return "Wrapped", []Common{w.dist}, []float64{float64(w.k), w.support.Lower}
+}
+
+func (w *Wrapped) String() string {
+	return specString(w.spec())
+}
+
+func (w *Wrapped) MarshalJSON() ([]byte, error) {
+	return marshalJSON(w)
+}
+
+func (w *Wrapped) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, w, nil)
+}
+
+func (w *Wrapped) MarshalText() ([]byte, error) {
+	return marshalText(w)
+}
+
+func (w *Wrapped) UnmarshalText(text []byte) error {
+	return unmarshalText(text, w, nil)
+}
+
+func (w *Wrapped) ParameterValues() map[string]float64 {
+	return parameterValues(w)
 }
 
-// k ∈ (0,∞)
+func (w *Wrapped) WithParameters(params map[string]float64) (Common, error) {
+	return withParameters(w, params, nil)
+}
+
+// k ∈ [0,∞), where 0 selects the default of 1000
 func (w *Wrapped) Parameters() stats.Limits {
 	return stats.Limits{
-		"k": stats.Interval{0, math.Inf(1), true, true},
+		"k": stats.Interval{0, math.Inf(1), false, true},
 	}
 }
 
@@ -44,55 +97,400 @@ func (w *Wrapped) Support() stats.Interval {
 	return w.support
 }
 
+// Mass of dist, of cdf F, outside [a, b], an upper bound of what the windings beyond hold.
+func outside(F func(float64) float64, a, b float64) float64 {
+	return F(a) + (1 - F(b))
+}
+
+// Mass of a circular distribution, of cdf F and density f, on the arc from θ to the upper end of its
+// support: 1 - F(θ) where that keeps its digits, and f integrated over the arc once it is small.
+func arcSurvival(F, f func(float64) float64, θ, upper float64) float64 {
+	if s := 1 - F(θ); s > .1 {
+		return s
+	}
+
+	return quadrature(f, θ, upper)
+}
+
 // via knb summation
 func (w *Wrapped) Probability(θ float64) float64 {
-	if w.Support().IsWithinInterval(θ) {
-		var c float64
-		sum := w.dist.Probability(θ + 2*math.Pi*float64(-w.k))
-		for k := -w.k - 1; k < w.k; k++ {
-			d := w.dist.Probability(θ + 2*math.Pi*float64(k))
-			t := sum + d
-			if math.Abs(sum) >= math.Abs(d) {
-				c += (sum - t) + d
-			} else {
-				c += (d - t) + sum
-			}
+	if !w.Support().IsWithinInterval(θ) {
+		return 0
+	}
 
-			sum = t
-		}
+	if w.form != nil {
+		return w.form.density(wrapFrom(θ, w.form.origin()))
+	}
 
-		return sum + c
+	// the terms beyond winding j are about 1/2π of the mass of dist beyond it
+	var s knb
+	s.add(w.dist.Probability(θ))
+	for j := 1; j <= w.k; j++ {
+		fj := 2 * math.Pi * float64(j)
+		s.add(w.dist.Probability(θ - fj))
+		s.add(w.dist.Probability(θ + fj))
+		if outside(w.dist.Distribution, θ-fj-math.Pi, θ+fj+math.Pi) <= 2*math.Pi*1e-16*s.value() {
+			break
+		}
 	}
 
-	return 0
+	return s.value()
 }
 
 // via knb summation
 func (w *Wrapped) Distribution(θ float64) float64 {
-	if w.Support().IsWithinInterval(θ) {
-		var c float64
-		fwk := float64(-w.k)
-		sum := w.dist.Distribution(θ+2*math.Pi*fwk) - w.dist.Distribution(-math.Pi+2*math.Pi*fwk)
-		for k := -w.k - 1; k < w.k; k++ {
-			fk := float64(k)
-			d := w.dist.Distribution(θ+2*math.Pi*fk) - w.dist.Distribution(-math.Pi+2*math.Pi*fk)
-			t := sum + d
-			if math.Abs(sum) >= math.Abs(d) {
-				c += (sum - t) + d
-			} else {
-				c += (d - t) + sum
+	sup := w.Support()
+	if θ >= sup.Upper {
+		return 1
+	}
+
+	if !sup.IsWithinInterval(θ) {
+		return 0
+	}
+
+	if w.form != nil {
+		a := w.form.origin()
+		v := w.form.cdf(wrapFrom(θ, a)) - w.form.cdf(wrapFrom(sup.Lower, a))
+		if v < 0 {
+			v++
+		}
+
+		return math.Max(0, math.Min(1, v))
+	}
+
+	var s knb
+	F := w.dist.Distribution
+	s.add(F(θ) - F(sup.Lower))
+	for j := 1; j <= w.k; j++ {
+		fj := 2 * math.Pi * float64(j)
+		s.add(F(θ-fj) - F(sup.Lower-fj))
+		s.add(F(θ+fj) - F(sup.Lower+fj))
+		if outside(F, sup.Lower-fj, sup.Lower+fj+2*math.Pi) <= 1e-16 {
+			break
+		}
+	}
+
+	return s.value()
+}
+
+// Inverse is in closed form for the wrapped Cauchy, Exponential and Laplace distributions.
+func (w *Wrapped) Inverse(p float64) float64 {
+	sup := w.Support()
+	if p <= 0 {
+		return sup.Lower
+	}
+
+	if p >= 1 {
+		return sup.Upper
+	}
+
+	if w.form != nil {
+		a := w.form.origin()
+		q := p + w.form.cdf(wrapFrom(sup.Lower, a))
+		if q >= 1 {
+			q--
+		}
+
+		if x := w.form.quantile(q); !math.IsNaN(x) {
+			θ := smath.WrapRange(a+x, sup.Lower, sup.Upper, false)
+			if θ == sup.Lower && p > .5 {
+				return sup.Upper
 			}
 
-			sum = t
+			return θ
 		}
+	}
+
+	return inverse(nil, w.Distribution, w.Probability, sup.Lower, sup.Upper, sup.Lower+2*math.Pi*p, p)
+}
 
-		return sum + c
+func (w *Wrapped) InverseSurvival(q float64) float64 {
+	sup := w.Support()
+	if q >= 1 {
+		return sup.Lower
 	}
 
-	return 0
+	if q <= 0 {
+		return sup.Upper
+	}
+
+	sf := func(x float64) float64 { return arcSurvival(w.Distribution, w.Probability, x, sup.Upper) }
+	return inverseSurvival(nil, sf, w.Probability, sup.Lower, sup.Upper, sup.Upper-2*math.Pi*q, q)
+}
+
+// TrigonometricMoment returns φₚ = E[e^{ipθ}], which is the characteristic function of dist at p where
+// dist is Characteristic, and otherwise the midpoint rule over the wrapped density, which converges
+// geometrically where that is smooth on the circle, to about 1e-6 where it kinks, and to about 1e-3
+// where it jumps within the support.
+func (w *Wrapped) TrigonometricMoment(p int) complex128 {
+	if c, ok := w.dist.(Characteristic); ok {
+		return c.CharacteristicFunction(float64(p))
+	}
+
+	const n = 2048
+	sup := w.Support()
+	h := 2 * math.Pi / n
+	var sum complex128
+	for i := 0; i < n; i++ {
+		θ := sup.Lower + (float64(i)+.5)*h
+		sum += complex(w.Probability(θ), 0) * cmplx.Exp(complex(0, float64(p)*θ))
+	}
+
+	return sum * complex(h, 0)
+}
+
+// CircularMean returns arg φ₁ within the support, or NaN where φ₁ = 0.
+func (w *Wrapped) CircularMean() float64 {
+	m := w.TrigonometricMoment(1)
+	if m == 0 {
+		return math.NaN()
+	}
+
+	sup := w.Support()
+	return smath.WrapRange(cmplx.Phase(m), sup.Lower, sup.Upper, false)
+}
+
+// |φ₁|
+func (w *Wrapped) ResultantLength() float64 {
+	return cmplx.Abs(w.TrigonometricMoment(1))
+}
+
+func (w *Wrapped) CircularVariance() float64 {
+	return 1 - w.ResultantLength()
+}
+
+func (w *Wrapped) CircularStdDev() float64 {
+	return math.Sqrt(-2 * math.Log(w.ResultantLength()))
 }
 
 func (w *Wrapped) Rand() float64 {
 	sup := w.Support()
 	return smath.WrapRange(w.dist.Rand(), sup.Lower, sup.Upper, false)
 }
+
+// Kahan–Babuška summation
+type knb struct {
+	sum, c float64
+}
+
+func (s *knb) add(d float64) {
+	t := s.sum + d
+	if math.Abs(s.sum) >= math.Abs(d) {
+		s.c += (s.sum - t) + d
+	} else {
+		s.c += (d - t) + s.sum
+	}
+
+	s.sum = t
+}
+
+func (s *knb) value() float64 {
+	return s.sum + s.c
+}
+
+// θ - a wrapped into [0, 2π).
+func wrapFrom(θ, a float64) float64 {
+	x := math.Mod(θ-a, 2*math.Pi)
+	if x < 0 {
+		x += 2 * math.Pi
+	}
+
+	if x >= 2*math.Pi {
+		x = 0
+	}
+
+	return x
+}
+
+// A wrapped distribution in closed form, about an origin a: its density at a + x and its cdf from a to
+// a + x, for x ∈ [0, 2π), and the x at which the cdf is p, NaN where it is solved for.
+type wrappedForm interface {
+	origin() float64
+	density(x float64) float64
+	cdf(x float64) float64
+	quantile(p float64) float64
+}
+
+// Wrapped normal, about μ - π.
+type wrappedNormal struct {
+	mu, sigma float64
+}
+
+func (wn wrappedNormal) origin() float64 {
+	return wn.mu - math.Pi
+}
+
+func (wn wrappedNormal) density(x float64) float64 {
+	return WrappedNormalProbability(x-math.Pi, wn.sigma)
+}
+
+func (wn wrappedNormal) cdf(x float64) float64 {
+	return WrappedNormalDistribution(x-math.Pi, wn.sigma)
+}
+
+func (wn wrappedNormal) quantile(p float64) float64 {
+	return math.NaN()
+}
+
+// Whether the Fourier series of the wrapped normal converges the faster, e^{-σ²/2} ≤ e^{-2π²/σ²}.
+func wrappedNormalFourier(σ float64) bool {
+	return σ*σ >= 2*math.Pi
+}
+
+// Terms k = -K..K of the wrapped normal sum, reaching 9σ from x ∈ [-π, π).
+func wrappedNormalTerms(σ float64) int {
+	return 1 + int(9*σ/(2*math.Pi))
+}
+
+// WrappedNormalProbability returns the density of the wrapped normal distribution of scale σ at μ + x,
+// for x ∈ [-π,π), the Jacobi theta function (1 + 2 Σ ρⁿ² cos nx)/2π for ρ = e^{-σ²/2}. The series
+// converges fast for σ² ≥ 2π, and the wrapped normal densities, reaching 9σ, for smaller σ.
+func WrappedNormalProbability(x, σ float64) float64 {
+	if wrappedNormalFourier(σ) {
+		ρ := math.Exp(-σ * σ / 2)
+		sum := 1.
+		for n := 1.; ; n++ {
+			t := 2 * math.Pow(ρ, n*n)
+			sum += t * math.Cos(n*x)
+			if t < 1e-17 {
+				break
+			}
+		}
+
+		return sum / (2 * math.Pi)
+	}
+
+	K := wrappedNormalTerms(σ)
+	var sum float64
+	for k := -K; k <= K; k++ {
+		z := (x + 2*math.Pi*float64(k)) / σ
+		sum += math.Exp(-z * z / 2)
+	}
+
+	return sum / (σ * math.Sqrt(2*math.Pi))
+}
+
+// WrappedNormalDistribution returns the mass of the wrapped normal distribution of scale σ from μ - π
+// to μ + x, for x ∈ [-π,π), (x + π)/2π + Σ ρⁿ² sin(nx)/nπ, summed as WrappedNormalProbability.
+func WrappedNormalDistribution(x, σ float64) float64 {
+	if wrappedNormalFourier(σ) {
+		ρ := math.Exp(-σ * σ / 2)
+		sum := (x + math.Pi) / (2 * math.Pi)
+		for n := 1.; ; n++ {
+			t := math.Pow(ρ, n*n) / (n * math.Pi)
+			sum += t * math.Sin(n*x)
+			if t < 1e-17 {
+				break
+			}
+		}
+
+		return sum
+	}
+
+	// Σ Φ((x + 2πk)/σ) - Φ((2πk - π)/σ), by the upper tails where both are above 1/2
+	K := wrappedNormalTerms(σ)
+	var sum float64
+	for k := -K; k <= K; k++ {
+		a, b := (x+2*math.Pi*float64(k))/σ, (2*math.Pi*float64(k)-math.Pi)/σ
+		if b >= 0 {
+			sum += (math.Erfc(b/math.Sqrt2) - math.Erfc(a/math.Sqrt2)) / 2
+		} else {
+			sum += (math.Erfc(-a/math.Sqrt2) - math.Erfc(-b/math.Sqrt2)) / 2
+		}
+	}
+
+	return sum
+}
+
+// Wrapped Cauchy, about μ - π.
+type wrappedCauchy struct {
+	mu, rho float64
+}
+
+func (wc wrappedCauchy) origin() float64 {
+	return wc.mu - math.Pi
+}
+
+func (wc wrappedCauchy) density(x float64) float64 {
+	return WrappedCauchyProbability(x-math.Pi, wc.rho)
+}
+
+func (wc wrappedCauchy) cdf(x float64) float64 {
+	return WrappedCauchyDistribution(x-math.Pi, wc.rho)
+}
+
+func (wc wrappedCauchy) quantile(p float64) float64 {
+	return math.Pi + WrappedCauchyInverse(p, wc.rho)
+}
+
+// WrappedCauchyProbability returns the density of the wrapped Cauchy distribution of mean resultant
+// length ρ = e^{-γ} at μ + x, (1 - ρ²)/2π(1 + ρ² - 2ρ cos x).
+func WrappedCauchyProbability(x, ρ float64) float64 {
+	return (1 - ρ*ρ) / (2 * math.Pi * (1 + ρ*ρ - 2*ρ*math.Cos(x)))
+}
+
+// WrappedCauchyDistribution returns the mass of the wrapped Cauchy distribution of mean resultant
+// length ρ from μ - π to μ + x, for x ∈ [-π,π).
+func WrappedCauchyDistribution(x, ρ float64) float64 {
+	return .5 + math.Atan((1+ρ)/(1-ρ)*math.Tan(x/2))/math.Pi
+}
+
+// WrappedCauchyInverse returns the x ∈ [-π,π) at which WrappedCauchyDistribution is p.
+func WrappedCauchyInverse(p, ρ float64) float64 {
+	return 2 * math.Atan((1-ρ)/(1+ρ)*math.Tan(math.Pi*(p-.5)))
+}
+
+// Wrapped exponential, of density λe^{-λθ}/(1 - e^{-2πλ}) for θ ∈ [0, 2π), about 0.
+type wrappedExponential struct {
+	rate float64
+}
+
+func (we wrappedExponential) origin() float64 {
+	return 0
+}
+
+func (we wrappedExponential) density(x float64) float64 {
+	return we.rate * math.Exp(-we.rate*x) / -math.Expm1(-2*math.Pi*we.rate)
+}
+
+func (we wrappedExponential) cdf(x float64) float64 {
+	return math.Expm1(-we.rate*x) / math.Expm1(-2*math.Pi*we.rate)
+}
+
+func (we wrappedExponential) quantile(p float64) float64 {
+	return -math.Log1p(p*math.Expm1(-2*math.Pi*we.rate)) / we.rate
+}
+
+// Wrapped Laplace, of density (e^{-x/b} + e^{-(2π-x)/b})/2b(1 - q) at μ + x for q = e^{-2π/b}, about μ.
+type wrappedLaplace struct {
+	mu, scale float64
+}
+
+func (wl wrappedLaplace) origin() float64 {
+	return wl.mu
+}
+
+func (wl wrappedLaplace) density(x float64) float64 {
+	b := wl.scale
+	return (math.Exp(-x/b) + math.Exp(-(2*math.Pi-x)/b)) / (2 * b * -math.Expm1(-2*math.Pi/b))
+}
+
+// (1 - e^{-x/b} + e^{-(2π-x)/b} - q)/2(1 - q)
+func (wl wrappedLaplace) cdf(x float64) float64 {
+	b := wl.scale
+	return (-math.Expm1(-x/b) + math.Exp(-(2*math.Pi-x)/b) - math.Exp(-2*math.Pi/b)) / (2 * -math.Expm1(-2*math.Pi/b))
+}
+
+// With y = e^{-x/b}, the cdf is p where y² + By - q = 0 for B = 2(1 - q)p - 1 + q.
+func (wl wrappedLaplace) quantile(p float64) float64 {
+	b := wl.scale
+	q := math.Exp(-2 * math.Pi / b)
+	B := -2*math.Expm1(-2*math.Pi/b)*p - 1 + q
+	d := math.Sqrt(B*B + 4*q)
+	y := (d - B) / 2
+	if B > 0 {
+		y = 2 * q / (B + d)
+	}
+
+	return -b * math.Log(y)
+}
@@ -1,6 +1,9 @@
 package continuous
 
 import (
+	"fmt"
+	"github.com/jtejido/stats"
+	"math"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -47,6 +50,16 @@ func TestWrappedNormalDistribution(t *testing.T) {
 	}
 }
 
+func TestWrappedNormalInverseDistribution(t *testing.T) {
+	tol := 0.0000001
+	sn, _ := NewNormal(0, 1)
+	b, _ := NewWrapped(sn, 1000, DefaultCircularSupport)
+	for _, p := range []float64{.001, .1, .5, .9, .999} {
+		res := b.Distribution(b.Inverse(p))
+		run_test(t, res, p, tol, fmt.Sprintf("WrappedNormalInverseDistribution(%v)", p))
+	}
+}
+
 func BenchmarkWrappedNormalDistribution(b *testing.B) {
 	sn, _ := NewNormal(0, 1)
 	bd, _ := NewWrapped(sn, 1000, DefaultCircularSupport)
@@ -55,3 +68,142 @@ func BenchmarkWrappedNormalDistribution(b *testing.B) {
 		bd.Distribution(rnd.NormFloat64())
 	}
 }
+
+// hides the base distribution from the closed forms of Wrapped
+type opaque struct {
+	Common
+}
+
+func TestWrappedClosedForms(t *testing.T) {
+	tol := 1e-9
+	n1, _ := NewNormal(.3, .7)
+	n2, _ := NewNormal(-1, 3)
+	e, _ := NewExponential(.8)
+	l, _ := NewLaplace(-2.5, 1.3)
+	for _, d := range []Common{n1, n2, e, l} {
+		for _, sup := range []struct{ lower float64 }{{0}, {-math.Pi}, {1}} {
+			support := stats.Interval{sup.lower, sup.lower + 2*math.Pi, false, false}
+			closed, _ := NewWrapped(d, 0, support)
+			summed, _ := NewWrapped(opaque{d}, 200000, support)
+			for i := 0; i < 16; i++ {
+				θ := sup.lower + 2*math.Pi*(float64(i)+.37)/16
+				desc := fmt.Sprintf("Wrapped(%v).%%s(%v)", d, θ)
+				run_test(t, closed.Probability(θ), summed.Probability(θ), tol, fmt.Sprintf(desc, "Probability"))
+				run_test(t, closed.Distribution(θ), summed.Distribution(θ), tol, fmt.Sprintf(desc, "Distribution"))
+			}
+		}
+	}
+}
+
+// the sums of the Cauchy tails converge too slowly to check against
+func TestWrappedCauchy(t *testing.T) {
+	tol := 1e-12
+	c, _ := NewCauchy(2, .4)
+	w, _ := NewWrapped(c, 0, stats.Interval{0, 2 * math.Pi, false, false})
+	ρ := math.Exp(-.4)
+	for i := 0; i < 16; i++ {
+		θ := 2 * math.Pi * (float64(i) + .37) / 16
+		want := (1 - ρ*ρ) / (2 * math.Pi * (1 + ρ*ρ - 2*ρ*math.Cos(θ-2)))
+		run_test(t, w.Probability(θ), want, tol, fmt.Sprintf("WrappedCauchyProbability(%v)", θ))
+	}
+
+	// ∫₀^θ by the density's antiderivative atan((1+ρ)/(1-ρ) tan((θ-μ)/2))/π, continuous from 0 through μ ± π
+	F := func(θ float64) float64 {
+		return math.Atan((1+ρ)/(1-ρ)*math.Tan((θ-2)/2)) / math.Pi
+	}
+
+	run_test(t, w.Distribution(1), F(1)-F(0), tol, "WrappedCauchyDistribution(1)")
+	run_test(t, w.Distribution(4), F(4)-F(0), tol, "WrappedCauchyDistribution(4)")
+	run_test(t, w.Distribution(6), F(6)-F(0)+1, tol, "WrappedCauchyDistribution(6)")
+
+	// the closed forms about the mean that package directional shares
+	for _, p := range []float64{.001, .1, .5, .9, .999} {
+		run_test(t, WrappedCauchyDistribution(WrappedCauchyInverse(p, ρ), ρ), p, tol, fmt.Sprintf("WrappedCauchyInverse(%v)", p))
+	}
+}
+
+func TestWrappedInverse(t *testing.T) {
+	tol := 1e-9
+	n, _ := NewNormal(.3, .7)
+	c, _ := NewCauchy(2, .4)
+	e, _ := NewExponential(.8)
+	l, _ := NewLaplace(-2.5, 1.3)
+	g, _ := NewGamma(2, 1.5)
+	for _, d := range []Common{n, c, e, l, g} {
+		w, _ := NewWrapped(d, 0, stats.Interval{-math.Pi, math.Pi, false, false})
+		for _, p := range []float64{.001, .1, .37, .5, .9, .999} {
+			run_test(t, w.Distribution(w.Inverse(p)), p, tol, fmt.Sprintf("Wrapped(%v).Inverse(%v)", d, p))
+		}
+	}
+}
+
+func TestWrappedTrigonometricMoment(t *testing.T) {
+	tol := 1e-9
+	n, _ := NewNormal(.3, .7)
+	c, _ := NewCauchy(2, .4)
+	e, _ := NewExponential(.8)
+	l, _ := NewLaplace(-2.5, 1.3)
+	g, _ := NewGamma(2, 1.5)
+	u, _ := NewUniform(-1, 4)
+	for _, c := range []struct {
+		d   Common
+		tol float64
+	}{
+		{n, tol},
+		{c, tol},
+		// the midpoint rule is O(h²) over kinks, and a jump at the ends of the support
+		{e, 1e-5},
+		{l, 1e-5},
+		{g, 1e-5},
+		// and O(h) over jumps within it
+		{u, 1e-3},
+	} {
+		support := stats.Interval{0, 2 * math.Pi, false, false}
+		w, _ := NewWrapped(c.d, 0, support)
+		o, _ := NewWrapped(opaque{c.d}, 0, support)
+		if _, ok := c.d.(*Cauchy); ok {
+			// by the closed form density, as its sums converge too slowly
+			o = &Wrapped{dist: opaque{c.d}, support: support, k: 1000, form: w.form}
+		}
+
+		for p := 0; p <= 3; p++ {
+			want, got := o.TrigonometricMoment(p), w.TrigonometricMoment(p)
+			desc := fmt.Sprintf("Wrapped(%v).TrigonometricMoment(%v)", c.d, p)
+			run_test(t, real(got), real(want), c.tol, desc)
+			run_test(t, imag(got), imag(want), c.tol, desc)
+		}
+	}
+
+	w, _ := NewWrapped(n, 0, DefaultCircularSupport)
+	run_test(t, w.CircularMean(), .3, 1e-12, "WrappedNormalCircularMean")
+	run_test(t, w.ResultantLength(), math.Exp(-.7*.7/2), 1e-12, "WrappedNormalResultantLength")
+	run_test(t, w.CircularVariance(), 1-math.Exp(-.7*.7/2), 1e-12, "WrappedNormalCircularVariance")
+	run_test(t, w.CircularStdDev(), .7, 1e-12, "WrappedNormalCircularStdDev")
+}
+
+// counts the evaluations of the base distribution
+type counted struct {
+	Common
+	n int
+}
+
+func (c *counted) Probability(x float64) float64 {
+	c.n++
+	return c.Common.Probability(x)
+}
+
+func (c *counted) Distribution(x float64) float64 {
+	c.n++
+	return c.Common.Distribution(x)
+}
+
+func TestWrappedAdaptive(t *testing.T) {
+	g, _ := NewGamma(3, 2)
+	d := &counted{Common: g}
+	w, _ := NewWrapped(d, 1000, DefaultCircularSupport)
+	w.Probability(1)
+	w.Distribution(1)
+	if d.n > 100 {
+		t.Errorf("Mismatch. Wrapped Gamma evaluations, want: <= 100, got: %v", d.n)
+	}
+}
@@ -0,0 +1,138 @@
+package err
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"runtime"
+)
+
+// Policy says what a Config does with an error.
+type Policy int
+
+const (
+	// PolicyHandler calls the Handler of the Config, or else the handler set by SetErrorHandler, which
+	// logs and panics if none was set. This is the default.
+	PolicyHandler Policy = iota
+	PolicyPanic          // log the error and panic
+	PolicyLog            // log the error
+	PolicyIgnore         // drop the error
+	PolicyCollect        // add the error to the Diagnostics of the Config
+)
+
+func (p Policy) String() string {
+	switch p {
+	case PolicyHandler:
+		return "handler"
+	case PolicyPanic:
+		return "panic"
+	case PolicyLog:
+		return "log"
+	case PolicyIgnore:
+		return "ignore"
+	case PolicyCollect:
+		return "collect"
+	}
+
+	return fmt.Sprintf("Policy(%d)", int(p))
+}
+
+// Config is an error policy owned by its caller, so that goroutines can raise errors under different
+// policies at the same time without touching the package defaults. Zero fields, and a nil *Config, fall
+// back to those defaults: the handler of SetErrorHandler, the stream of SetStream and SetStreamHandler
+// and the collector of SetDiagnostics.
+type Config struct {
+	Policy      Policy
+	Handler     ErrorHandlerType  // called under PolicyHandler
+	Stream      StreamHandlerType // prints the errors logged under PolicyLog and PolicyPanic
+	Diagnostics *Diagnostics      // receives the errors of PolicyCollect and the calls to Report
+}
+
+// Error hands the error to c and returns it.
+func (c *Config) Error(reason string, errno int) StatsError {
+	_, file, line, _ := runtime.Caller(1)
+	c.Handle(reason, file, line, errno)
+	return New(errno, reason)
+}
+
+// Handle deals with an error raised at file:line as the policy of c says. It has the signature of an
+// ErrorHandlerType, so that a Config can stand in for a handler.
+func (c *Config) Handle(reason, file string, line, errno int) {
+	if c == nil {
+		c = &Config{}
+	}
+
+	switch c.Policy {
+	case PolicyHandler:
+		h := c.Handler
+		if h == nil {
+			h = defaultErrorHandler()
+		}
+
+		if h != nil {
+			h(reason, file, line, errno)
+			return
+		}
+
+		c.print("ERROR", file, line, reason)
+		c.print("ERROR", file, line, "Default Stats error handler invoked.")
+		panic(reason)
+	case PolicyPanic:
+		c.print("ERROR", file, line, reason)
+		panic(reason)
+	case PolicyLog:
+		c.print("ERROR", file, line, reason)
+	case PolicyCollect:
+		c.report(reason, file, line, errno, math.NaN(), math.NaN())
+	}
+}
+
+// Report records a problem with value, estimated to be off by estimate (NaN if unknown), to the
+// Diagnostics of c, if any. It never calls the handler, whatever the policy.
+func (c *Config) Report(reason string, errno int, value, estimate float64) {
+	_, file, line, _ := runtime.Caller(1)
+	c.report(reason, file, line, errno, value, estimate)
+}
+
+func (c *Config) report(reason, file string, line, errno int, value, estimate float64) {
+	var d *Diagnostics
+	if c != nil {
+		d = c.Diagnostics
+	}
+
+	if d == nil {
+		diagnosticsMu.RLock()
+		d = diagnostics
+		diagnosticsMu.RUnlock()
+	}
+
+	if d != nil {
+		d.Add(Diagnostic{Errno: errno, Reason: reason, File: file, Line: line, Value: value, Estimate: estimate})
+	}
+}
+
+func (c *Config) print(label, file string, line int, reason string) {
+	if c.Stream != nil {
+		c.Stream(label, file, line, reason)
+		return
+	}
+
+	StreamPrintf(label, file, line, reason)
+}
+
+type configKey struct{}
+
+// NewContext returns a copy of ctx carrying c, for functions of this module that take a context.
+func NewContext(ctx context.Context, c *Config) context.Context {
+	return context.WithValue(ctx, configKey{}, c)
+}
+
+// FromContext returns the Config carried by ctx, or nil, which follows the package defaults.
+func FromContext(ctx context.Context) *Config {
+	if ctx == nil {
+		return nil
+	}
+
+	c, _ := ctx.Value(configKey{}).(*Config)
+	return c
+}
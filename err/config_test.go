@@ -0,0 +1,95 @@
+package err
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestConfigPolicies(t *testing.T) {
+	var handled, printed []string
+	handler := func(reason, file string, line, errno int) { handled = append(handled, reason) }
+	stream := func(label, file string, line int, reason string) { printed = append(printed, reason) }
+
+	panics := func(c *Config) (p bool) {
+		defer func() { p = recover() != nil }()
+		c.Error("boom", EDOM)
+		return
+	}
+
+	d := new(Diagnostics)
+	cases := []struct {
+		c                        *Config
+		panics                   bool
+		handled, printed, listed int
+	}{
+		{&Config{Handler: handler, Stream: stream}, false, 1, 0, 0},
+		{&Config{Policy: PolicyPanic, Handler: handler, Stream: stream}, true, 0, 1, 0},
+		{&Config{Policy: PolicyLog, Stream: stream, Diagnostics: d}, false, 0, 1, 0},
+		{&Config{Policy: PolicyIgnore, Handler: handler, Stream: stream}, false, 0, 0, 0},
+		{&Config{Policy: PolicyCollect, Handler: handler, Stream: stream, Diagnostics: d}, false, 0, 0, 1},
+	}
+
+	for _, c := range cases {
+		handled, printed = nil, nil
+		d.Reset()
+		if p := panics(c.c); p != c.panics || len(handled) != c.handled || len(printed) != c.printed || d.Len() != c.listed {
+			t.Errorf("Mismatch. policy %v want: panic %v, %d handled, %d printed, %d collected, got: %v, %v, %v, %v",
+				c.c.Policy, c.panics, c.handled, c.printed, c.listed, p, handled, printed, d.List())
+		}
+	}
+
+	// a nil or zero Config follows the default handler
+	SetErrorHandler(handler)
+	defer SetErrorHandler(nil)
+	handled = nil
+	(*Config)(nil).Error("nil", EDOM)
+	(&Config{}).Error("zero", EDOM)
+	if len(handled) != 2 {
+		t.Errorf("Mismatch. default handler want: [nil zero], got: %v", handled)
+	}
+}
+
+func TestConfigContext(t *testing.T) {
+	if c := FromContext(context.Background()); c != nil {
+		t.Errorf("Mismatch. FromContext want: nil, got: %v", c)
+	}
+
+	c := &Config{Policy: PolicyIgnore}
+	if got := FromContext(NewContext(context.Background(), c)); got != c {
+		t.Errorf("Mismatch. FromContext want: %v, got: %v", c, got)
+	}
+}
+
+func TestConfigConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	diags := make([]*Diagnostics, 8)
+	for i := range diags {
+		diags[i] = new(Diagnostics)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c := &Config{Policy: PolicyCollect, Diagnostics: diags[i]}
+			for k := 0; k < 100; k++ {
+				c.Error(fmt.Sprint(i), EDOM)
+				c.Report(fmt.Sprint(i), ELOSS, 0, 0)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i, d := range diags {
+		list := d.List()
+		if len(list) != 200 {
+			t.Errorf("Mismatch. goroutine %d want: 200 reports, got: %d", i, len(list))
+		}
+
+		for _, r := range list {
+			if r.Reason != fmt.Sprint(i) {
+				t.Errorf("Mismatch. goroutine %d got a report of %s", i, r.Reason)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package err
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+)
+
+// Diagnostic records a result that may be less accurate than its type suggests: a series or iteration
+// stopped before converging (EMAXITER), precision lost to cancellation (ELOSS), an underflow (EUNDRFLW)
+// or a value clamped into its range (ERANGE).
+type Diagnostic struct {
+	Errno    int
+	Reason   string
+	File     string
+	Line     int
+	Value    float64 // the value returned
+	Estimate float64 // estimated absolute error of Value, NaN if unknown
+}
+
+func (d Diagnostic) Status() int {
+	return d.Errno
+}
+
+func (d Diagnostic) Error() string {
+	return fmt.Sprintf("%s (value %v, error estimate %v)", d.Reason, d.Value, d.Estimate)
+}
+
+// Diagnostics collects the reports of numerical code while it is installed by SetDiagnostics or Diagnose.
+// The zero value is ready to use, and it is safe for concurrent use.
+type Diagnostics struct {
+	mu   sync.Mutex
+	list []Diagnostic
+}
+
+// Add appends d to the reports.
+func (diag *Diagnostics) Add(d Diagnostic) {
+	diag.mu.Lock()
+	diag.list = append(diag.list, d)
+	diag.mu.Unlock()
+}
+
+// List returns a copy of the reports, in the order they were made.
+func (diag *Diagnostics) List() []Diagnostic {
+	diag.mu.Lock()
+	defer diag.mu.Unlock()
+	return append([]Diagnostic(nil), diag.list...)
+}
+
+// Len returns the number of reports.
+func (diag *Diagnostics) Len() int {
+	diag.mu.Lock()
+	defer diag.mu.Unlock()
+	return len(diag.list)
+}
+
+// Err returns the first report, or nil if there is none.
+func (diag *Diagnostics) Err() StatsError {
+	diag.mu.Lock()
+	defer diag.mu.Unlock()
+	if len(diag.list) == 0 {
+		return nil
+	}
+
+	return diag.list[0]
+}
+
+// ErrorEstimate returns the sum of the error estimates of the reports, 0 if there is none and NaN if any
+// of them is unknown.
+func (diag *Diagnostics) ErrorEstimate() float64 {
+	diag.mu.Lock()
+	defer diag.mu.Unlock()
+	var sum float64
+	for _, d := range diag.list {
+		sum += math.Abs(d.Estimate)
+	}
+
+	return sum
+}
+
+// Reset discards the reports.
+func (diag *Diagnostics) Reset() {
+	diag.mu.Lock()
+	diag.list = nil
+	diag.mu.Unlock()
+}
+
+var (
+	diagnosticsMu sync.RWMutex
+	diagnostics   *Diagnostics
+)
+
+// SetDiagnostics installs d to collect the reports of every subsequent call, returning the previous
+// collector. A nil d, the default, turns diagnostics off.
+func SetDiagnostics(d *Diagnostics) *Diagnostics {
+	diagnosticsMu.Lock()
+	defer diagnosticsMu.Unlock()
+	previous := diagnostics
+	diagnostics = d
+	return previous
+}
+
+// DiagnosticsOn reports whether a collector is installed, so that callers can skip work done only to
+// report.
+func DiagnosticsOn() bool {
+	diagnosticsMu.RLock()
+	defer diagnosticsMu.RUnlock()
+	return diagnostics != nil
+}
+
+// Diagnose calls f with a fresh collector installed and returns its value together with the reports made
+// meanwhile, which are also passed on to any collector installed before. The collector is process wide,
+// so reports from other goroutines running at the same time are collected too; give each goroutine a
+// Config with its own Diagnostics to keep them apart.
+func Diagnose(f func() float64) (float64, *Diagnostics) {
+	d := new(Diagnostics)
+	previous := SetDiagnostics(d)
+	defer func() {
+		SetDiagnostics(previous)
+		if previous != nil {
+			for _, r := range d.List() {
+				previous.Add(r)
+			}
+		}
+	}()
+
+	return f(), d
+}
+
+// Report records a problem with value, estimated to be off by estimate (NaN if unknown), to the
+// installed collector, if any. Unlike Error, it never calls the error handler.
+func Report(reason string, errno int, value, estimate float64) {
+	_, file, line, _ := runtime.Caller(1)
+	(*Config)(nil).report(reason, file, line, errno, value, estimate)
+}
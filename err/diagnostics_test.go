@@ -0,0 +1,45 @@
+package err
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDiagnostics(t *testing.T) {
+	// nothing is collected while diagnostics are off
+	Report("off", ELOSS, 1, 0)
+	if DiagnosticsOn() {
+		t.Fatalf("diagnostics on by default")
+	}
+
+	outer := new(Diagnostics)
+	SetDiagnostics(outer)
+	defer SetDiagnostics(nil)
+
+	Report("outer", ERANGE, 1, 1e-3)
+	v, inner := Diagnose(func() float64 {
+		Report("inner", EMAXITER, 2, 1e-2)
+		Report("lossy", ELOSS, 2, math.NaN())
+		return 2
+	})
+
+	if v != 2 || inner.Len() != 2 || inner.Err().Status() != EMAXITER {
+		t.Errorf("Mismatch. Diagnose want: 2 with 2 reports, got: %v with %v", v, inner.List())
+	}
+
+	if e := inner.ErrorEstimate(); !math.IsNaN(e) {
+		t.Errorf("Mismatch. ErrorEstimate want: NaN, got: %v", e)
+	}
+
+	// the inner reports are passed on to the collector installed before
+	list := outer.List()
+	if len(list) != 3 || list[0].Reason != "outer" || list[1].Reason != "inner" {
+		t.Errorf("Mismatch. outer reports want: [outer inner lossy], got: %v", list)
+	}
+
+	outer.Reset()
+	Report("again", EUNDRFLW, 0, 1e-300)
+	if e := outer.ErrorEstimate(); outer.Len() != 1 || e != 1e-300 || outer.Err().Status() != EUNDRFLW {
+		t.Errorf("Mismatch. after Reset want one report, got: %v", outer.List())
+	}
+}
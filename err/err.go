@@ -1,8 +1,7 @@
 package err
 
 import (
-	"log"
-	// "os"
+	"sync"
 )
 
 type (
@@ -11,7 +10,8 @@ type (
 )
 
 var (
-	errorHandler ErrorHandlerType = nil
+	errorHandlerMu sync.RWMutex
+	errorHandler   ErrorHandlerType = nil
 )
 
 type StatsError interface {
@@ -36,25 +36,28 @@ func (err *statsError) Error() string {
 	return err.message
 }
 
+// HandleError deals with an error under the package defaults: it calls the handler set by
+// SetErrorHandler, or logs the error and panics if there is none. Use a Config for another policy.
 func HandleError(reason, file string, line, gsl_errno int) {
-	if errorHandler != nil {
-		errorHandler(reason, file, line, gsl_errno)
-		return
-	}
-
-	StreamPrintf("ERROR", file, line, reason)
-	log.Printf("Default Stats error handler invoked.\n")
-	// SIGABRT = 6?
-	// os.Exit(6)?
-	panic(reason)
+	(*Config)(nil).Handle(reason, file, line, gsl_errno)
 }
 
+// SetErrorHandler sets the default handler, nil restoring the one that panics. It is safe to call
+// concurrently, but affects every goroutine not raising errors through a Config of its own.
 func SetErrorHandler(new_handler ErrorHandlerType) {
+	errorHandlerMu.Lock()
 	errorHandler = new_handler
+	errorHandlerMu.Unlock()
 }
 
 func SetErrorHandlerOff() {
-	errorHandler = NoErrorHandler
+	SetErrorHandler(NoErrorHandler)
+}
+
+func defaultErrorHandler() ErrorHandlerType {
+	errorHandlerMu.RLock()
+	defer errorHandlerMu.RUnlock()
+	return errorHandler
 }
 
 func NoErrorHandler(reason, file string, line int, gsl_errno int) {
@@ -3,40 +3,60 @@ package err
 import (
 	"log"
 	"os"
+	"sync"
 )
 
 var (
+	streamMu      sync.RWMutex
 	stream        *os.File          = nil
+	streamLogger  *log.Logger       = nil
 	streamHandler StreamHandlerType = nil
 )
 
+// StreamPrintf prints an error through the handler set by SetStreamHandler, or else to the stream set
+// by SetStream, stderr by default.
 func StreamPrintf(label, file string, line int, reason string) {
-	if stream == nil {
-		stream = os.Stderr
-		log.SetOutput(stream)
-	}
+	streamMu.RLock()
+	handler, logger := streamHandler, streamLogger
+	streamMu.RUnlock()
 
-	if streamHandler != nil {
-		streamHandler(label, file, line, reason)
+	if handler != nil {
+		handler(label, file, line, reason)
 		return
 	}
 
-	log.Printf("ggsl: %s:%d: %s: %s\n", file, line, label, reason)
+	if logger == nil {
+		streamMu.Lock()
+		if streamLogger == nil {
+			if stream == nil {
+				stream = os.Stderr
+			}
+			streamLogger = log.New(stream, "", log.LstdFlags)
+		}
+		logger = streamLogger
+		streamMu.Unlock()
+	}
 
+	logger.Printf("ggsl: %s:%d: %s: %s\n", file, line, label, reason)
 }
 
 func SetStreamHandler(new_handler StreamHandlerType) StreamHandlerType {
+	streamMu.Lock()
+	defer streamMu.Unlock()
 	previous_handler := streamHandler
 	streamHandler = new_handler
 	return previous_handler
 }
 
 func SetStream(new_stream *os.File) *os.File {
+	streamMu.Lock()
+	defer streamMu.Unlock()
 	if stream == nil {
 		stream = os.Stderr
 	}
 
 	previous_stream := stream
 	stream = new_stream
+	streamLogger = nil
 	return previous_stream
 }
@@ -3,13 +3,13 @@ package main
 import (
 	gsl "github.com/jtejido/ggsl"
 	"github.com/jtejido/stats/dist/continuous"
-	//"github.com/jtejido/stats/dist/continuous/directional"
+	"github.com/jtejido/stats/dist/continuous/directional"
 	"go-hep.org/x/hep/hbook"
 	"go-hep.org/x/hep/hplot"
 	"gonum.org/v1/plot/plotter"
 	"gonum.org/v1/plot/vg"
 	"image/color"
-	//"math"
+	"math"
 	// "math/rand"
 	//"github.com/jtejido/stats"
 	"strings"
@@ -26,16 +26,17 @@ type internalFunc struct {
 }
 
 var (
-	xmin      = gsl.Float64Eps
-	xmax      = 5.
+	xmin      = -math.Pi
+	xmax      = math.Pi
 	lineColor = color.RGBA{204, 119, 34, 255}
 	pw        = plotWindow{xmin: xmin, xmax: xmax, ymin: gsl.Float64Eps, ymax: 1}
 	nSamples  = 1e6 // number of samples
 	nBins     = 200
-	dist, _   = continuous.NewGompertz(.1, 1)
+	dist, _   = directional.NewWrappedCauchy(0, .5, continuous.DefaultCircularSupport)
+	// continuous.NewGompertz(.1, 1)
 	// continuous.NewJohnsonSUWithSource(-2, 2, 1.1, 1.5, rand.NewSource(132214534))
-	title    = "Gompertz(.1, 1)"
-	filename = "Gompertz"
+	title    = "WrappedCauchy(0, .5)"
+	filename = "WrappedCauchy"
 	format   = "jpg"
 )
 
@@ -0,0 +1,323 @@
+package stats
+
+import (
+	"fmt"
+	gsl "github.com/jtejido/ggsl"
+	"github.com/jtejido/stats/err"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// https://en.wikipedia.org/wiki/Interval_(mathematics)
+type Interval struct {
+	Lower, Upper         float64
+	LowerOpen, UpperOpen bool
+}
+
+// Check if x is within the interval.
+func (i Interval) IsWithinInterval(x float64) bool {
+	// disregard NaNs.
+	if math.IsNaN(x) {
+		return false
+	}
+
+	// If the lower limit is -∞, we are always within bounds.
+	if !math.IsInf(i.Lower, -1) {
+		if i.LowerOpen {
+			if x <= i.Lower {
+				return false // x must be > lower bound
+			}
+		}
+
+		if x < i.Lower {
+			return false // x must be >= lower bound
+		}
+	}
+
+	// If the upper limit is ∞, we are always within bounds.
+	if !math.IsInf(i.Upper, 1) {
+		if i.UpperOpen {
+			if x >= i.Upper {
+				return false // x must be < upper bound
+			}
+		}
+
+		if x > i.Upper {
+			return false // x must be <= upper bound
+		}
+
+	}
+
+	return true
+}
+
+// Check if the length of the interval equals length, up to the rounding of its ends. Whether the ends
+// are open does not change the length.
+func (i Interval) IsEqualLength(length float64) bool {
+	// disregard NaNs.
+	if math.IsNaN(length) || i.IsEmpty() {
+		return false
+	}
+
+	l := i.Length()
+	if math.IsInf(l, 1) || math.IsInf(length, 1) {
+		return l == length
+	}
+
+	// computing either end from the other may round by half an ulp of the larger end
+	tol := 2 * gsl.Float64Eps * math.Max(math.Max(math.Abs(i.Lower), math.Abs(i.Upper)), math.Abs(length))
+	return math.Abs(l-length) <= tol
+}
+
+// Check if the interval contains no point, which is the case when Lower > Upper, when Lower == Upper and
+// either end is open, or when either end is NaN.
+func (i Interval) IsEmpty() bool {
+	if math.IsNaN(i.Lower) || math.IsNaN(i.Upper) {
+		return true
+	}
+
+	if i.Lower == i.Upper {
+		return i.LowerOpen || i.UpperOpen || math.IsInf(i.Lower, 0)
+	}
+
+	return i.Lower > i.Upper
+}
+
+// Check if x is within the interval, same as IsWithinInterval.
+func (i Interval) Contains(x float64) bool {
+	return i.IsWithinInterval(x)
+}
+
+// Check if every point of j is within the interval. The empty interval is contained in every interval.
+func (i Interval) ContainsInterval(j Interval) bool {
+	if j.IsEmpty() {
+		return true
+	}
+
+	if i.IsEmpty() {
+		return false
+	}
+
+	lower := j.Lower > i.Lower || j.Lower == i.Lower && (!i.LowerOpen || j.LowerOpen || math.IsInf(i.Lower, -1))
+	upper := j.Upper < i.Upper || j.Upper == i.Upper && (!i.UpperOpen || j.UpperOpen || math.IsInf(i.Upper, 1))
+	return lower && upper
+}
+
+// Check if the interval and j share at least one point.
+func (i Interval) Overlaps(j Interval) bool {
+	return !i.Intersect(j).IsEmpty()
+}
+
+// Intersection of the interval and j, which may be empty. At a shared end, the end is open if it is open in
+// either interval.
+func (i Interval) Intersect(j Interval) Interval {
+	r := i
+	if j.Lower > r.Lower || j.Lower == r.Lower && j.LowerOpen {
+		r.Lower, r.LowerOpen = j.Lower, j.LowerOpen || j.Lower == i.Lower && i.LowerOpen
+	}
+
+	if j.Upper < r.Upper || j.Upper == r.Upper && j.UpperOpen {
+		r.Upper, r.UpperOpen = j.Upper, j.UpperOpen || j.Upper == i.Upper && i.UpperOpen
+	}
+
+	return r
+}
+
+// Smallest interval containing both the interval and j. At a shared end, the end is closed if it is closed
+// in either interval. Empty intervals are ignored.
+func (i Interval) Hull(j Interval) Interval {
+	if j.IsEmpty() {
+		return i
+	}
+
+	if i.IsEmpty() {
+		return j
+	}
+
+	r := i
+	if j.Lower < r.Lower || j.Lower == r.Lower && !j.LowerOpen {
+		r.Lower, r.LowerOpen = j.Lower, j.LowerOpen
+	}
+
+	if j.Upper > r.Upper || j.Upper == r.Upper && !j.UpperOpen {
+		r.Upper, r.UpperOpen = j.Upper, j.UpperOpen
+	}
+
+	return r
+}
+
+// Union of the interval and j. The union is an interval only if they overlap or meet at an end that belongs
+// to one of them, otherwise ok is false and the Hull is returned.
+func (i Interval) Union(j Interval) (u Interval, ok bool) {
+	u = i.Hull(j)
+	if i.IsEmpty() || j.IsEmpty() || i.Overlaps(j) {
+		return u, true
+	}
+
+	// adjacent, e.g. [a,b) and [b,c]
+	if i.Upper == j.Lower && !(i.UpperOpen && j.LowerOpen) || j.Upper == i.Lower && !(j.UpperOpen && i.LowerOpen) {
+		return u, true
+	}
+
+	return u, false
+}
+
+// Length (width) of the interval, Upper - Lower, and 0 if it is empty.
+func (i Interval) Length() float64 {
+	if i.IsEmpty() {
+		return 0
+	}
+
+	return i.Upper - i.Lower
+}
+
+// Midpoint of the interval, which is infinite if it is unbounded on one side only, and NaN if it is
+// unbounded on both sides or empty.
+func (i Interval) Midpoint() float64 {
+	if i.IsEmpty() {
+		return math.NaN()
+	}
+
+	// halves first, to avoid overflow
+	return i.Lower/2 + i.Upper/2
+}
+
+// Nearest point to x in the closure of the interval. Open ends are returned as they are, since the interval
+// has no nearest point to them. x is returned unchanged if the interval is empty or x is NaN.
+func (i Interval) Clamp(x float64) float64 {
+	if i.IsEmpty() {
+		return x
+	}
+
+	if x < i.Lower {
+		return i.Lower
+	}
+
+	if x > i.Upper {
+		return i.Upper
+	}
+
+	return x
+}
+
+// The interval with both ends closed, except infinite ones.
+func (i Interval) Closure() Interval {
+	return Interval{i.Lower, i.Upper, math.IsInf(i.Lower, -1), math.IsInf(i.Upper, 1)}
+}
+
+// The interval with both ends open.
+func (i Interval) Interior() Interval {
+	return Interval{i.Lower, i.Upper, true, true}
+}
+
+// The same set of points written in a canonical way: infinite ends are open, and every empty interval
+// becomes (0,0).
+func (i Interval) Normalize() Interval {
+	if i.IsEmpty() {
+		return Interval{0, 0, true, true}
+	}
+
+	if math.IsInf(i.Lower, -1) {
+		i.LowerOpen = true
+	}
+
+	if math.IsInf(i.Upper, 1) {
+		i.UpperOpen = true
+	}
+
+	return i
+}
+
+// Check if i and j hold the same set of points.
+func (i Interval) Equal(j Interval) bool {
+	return i.Normalize() == j.Normalize()
+}
+
+// ParseInterval reads an interval in the notation printed by String, such as "[0,1)", "(-∞,∞)" or
+// "[1.5, Inf]". Infinite ends may be written as ∞, +∞, -∞, Inf, +Inf or -Inf.
+func ParseInterval(s string) (Interval, error) {
+	var i Interval
+	t := strings.TrimSpace(s)
+	if len(t) < 2 {
+		return i, err.New(err.EINVAL, fmt.Sprintf("%q is not an interval", s))
+	}
+
+	switch t[0] {
+	case '[':
+	case '(':
+		i.LowerOpen = true
+	default:
+		return i, err.New(err.EINVAL, fmt.Sprintf("%q is not an interval", s))
+	}
+
+	switch t[len(t)-1] {
+	case ']':
+	case ')':
+		i.UpperOpen = true
+	default:
+		return i, err.New(err.EINVAL, fmt.Sprintf("%q is not an interval", s))
+	}
+
+	ends := strings.Split(t[1:len(t)-1], ",")
+	if len(ends) != 2 {
+		return i, err.New(err.EINVAL, fmt.Sprintf("%q is not an interval", s))
+	}
+
+	var e error
+	if i.Lower, e = parseEnd(ends[0]); e != nil {
+		return i, err.New(err.EINVAL, fmt.Sprintf("%q is not an interval", s))
+	}
+
+	if i.Upper, e = parseEnd(ends[1]); e != nil {
+		return i, err.New(err.EINVAL, fmt.Sprintf("%q is not an interval", s))
+	}
+
+	return i, nil
+}
+
+func parseEnd(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	switch s {
+	case "∞", "+∞":
+		return math.Inf(1), nil
+	case "-∞":
+		return math.Inf(-1), nil
+	}
+
+	return strconv.ParseFloat(s, 64)
+}
+
+func (i Interval) String() string {
+	l_temp := fmt.Sprintf("%v", i.Lower)
+
+	if math.IsInf(i.Lower, 1) {
+		l_temp = "∞"
+	}
+
+	if math.IsInf(i.Lower, -1) {
+		l_temp = "-∞"
+	}
+
+	u_temp := fmt.Sprintf("%v", i.Upper)
+
+	if math.IsInf(i.Upper, -1) {
+		u_temp = "-∞"
+	}
+
+	if math.IsInf(i.Upper, 1) {
+		u_temp = "∞"
+	}
+
+	str := "(" + l_temp + "," + u_temp + ")"
+
+	if !i.LowerOpen {
+		str = "[" + str[1:]
+	}
+
+	if !i.UpperOpen {
+		str = str[:len(str)-1] + "]"
+	}
+
+	return str
+}
@@ -0,0 +1,224 @@
+package stats
+
+import (
+	"github.com/jtejido/stats/err"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var inf = math.Inf(1)
+
+func TestIntervalIsEmpty(t *testing.T) {
+	cases := []struct {
+		i        Interval
+		expected bool
+	}{
+		{Interval{0, 1, false, false}, false},
+		{Interval{1, 1, false, false}, false},
+		{Interval{1, 1, true, false}, true},
+		{Interval{1, 1, false, true}, true},
+		{Interval{2, 1, false, false}, true},
+		{Interval{math.NaN(), 1, false, false}, true},
+		{Interval{-inf, inf, true, true}, false},
+		{Interval{inf, inf, false, false}, true},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			if res := c.i.IsEmpty(); res != c.expected {
+				t.Errorf("Mismatch. Case %d, %v want: %v, got: %v", i, c.i, c.expected, res)
+			}
+		})
+	}
+}
+
+func TestIntervalIntersect(t *testing.T) {
+	cases := []struct {
+		a, b, expected Interval
+	}{
+		{Interval{0, 2, false, false}, Interval{1, 3, false, false}, Interval{1, 2, false, false}},
+		{Interval{0, 2, false, true}, Interval{1, 2, false, false}, Interval{1, 2, false, true}},
+		{Interval{0, 2, false, false}, Interval{0, 2, true, false}, Interval{0, 2, true, false}},
+		{Interval{-inf, inf, true, true}, Interval{0, inf, false, true}, Interval{0, inf, false, true}},
+		{Interval{0, 1, false, false}, Interval{1, 2, false, false}, Interval{1, 1, false, false}},
+		{Interval{0, 1, false, true}, Interval{1, 2, false, false}, Interval{1, 1, false, true}},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			if res := c.a.Intersect(c.b); res != c.expected {
+				t.Errorf("Mismatch. Case %d, want: %v, got: %v", i, c.expected, res)
+			}
+
+			if res := c.b.Intersect(c.a); res != c.expected {
+				t.Errorf("Mismatch. Case %d (swapped), want: %v, got: %v", i, c.expected, res)
+			}
+		})
+	}
+}
+
+func TestIntervalUnion(t *testing.T) {
+	cases := []struct {
+		a, b, expected Interval
+		ok             bool
+	}{
+		{Interval{0, 2, false, false}, Interval{1, 3, false, true}, Interval{0, 3, false, true}, true},
+		{Interval{0, 1, false, true}, Interval{1, 2, false, false}, Interval{0, 2, false, false}, true},
+		{Interval{0, 1, false, true}, Interval{1, 2, true, false}, Interval{0, 2, false, false}, false},
+		{Interval{0, 1, false, false}, Interval{2, 3, false, false}, Interval{0, 3, false, false}, false},
+		{Interval{0, 1, true, false}, Interval{0, 1, false, true}, Interval{0, 1, false, false}, true},
+		{Interval{0, 1, false, false}, Interval{5, 4, false, false}, Interval{0, 1, false, false}, true},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			res, ok := c.a.Union(c.b)
+			if res != c.expected || ok != c.ok {
+				t.Errorf("Mismatch. Case %d, want: %v %v, got: %v %v", i, c.expected, c.ok, res, ok)
+			}
+
+			res, ok = c.b.Union(c.a)
+			if res != c.expected || ok != c.ok {
+				t.Errorf("Mismatch. Case %d (swapped), want: %v %v, got: %v %v", i, c.expected, c.ok, res, ok)
+			}
+		})
+	}
+}
+
+func TestIntervalContainsInterval(t *testing.T) {
+	cases := []struct {
+		a, b     Interval
+		expected bool
+	}{
+		{Interval{0, 2, false, false}, Interval{0, 2, true, true}, true},
+		{Interval{0, 2, true, true}, Interval{0, 2, false, false}, false},
+		{Interval{0, 2, true, false}, Interval{0, 1, false, false}, false},
+		{Interval{-inf, inf, false, false}, Interval{-inf, 0, true, false}, true},
+		{Interval{-inf, inf, true, true}, Interval{-inf, 0, false, false}, true},
+		{Interval{0, 1, false, false}, Interval{3, 2, false, false}, true},
+		{Interval{0, 1, false, false}, Interval{0, 1.5, false, false}, false},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			if res := c.a.ContainsInterval(c.b); res != c.expected {
+				t.Errorf("Mismatch. Case %d, want: %v, got: %v", i, c.expected, res)
+			}
+		})
+	}
+}
+
+func TestIntervalMeasures(t *testing.T) {
+	cases := []struct {
+		i                Interval
+		length, midpoint float64
+		clampLo, clampHi float64
+	}{
+		{Interval{-1, 3, false, true}, 4, 1, -1, 3},
+		{Interval{0, inf, false, true}, inf, inf, 0, 10},
+		{Interval{-math.MaxFloat64, math.MaxFloat64, false, false}, inf, 0, -10, 10},
+		{Interval{2, 1, false, false}, 0, math.NaN(), -10, 10},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			if res := c.i.Length(); res != c.length {
+				t.Errorf("Length mismatch. Case %d, want: %v, got: %v", i, c.length, res)
+			}
+
+			if res := c.i.Midpoint(); res != c.midpoint && !(math.IsNaN(res) && math.IsNaN(c.midpoint)) {
+				t.Errorf("Midpoint mismatch. Case %d, want: %v, got: %v", i, c.midpoint, res)
+			}
+
+			if res := c.i.Clamp(-10); res != c.clampLo {
+				t.Errorf("Clamp mismatch. Case %d, want: %v, got: %v", i, c.clampLo, res)
+			}
+
+			if res := c.i.Clamp(10); res != c.clampHi {
+				t.Errorf("Clamp mismatch. Case %d, want: %v, got: %v", i, c.clampHi, res)
+			}
+		})
+	}
+}
+
+func TestIntervalIsEqualLength(t *testing.T) {
+	cases := []struct {
+		i        Interval
+		length   float64
+		expected bool
+	}{
+		{Interval{-math.Pi, math.Pi, false, false}, 2 * math.Pi, true},
+		{Interval{-math.Pi, math.Pi, true, true}, 2 * math.Pi, true},
+		{Interval{.1, .1 + 2*math.Pi, false, false}, 2 * math.Pi, true},
+		{Interval{1e3, 1e3 + 2*math.Pi, false, false}, 2 * math.Pi, true},
+		{Interval{0, 6.28, false, false}, 2 * math.Pi, false},
+		{Interval{0, inf, false, true}, inf, true},
+		{Interval{0, 1, false, false}, math.NaN(), false},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			if res := c.i.IsEqualLength(c.length); res != c.expected {
+				t.Errorf("Mismatch. Case %d, want: %v, got: %v", i, c.expected, res)
+			}
+		})
+	}
+}
+
+func TestIntervalNormalize(t *testing.T) {
+	cases := []struct {
+		i, expected Interval
+	}{
+		{Interval{-inf, 0, false, false}, Interval{-inf, 0, true, false}},
+		{Interval{0, inf, false, false}, Interval{0, inf, false, true}},
+		{Interval{3, 2, false, false}, Interval{0, 0, true, true}},
+		{Interval{1, 2, false, true}, Interval{1, 2, false, true}},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			if res := c.i.Normalize(); res != c.expected {
+				t.Errorf("Mismatch. Case %d, want: %v, got: %v", i, c.expected, res)
+			}
+		})
+	}
+
+	if i := (Interval{1, 2, true, true}); i.Closure() != (Interval{1, 2, false, false}) || i.Closure().Interior() != i {
+		t.Errorf("Closure and Interior mismatch")
+	}
+
+	if !(Interval{-inf, 1, false, false}).Equal(Interval{-inf, 1, true, false}) {
+		t.Errorf("Equal mismatch")
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	cases := []Interval{
+		{0, 1, false, false},
+		{-1.5, 2e10, true, false},
+		{-inf, inf, true, true},
+		{0, inf, false, true},
+		{-math.Pi, math.Pi, false, true},
+	}
+
+	for i, c := range cases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			res, e := ParseInterval(c.String())
+			if e != nil || res != c {
+				t.Errorf("Mismatch. Case %d, want: %v, got: %v (%v)", i, c, res, e)
+			}
+		})
+	}
+
+	if res, e := ParseInterval(" ( -Inf , +Inf ] "); e != nil || res != (Interval{-inf, inf, true, false}) {
+		t.Errorf("Mismatch. got: %v (%v)", res, e)
+	}
+
+	// returned without raising them, so that none of these panics under the default handler
+	for _, s := range []string{"", "[0,1", "0,1]", "[0;1]", "[0,1,2]", "[a,1]", "{0,1}"} {
+		if res, e := ParseInterval(s); e == nil || e.(err.StatsError).Status() != err.EINVAL {
+			t.Errorf("ParseInterval(%q) want EINVAL, got: %v (%v)", s, res, e)
+		}
+	}
+}
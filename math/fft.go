@@ -0,0 +1,68 @@
+package math
+
+import (
+	"fmt"
+	"github.com/jtejido/stats/err"
+	gomath "math"
+	"math/bits"
+	"math/cmplx"
+)
+
+// FFT returns the discrete Fourier transform Σ xₖ exp(-2πijk/n) of x, whose length n must be a power of
+// 2, by the iterative radix-2 Cooley-Tukey algorithm. x is left unchanged. Any other length is an
+// EBADLEN error.
+func FFT(x []complex128) ([]complex128, error) {
+	return fft(x, -1)
+}
+
+// InverseFFT returns the inverse discrete Fourier transform (1/n) Σ xₖ exp(2πijk/n) of x, whose length n
+// must be a power of 2, so that InverseFFT(FFT(x)) = x.
+func InverseFFT(x []complex128) ([]complex128, error) {
+	y, e := fft(x, 1)
+	if e != nil {
+		return nil, e
+	}
+
+	for i := range y {
+		y[i] /= complex(float64(len(y)), 0)
+	}
+
+	return y, nil
+}
+
+func fft(x []complex128, sign float64) ([]complex128, error) {
+	n := len(x)
+	if n == 0 || n&(n-1) != 0 {
+		return nil, err.New(err.EBADLEN, fmt.Sprintf("FFT: length %v is not a power of 2", n))
+	}
+
+	// bit reversed copy
+	y := make([]complex128, n)
+	shift := uint(64 - bits.TrailingZeros(uint(n)))
+	for i, v := range x {
+		if n == 1 {
+			y[0] = v
+			break
+		}
+		y[bits.Reverse64(uint64(i))>>shift] = v
+	}
+
+	for size := 2; size <= n; size <<= 1 {
+		w := cmplx.Rect(1, sign*2*gomath.Pi/float64(size))
+		for start := 0; start < n; start += size {
+			wk := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				// recomputing the twiddle every 16 steps bounds the rounding of the recurrence
+				if k&15 == 0 {
+					wk = cmplx.Rect(1, sign*2*gomath.Pi*float64(k)/float64(size))
+				}
+
+				a, b := y[start+k], wk*y[start+k+size/2]
+				y[start+k], y[start+k+size/2] = a+b, a-b
+				wk *= w
+			}
+		}
+	}
+
+	return y, nil
+}
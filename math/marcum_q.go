@@ -1,8 +1,10 @@
 package math
 
 import (
+	"fmt"
 	gsl "github.com/jtejido/ggsl"
 	sf "github.com/jtejido/ggsl/specfunc"
+	"github.com/jtejido/stats/err"
 	gomath "math"
 )
 
@@ -39,6 +41,12 @@ import (
  *     on Information Theory, vol. 37, no. 4. pg. 1233, Jul. 1991.
  **/
 func MarcumQ(mu, alpha, beta float64) float64 {
+	return MarcumQWithConfig(mu, alpha, beta, nil)
+}
+
+// MarcumQWithConfig is MarcumQ reporting series that fail to converge, and results clamped into [0, 1],
+// to the Diagnostics of c rather than to the installed collector. A nil c follows the package defaults.
+func MarcumQWithConfig(mu, alpha, beta float64, c *err.Config) float64 {
 	if mu <= 0 || alpha < 0 || beta < 0 {
 		panic("Invalid Inputs")
 	}
@@ -55,13 +63,13 @@ func MarcumQ(mu, alpha, beta float64) float64 {
 	fix := int(mu)
 	diff := mu - float64(fix)
 	if (diff == 0.5) || (diff == 0) {
-		return benton(mu, alpha, beta)
+		return benton(mu, alpha, beta, c)
 	}
 
 	// If mu is not a multiple of 0.5, then use equivalency of the Marcum Q
 	// function with the noncentral chi square CDF and therefore the noncentral
 	// gamma CDF to compute the result.
-	return 1 - ncGammaDis(gomath.Pow(beta, 2), mu, 2, gomath.Pow(alpha, 2))
+	return 1 - ncGammaDis(gomath.Pow(beta, 2), mu, 2, gomath.Pow(alpha, 2), c)
 }
 
 // Evaluate the cumulative distribution function of the noncentral gamma distribution at desired points.
@@ -81,7 +89,7 @@ func MarcumQ(mu, alpha, beta float64) float64 {
 //     pp.1224-1231, Sep. 1996.
 //
 // from TrackerComponentLibrary in Distributions/GammaD.m. December 2014 David A. Karnick, Naval Research Laboratory, Washington D.C.
-func ncGammaDis(x, k, theta, lambda float64) float64 {
+func ncGammaDis(x, k, theta, lambda float64, c *err.Config) float64 {
 	maxIter := 5000
 
 	x = x / theta
@@ -100,6 +108,7 @@ func ncGammaDis(x, k, theta, lambda float64) float64 {
 	remain := 1 - pp
 	ii := 1.0
 	cdf := pp * gammap
+	var errest float64
 
 	for {
 		gxp = gxp * x / (a + ii - 1)
@@ -110,6 +119,7 @@ func ncGammaDis(x, k, theta, lambda float64) float64 {
 		remain = remain - pp
 		if ii > m {
 			if er <= gsl.Float64Eps || int(ii) > maxIter {
+				errest = er
 				break
 			}
 		} else {
@@ -119,6 +129,7 @@ func ncGammaDis(x, k, theta, lambda float64) float64 {
 			cdf = cdf + pr*gammar
 			remain = remain - pr
 			if remain <= gsl.Float64Eps || int(ii) > maxIter {
+				errest = remain
 				break
 			}
 
@@ -127,6 +138,10 @@ func ncGammaDis(x, k, theta, lambda float64) float64 {
 		ii++
 	}
 
+	if errest > gsl.Float64Eps {
+		c.Report(fmt.Sprintf("MarcumQ: series did not converge in %d terms", maxIter), err.EMAXITER, 1-cdf, errest)
+	}
+
 	return cdf
 }
 
@@ -140,7 +155,7 @@ func ncGammaDis(x, k, theta, lambda float64) float64 {
 // eps(sumVal) and some code for the fact that we want Q and not 1-Q is
 // added as well as the translation of the input parameters
 // into the form for the noncentral Chi-Squared distribution.
-func benton(mu, alpha, beta float64) float64 {
+func benton(mu, alpha, beta float64, c *err.Config) float64 {
 	maxIter := 5000
 	n := 2 * mu
 	lambda := alpha * alpha
@@ -189,7 +204,7 @@ func benton(mu, alpha, beta float64) float64 {
 			if errorVal <= ulp(sumVal) {
 				break
 			} else if i > float64(maxIter) {
-				// warning('Maximum number of iterations Exceeded. Unable to meet the error tolerance.')
+				c.Report(fmt.Sprintf("MarcumQ: series did not converge in %d terms", maxIter), err.EMAXITER, gomath.Min(gomath.Max(1-sumVal, 0), 1), errorVal)
 				break
 			} else {
 				i = i + 1
@@ -203,7 +218,7 @@ func benton(mu, alpha, beta float64) float64 {
 			if remain <= ulp(sumVal) {
 				break
 			} else if i > float64(maxIter) {
-				// warning('Maximum number of iterations Exceeded. Unable to meet the error tolerance.')
+				c.Report(fmt.Sprintf("MarcumQ: series did not converge in %d terms", maxIter), err.EMAXITER, gomath.Min(gomath.Max(1-sumVal, 0), 1), remain)
 				break
 			} else {
 				i = i + 1
@@ -215,7 +230,12 @@ func benton(mu, alpha, beta float64) float64 {
 	// The max deals with negative values within precision bounds.
 	Q := gomath.Max(1-P, 0)
 	// The min deals with positive values within precision bounds.
-	return gomath.Min(Q, 1)
+	Q = gomath.Min(Q, 1)
+	if d := gomath.Abs(1 - P - Q); d > ulp(1) {
+		c.Report(fmt.Sprintf("MarcumQ: %v clamped into [0, 1]", 1-P), err.ERANGE, Q, d)
+	}
+
+	return Q
 
 }
 
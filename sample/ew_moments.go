@@ -0,0 +1,123 @@
+package sample
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jtejido/stats/err"
+	"math"
+)
+
+// EWMoments accumulates exponentially weighted moments of a stream: each observation added discounts
+// the weight of all earlier ones by 1-α. The mean is bias corrected, so that the first observation is
+// the mean rather than being shrunk towards 0, and approaches the usual exponential moving average
+// (1-α)m + αx as the stream grows. It is not safe for concurrent use.
+type EWMoments struct {
+	alpha       float64
+	w, mean, s2 float64 // total discounted weight, mean, discounted sum of squared deviations
+}
+
+// NewEWMoments returns an accumulator giving weight α ∈ (0,1] to each new observation.
+func NewEWMoments(alpha float64) (*EWMoments, error) {
+	if !(alpha > 0 && alpha <= 1) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("EWMoments: α = %v is outside (0,1]", alpha))
+	}
+
+	return &EWMoments{alpha: alpha}, nil
+}
+
+// NewEWMomentsHalfLife returns an accumulator in which an observation loses half its weight after h
+// more are added.
+func NewEWMomentsHalfLife(h float64) (*EWMoments, error) {
+	if !(h > 0) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("EWMoments: half-life %v is not positive", h))
+	}
+
+	return NewEWMoments(-math.Expm1(-math.Ln2 / h))
+}
+
+// Alpha returns the weight given to each new observation.
+func (m *EWMoments) Alpha() float64 {
+	return m.alpha
+}
+
+// Add adds x, discounting the earlier observations.
+func (m *EWMoments) Add(x float64) {
+	m.w = (1-m.alpha)*m.w + 1
+	d := x - m.mean
+	m.mean += d / m.w
+	m.s2 = (1-m.alpha)*m.s2 + d*(x-m.mean)
+}
+
+// Merge adds the observations of o to m, as if the two streams had been added in step: both are taken
+// to have aged alike, as when each goroutine keeps one of a stream split evenly. The accumulators must
+// have the same α.
+func (m *EWMoments) Merge(o *EWMoments) error {
+	if o.alpha != m.alpha {
+		return err.New(err.EINVAL, fmt.Sprintf("EWMoments: cannot merge α = %v into α = %v", o.alpha, m.alpha))
+	}
+
+	if o.w == 0 {
+		return nil
+	}
+
+	n := m.w + o.w
+	d := o.mean - m.mean
+	m.s2 += o.s2 + d*d*m.w*o.w/n
+	m.mean += d * o.w / n
+	m.w = n
+	return nil
+}
+
+// Count returns the total discounted weight, which tends to 1/α.
+func (m *EWMoments) Count() float64 {
+	return m.w
+}
+
+// Mean returns the exponentially weighted mean, NaN if nothing was added.
+func (m *EWMoments) Mean() float64 {
+	if m.w == 0 {
+		return math.NaN()
+	}
+
+	return m.mean
+}
+
+// Variance returns the exponentially weighted variance, the discounted sum of squared deviations from
+// the mean divided by the discounted weight.
+func (m *EWMoments) Variance() float64 {
+	if m.w == 0 {
+		return math.NaN()
+	}
+
+	return m.s2 / m.w
+}
+
+// StdDev returns the square root of Variance.
+func (m *EWMoments) StdDev() float64 {
+	return math.Sqrt(m.Variance())
+}
+
+type ewMomentsJSON struct {
+	Alpha float64 `json:"alpha"`
+	Count float64 `json:"count"`
+	Mean  float64 `json:"mean"`
+	S2    float64 `json:"s2"`
+}
+
+func (m *EWMoments) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ewMomentsJSON{m.alpha, m.w, m.mean, m.s2})
+}
+
+func (m *EWMoments) UnmarshalJSON(b []byte) error {
+	var s ewMomentsJSON
+	if e := json.Unmarshal(b, &s); e != nil {
+		return e
+	}
+
+	if !(s.Alpha > 0 && s.Alpha <= 1) {
+		return err.New(err.EINVAL, fmt.Sprintf("EWMoments: α = %v is outside (0,1]", s.Alpha))
+	}
+
+	*m = EWMoments{s.Alpha, s.Count, s.Mean, s.S2}
+	return nil
+}
@@ -0,0 +1,94 @@
+package sample
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestEWMoments(t *testing.T) {
+	tol := 1e-13
+	alpha := .2
+	m, _ := NewEWMoments(alpha)
+	for _, x := range data {
+		m.Add(x)
+	}
+
+	// directly from the weights (1-α)^age
+	var w, s, s2 float64
+	for i, x := range data {
+		wi := math.Pow(1-alpha, float64(len(data)-1-i))
+		w += wi
+		s += wi * x
+	}
+	mean := s / w
+	for i, x := range data {
+		s2 += math.Pow(1-alpha, float64(len(data)-1-i)) * (x - mean) * (x - mean)
+	}
+
+	if math.Abs(m.Count()-w) > tol*w || math.Abs(m.Mean()-mean) > tol*mean || math.Abs(m.Variance()-s2/w) > tol*s2/w {
+		t.Errorf("Mismatch. want: %v, %v, %v, got: %v, %v, %v", w, mean, s2/w, m.Count(), m.Mean(), m.Variance())
+	}
+
+	// a long constant stream forgets what came before
+	for i := 0; i < 500; i++ {
+		m.Add(3)
+	}
+	if math.Abs(m.Mean()-3) > tol || m.Variance() > tol || math.Abs(m.Count()-1/alpha) > tol {
+		t.Errorf("Mismatch. want: 3, 0, %v, got: %v, %v, %v", 1/alpha, m.Mean(), m.Variance(), m.Count())
+	}
+
+	h, _ := NewEWMomentsHalfLife(5)
+	if want := -math.Expm1(-math.Ln2 / 5); h.Alpha() != want {
+		t.Errorf("Mismatch. half-life α want: %v, got: %v", want, h.Alpha())
+	}
+
+	for _, a := range []float64{0, -.1, 1.5, math.NaN()} {
+		if _, e := NewEWMoments(a); e == nil {
+			t.Errorf("Mismatch. NewEWMoments(%v) want: error, got: nil", a)
+		}
+	}
+}
+
+func TestEWMomentsMergeJSON(t *testing.T) {
+	tol := 1e-13
+	a, _ := NewEWMoments(.1)
+	b, _ := NewEWMoments(.1)
+	all, _ := NewEWMoments(.1)
+	for _, x := range data {
+		all.Add(x)
+	}
+	for _, x := range data[:4] {
+		a.Add(x)
+	}
+
+	js, _ := json.Marshal(a)
+	var r EWMoments
+	if e := json.Unmarshal(js, &r); e != nil {
+		t.Fatal(e)
+	}
+
+	// a stream split evenly, aged alike, merges into one of the same weight
+	for _, x := range data[:5] {
+		b.Add(x)
+	}
+	c, _ := NewEWMoments(.1)
+	for _, x := range data[5:] {
+		c.Add(x)
+	}
+	if e := b.Merge(c); e != nil {
+		t.Fatal(e)
+	}
+	if want := 2 * (1 - math.Pow(.9, 5)) / .1; math.Abs(b.Count()-want) > tol*want {
+		t.Errorf("Mismatch. merged Count want: %v, got: %v", want, b.Count())
+	}
+
+	if r.Alpha() != .1 || r.Mean() != a.Mean() || r.Variance() != a.Variance() {
+		t.Errorf("Mismatch. round trip want: %v, got: %v", a, &r)
+	}
+
+	d, _ := NewEWMoments(.2)
+	if e := a.Merge(d); e == nil {
+		t.Errorf("Mismatch. merging α = .2 into α = .1 want: error, got: nil")
+	}
+}
@@ -0,0 +1,251 @@
+package sample
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jtejido/stats/err"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// DefaultKLLSize is a size k of KLL giving a rank error within about 1.3% with probability .99.
+const DefaultKLLSize = 200
+
+// KLL is the quantile sketch of Karnin, Lang and Liberty: a hierarchy of compactors, each halving the
+// observations it holds into the next, sampling the odd or even ones of them at random, so that an item
+// at level h stands for 2ʰ observations. With capacities shrinking by 2/3 from the top level down, its
+// rank error falls as 1/k uniformly over quantiles, in O(k) memory. Its cdf is the step function of
+// the retained items, with the exact minimum and maximum at 0 and 1. It is not safe for concurrent use.
+//
+// Z. Karnin, K. Lang and E. Liberty, "Optimal quantile approximation in streams," in 2016 IEEE 57th
+// Annual Symposium on Foundations of Computer Science, pp. 71-78, 2016.
+type KLL struct {
+	k        int
+	levels   [][]float64 // items of level h weigh 2ʰ
+	count    float64
+	min, max float64
+	src      rand.Source
+}
+
+// NewKLL returns an empty KLL sketch of size k ≥ 2.
+func NewKLL(k int) (*KLL, error) {
+	return NewKLLWithSource(k, nil)
+}
+
+// NewKLLWithSource returns an empty KLL sketch of size k ≥ 2, compacting at random by src.
+func NewKLLWithSource(k int, src rand.Source) (*KLL, error) {
+	if k < 2 {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("KLL: size %v is below 2", k))
+	}
+
+	return &KLL{k: k, levels: make([][]float64, 1), min: math.Inf(1), max: math.Inf(-1), src: src}, nil
+}
+
+// Size returns k.
+func (s *KLL) Size() int {
+	return s.k
+}
+
+// Add adds x, ignoring NaN.
+func (s *KLL) Add(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+
+	s.levels[0] = append(s.levels[0], x)
+	s.count++
+	s.min, s.max = math.Min(s.min, x), math.Max(s.max, x)
+	s.compress()
+}
+
+// Merge adds the observations summarised by o to s, which keeps its size.
+func (s *KLL) Merge(o *KLL) {
+	for h, items := range o.levels {
+		if h == len(s.levels) {
+			s.levels = append(s.levels, nil)
+		}
+		s.levels[h] = append(s.levels[h], items...)
+	}
+
+	s.count += o.count
+	s.min, s.max = math.Min(s.min, o.min), math.Max(s.max, o.max)
+	s.compress()
+}
+
+// Count returns the number of observations added.
+func (s *KLL) Count() float64 {
+	return s.count
+}
+
+// Capacity of level h, k(2/3)^(depth below the top level), but at least 2.
+func (s *KLL) capacity(h int) int {
+	depth := len(s.levels) - 1 - h
+	return int(math.Max(2, math.Ceil(float64(s.k)*math.Pow(2./3, float64(depth)))))
+}
+
+// Compacts the lowest full level until the items fit within the capacity of all levels.
+func (s *KLL) compress() {
+	for {
+		var size, capacity int
+		for h, items := range s.levels {
+			size += len(items)
+			capacity += s.capacity(h)
+		}
+
+		if size < capacity {
+			return
+		}
+
+		for h, items := range s.levels {
+			if len(items) < s.capacity(h) {
+				continue
+			}
+
+			if h+1 == len(s.levels) {
+				s.levels = append(s.levels, nil)
+			}
+
+			// an odd item out stays behind
+			sort.Float64s(items)
+			odd := len(items) % 2
+			for i := s.coin() + odd; i < len(items); i += 2 {
+				s.levels[h+1] = append(s.levels[h+1], items[i])
+			}
+			s.levels[h] = append(items[:0], items[:odd]...)
+			break
+		}
+	}
+}
+
+func (s *KLL) coin() int {
+	if s.src != nil {
+		return int(s.src.Int63() & 1)
+	}
+
+	return int(rand.Int63() & 1)
+}
+
+// The retained items, sorted, with their cumulative weights.
+func (s *KLL) ordered() ordered {
+	var x, w []float64
+	for h, items := range s.levels {
+		for _, v := range items {
+			x = append(x, v)
+			w = append(w, math.Ldexp(1, h))
+		}
+	}
+
+	o, _ := order(x, w)
+	return o
+}
+
+// Quantile returns the p-quantile of the sketch, the first retained item at which the cumulative weight
+// reaches p times the count, or the exact minimum and maximum at p = 0 and 1. It is NaN if the sketch is
+// empty.
+func (s *KLL) Quantile(p float64) float64 {
+	switch {
+	case s.count == 0 || !(p >= 0 && p <= 1):
+		return math.NaN()
+	case p == 0:
+		return s.min
+	case p == 1:
+		return s.max
+	}
+
+	o := s.ordered()
+	if len(o.x) == 0 {
+		return math.NaN()
+	}
+
+	return o.stat(p * o.total())
+}
+
+// Inverse returns Quantile(p).
+func (s *KLL) Inverse(p float64) float64 {
+	return s.Quantile(p)
+}
+
+// Rank returns the weight of the retained items at or below x, estimating the number of observations
+// that are.
+func (s *KLL) Rank(x float64) float64 {
+	if s.count == 0 || math.IsNaN(x) {
+		return math.NaN()
+	}
+
+	o := s.ordered()
+	i := sort.Search(len(o.x), func(i int) bool { return o.x[i] > x })
+	if i == 0 {
+		return 0
+	}
+
+	return o.cum[i-1]
+}
+
+// Distribution returns the cdf of the sketch at x, NaN if it is empty.
+func (s *KLL) Distribution(x float64) float64 {
+	return s.Rank(x) / s.count
+}
+
+func (s *KLL) breakpoints() (x, lower, upper []float64) {
+	o := s.ordered()
+	if len(o.x) == 0 {
+		return nil, nil, nil
+	}
+
+	lower = make([]float64, len(o.x))
+	upper = make([]float64, len(o.x))
+	prev := 0.
+	for i, c := range o.cum {
+		lower[i], upper[i] = prev/s.count, c/s.count
+		prev = c
+	}
+
+	return o.x, lower, upper
+}
+
+type kllJSON struct {
+	K      int         `json:"k"`
+	Count  float64     `json:"count"`
+	Min    float64     `json:"min"`
+	Max    float64     `json:"max"`
+	Levels [][]float64 `json:"levels"`
+}
+
+// MarshalJSON encodes the state of the sketch, but not its source.
+func (s *KLL) MarshalJSON() ([]byte, error) {
+	r := kllJSON{s.k, s.count, s.min, s.max, s.levels}
+	if s.count == 0 {
+		r.Min, r.Max = 0, 0
+	}
+
+	return json.Marshal(r)
+}
+
+// UnmarshalJSON decodes the state of a sketch, keeping the source of s.
+func (s *KLL) UnmarshalJSON(b []byte) error {
+	var r kllJSON
+	if ee := json.Unmarshal(b, &r); ee != nil {
+		return ee
+	}
+
+	n, ee := NewKLLWithSource(r.K, s.src)
+	if ee != nil {
+		return ee
+	}
+
+	var total float64
+	for h, items := range r.Levels {
+		total += float64(len(items)) * math.Ldexp(1, h)
+	}
+	if total != r.Count {
+		return err.New(err.EINVAL, fmt.Sprintf("KLL: levels weigh %v, not the count %v", total, r.Count))
+	}
+
+	if r.Count > 0 {
+		n.levels, n.count, n.min, n.max = r.Levels, r.Count, r.Min, r.Max
+	}
+
+	*s = *n
+	return nil
+}
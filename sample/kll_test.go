@@ -0,0 +1,102 @@
+package sample
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestKLL(t *testing.T) {
+	const n = 100000
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, n)
+	s, _ := NewKLLWithSource(DefaultKLLSize, rand.NewSource(2))
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+		s.Add(x[i])
+	}
+
+	var items int
+	for _, l := range s.levels {
+		items += len(l)
+	}
+	if s.Count() != n || items > 3*DefaultKLLSize+2*len(s.levels) {
+		t.Errorf("Mismatch. Count, items want: %v, at most %v, got: %v, %v", n, 3*DefaultKLLSize+2*len(s.levels), s.Count(), items)
+	}
+
+	for _, p := range []float64{.01, .1, .25, .5, .75, .9, .99} {
+		if r := float64(countBelow(x, s.Quantile(p))) / n; math.Abs(r-p) > .013 {
+			t.Errorf("Mismatch. rank of Quantile(%v) want: %v, got: %v", p, p, r)
+		}
+
+		q := Quantile(p, InverseCDF, x, nil)
+		if r := s.Rank(q) / n; math.Abs(r-p) > .013 || s.Distribution(q) != r {
+			t.Errorf("Mismatch. Rank(%v) want: %v, got: %v", q, p*n, s.Rank(q))
+		}
+	}
+
+	if s.Quantile(0) != Quantile(0, Linear, x, nil) || s.Quantile(1) != Quantile(1, Linear, x, nil) {
+		t.Errorf("Mismatch. extremes want: %v, %v, got: %v, %v", Quantile(0, Linear, x, nil), Quantile(1, Linear, x, nil), s.Quantile(0), s.Quantile(1))
+	}
+
+	// exact until the first compaction
+	small, _ := NewKLL(DefaultKLLSize)
+	for _, v := range data {
+		small.Add(v)
+	}
+	for _, p := range []float64{.1, .35, .5, .9} {
+		if want := Quantile(p, InverseCDF, data, nil); small.Quantile(p) != want {
+			t.Errorf("Mismatch. small Quantile(%v) want: %v, got: %v", p, want, small.Quantile(p))
+		}
+	}
+
+	if _, e := NewKLL(1); e == nil {
+		t.Errorf("Mismatch. NewKLL(1) want: error, got: nil")
+	}
+}
+
+func TestKLLMergeJSON(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	var x []float64
+	parts := make([]*KLL, 4)
+	for k := range parts {
+		parts[k], _ = NewKLLWithSource(DefaultKLLSize, rand.NewSource(int64(k)))
+		for i := 0; i < 25000; i++ {
+			v := rnd.ExpFloat64() * float64(k+1)
+			parts[k].Add(v)
+			x = append(x, v)
+		}
+	}
+
+	for _, o := range parts[1:] {
+		parts[0].Merge(o)
+	}
+
+	s := parts[0]
+	for _, p := range []float64{.01, .5, .9, .99} {
+		if r := float64(countBelow(x, s.Quantile(p))) / float64(len(x)); math.Abs(r-p) > .013 {
+			t.Errorf("Mismatch. merged rank of Quantile(%v) want: %v, got: %v", p, p, r)
+		}
+	}
+
+	b, e := json.Marshal(s)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var r KLL
+	if e := json.Unmarshal(b, &r); e != nil {
+		t.Fatal(e)
+	}
+
+	for _, p := range []float64{0, .3, .99, 1} {
+		if r.Quantile(p) != s.Quantile(p) || r.Count() != s.Count() {
+			t.Errorf("Mismatch. round trip Quantile(%v) want: %v, got: %v", p, s.Quantile(p), r.Quantile(p))
+		}
+	}
+
+	if e := json.Unmarshal([]byte(`{"k":200,"count":5,"levels":[[1,2],[3]]}`), &r); e == nil {
+		t.Errorf("Mismatch. levels weighing 4 for a count of 5 want: error, got: nil")
+	}
+}
@@ -0,0 +1,152 @@
+package sample
+
+import (
+	"encoding/json"
+	"math"
+)
+
+// Moments accumulates the count, mean, central moments up to the fourth, minimum and maximum of a
+// stream in a single pass, by Welford's update generalised to higher moments and weights by Pébay. Its
+// statistics agree with those of this package over the same sample. Two accumulators merge into that
+// of their combined streams, so a stream can be split across goroutines, each adding to its own. The
+// zero value is an empty accumulator; it is not safe for concurrent use.
+//
+// P. Pébay, "Formulas for robust, one-pass parallel computation of covariances and arbitrary-order
+// statistical moments," Sandia Report SAND2008-6212, 2008.
+type Moments struct {
+	w, mean, m2, m3, m4 float64 // total weight, mean, sums of powers of deviations from the mean
+	min, max            float64
+}
+
+// Add adds x with weight 1.
+func (m *Moments) Add(x float64) {
+	m.AddWeighted(x, 1)
+}
+
+// AddWeighted adds x with weight w, a frequency weight as elsewhere in this package. A weight that is
+// not positive is ignored.
+func (m *Moments) AddWeighted(x, w float64) {
+	if !(w > 0) {
+		return
+	}
+
+	m.merge(w, x, 0, 0, 0, x, x)
+}
+
+// Merge adds the observations of o to m.
+func (m *Moments) Merge(o *Moments) {
+	if o.w > 0 {
+		m.merge(o.w, o.mean, o.m2, o.m3, o.m4, o.min, o.max)
+	}
+}
+
+func (m *Moments) merge(nb, mb, m2b, m3b, m4b, minb, maxb float64) {
+	na := m.w
+	if na == 0 {
+		m.w, m.mean, m.m2, m.m3, m.m4, m.min, m.max = nb, mb, m2b, m3b, m4b, minb, maxb
+		return
+	}
+
+	n := na + nb
+	d := mb - m.mean
+	dn := d / n
+	m.m4 += m4b + d*dn*dn*dn*na*nb*(na*na-na*nb+nb*nb) + 6*dn*dn*(na*na*m2b+nb*nb*m.m2) + 4*dn*(na*m3b-nb*m.m3)
+	m.m3 += m3b + d*dn*dn*na*nb*(na-nb) + 3*dn*(na*m2b-nb*m.m2)
+	m.m2 += m2b + d*dn*na*nb
+	m.mean += nb * dn
+	m.w = n
+	m.min = math.Min(m.min, minb)
+	m.max = math.Max(m.max, maxb)
+}
+
+// Count returns the total weight added.
+func (m *Moments) Count() float64 {
+	return m.w
+}
+
+// Mean returns the mean, NaN if nothing was added.
+func (m *Moments) Mean() float64 {
+	if m.w == 0 {
+		return math.NaN()
+	}
+
+	return m.mean
+}
+
+// Variance returns the unbiased variance, as Variance.
+func (m *Moments) Variance() float64 {
+	if !(m.w > 1) {
+		return math.NaN()
+	}
+
+	return m.m2 / (m.w - 1)
+}
+
+// StdDev returns the square root of Variance.
+func (m *Moments) StdDev() float64 {
+	return math.Sqrt(m.Variance())
+}
+
+// Skewness returns the adjusted skewness, as Skewness.
+func (m *Moments) Skewness() float64 {
+	n := m.w
+	if !(n > 2) {
+		return math.NaN()
+	}
+
+	g1 := math.Sqrt(n) * m.m3 / math.Pow(m.m2, 1.5)
+	return g1 * math.Sqrt(n*(n-1)) / (n - 2)
+}
+
+// ExKurtosis returns the adjusted excess kurtosis, as ExKurtosis.
+func (m *Moments) ExKurtosis() float64 {
+	n := m.w
+	if !(n > 3) {
+		return math.NaN()
+	}
+
+	g2 := n*m.m4/(m.m2*m.m2) - 3
+	return (n - 1) * ((n+1)*g2 + 6) / ((n - 2) * (n - 3))
+}
+
+// Min returns the smallest value added, NaN if nothing was.
+func (m *Moments) Min() float64 {
+	if m.w == 0 {
+		return math.NaN()
+	}
+
+	return m.min
+}
+
+// Max returns the largest value added, NaN if nothing was.
+func (m *Moments) Max() float64 {
+	if m.w == 0 {
+		return math.NaN()
+	}
+
+	return m.max
+}
+
+type momentsJSON struct {
+	Count float64 `json:"count"`
+	Mean  float64 `json:"mean"`
+	M2    float64 `json:"m2"`
+	M3    float64 `json:"m3"`
+	M4    float64 `json:"m4"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+}
+
+func (m *Moments) MarshalJSON() ([]byte, error) {
+	return json.Marshal(momentsJSON{m.w, m.mean, m.m2, m.m3, m.m4, m.min, m.max})
+}
+
+func (m *Moments) UnmarshalJSON(b []byte) error {
+	var s momentsJSON
+	if e := json.Unmarshal(b, &s); e != nil {
+		return e
+	}
+
+	*m = Moments{s.Count, s.Mean, s.M2, s.M3, s.M4, s.Min, s.Max}
+	return nil
+}
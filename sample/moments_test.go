@@ -0,0 +1,117 @@
+package sample
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestMomentsAccumulator(t *testing.T) {
+	tol := 1e-13
+	weights := []float64{1, 3, 0, 2, 1, 1, 4, 2, 1, 1}
+	var m, mw Moments
+	for i, x := range data {
+		m.Add(x)
+		mw.AddWeighted(x, weights[i])
+	}
+
+	cases := []struct {
+		name     string
+		f        func(x, weights []float64) float64
+		got, gwt float64
+	}{
+		{"Mean", Mean, m.Mean(), mw.Mean()},
+		{"Variance", Variance, m.Variance(), mw.Variance()},
+		{"StdDev", StdDev, m.StdDev(), mw.StdDev()},
+		{"Skewness", Skewness, m.Skewness(), mw.Skewness()},
+		{"ExKurtosis", ExKurtosis, m.ExKurtosis(), mw.ExKurtosis()},
+	}
+
+	for _, c := range cases {
+		if want := c.f(data, nil); math.Abs(c.got-want) > tol*math.Abs(want) {
+			t.Errorf("Mismatch. %s want: %v, got: %v", c.name, want, c.got)
+		}
+
+		if want := c.f(data, weights); math.Abs(c.gwt-want) > tol*math.Abs(want) {
+			t.Errorf("Mismatch. weighted %s want: %v, got: %v", c.name, want, c.gwt)
+		}
+	}
+
+	if m.Count() != 10 || m.Min() != .7 || m.Max() != 9.2 || mw.Count() != 16 {
+		t.Errorf("Mismatch. Count, Min, Max want: 10, .7, 9.2, got: %v, %v, %v", m.Count(), m.Min(), m.Max())
+	}
+
+	var empty Moments
+	if !math.IsNaN(empty.Mean()) || !math.IsNaN(empty.Min()) || !math.IsNaN(empty.Variance()) {
+		t.Errorf("Mismatch. empty Moments want: NaN, got: %v, %v, %v", empty.Mean(), empty.Min(), empty.Variance())
+	}
+}
+
+// Accumulators of parts of a stream, filled concurrently, merge into that of the whole.
+func TestMomentsMerge(t *testing.T) {
+	tol := 1e-8 // the offset of 1e6 costs digits of the higher moments either way
+	x := make([]float64, 1000)
+	for i := range x {
+		x[i] = 1e6 + math.Sin(float64(i))*math.Exp(float64(i%7))
+	}
+
+	parts := make([]Moments, 7)
+	var wg sync.WaitGroup
+	for k := range parts {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			for i := k; i < len(x); i += len(parts) {
+				parts[k].Add(x[i])
+			}
+		}(k)
+	}
+	wg.Wait()
+
+	var m Moments
+	for k := range parts {
+		m.Merge(&parts[k])
+	}
+
+	cases := []struct {
+		name      string
+		want, got float64
+	}{
+		{"Mean", Mean(x, nil), m.Mean()},
+		{"Variance", Variance(x, nil), m.Variance()},
+		{"Skewness", Skewness(x, nil), m.Skewness()},
+		{"ExKurtosis", ExKurtosis(x, nil), m.ExKurtosis()},
+	}
+
+	for _, c := range cases {
+		if math.Abs(c.got-c.want) > tol*math.Abs(c.want) {
+			t.Errorf("Mismatch. %s want: %v, got: %v", c.name, c.want, c.got)
+		}
+	}
+}
+
+func TestMomentsJSON(t *testing.T) {
+	var m Moments
+	for _, x := range data[:6] {
+		m.Add(x)
+	}
+
+	b, e := json.Marshal(&m)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var r Moments
+	if e := json.Unmarshal(b, &r); e != nil {
+		t.Fatal(e)
+	}
+
+	for _, x := range data[6:] {
+		r.Add(x)
+	}
+
+	if want, got := Variance(data, nil), r.Variance(); math.Abs(got-want) > 1e-14*want || r.Max() != 9.2 {
+		t.Errorf("Mismatch. Variance after round trip want: %v, got: %v", want, got)
+	}
+}
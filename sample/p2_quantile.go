@@ -0,0 +1,229 @@
+package sample
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jtejido/stats/err"
+	"math"
+	"sort"
+)
+
+// P2Quantile estimates a quantile of a stream in constant space by the P² algorithm, which keeps five
+// markers at the minimum, the p/2, p and (1+p)/2 quantiles and the maximum, moving them along a
+// parabola through their neighbours as observations arrive. Until five observations are added the
+// quantile is exact. It is not safe for concurrent use.
+//
+// R. Jain and I. Chlamtac, "The P² algorithm for dynamic calculation of quantiles and histograms
+// without storing observations," Communications of the ACM, vol. 28, no. 10, pp. 1076-1085, 1985.
+type P2Quantile struct {
+	p     float64
+	count int
+	q     [5]float64 // marker heights, or the first observations while fewer than five
+	n     [5]float64 // marker positions, from 1
+	want  [5]float64 // desired marker positions
+}
+
+// NewP2Quantile returns an estimator of the p-quantile, p ∈ [0,1].
+func NewP2Quantile(p float64) (*P2Quantile, error) {
+	if !(p >= 0 && p <= 1) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("P2Quantile: p = %v is outside [0,1]", p))
+	}
+
+	return &P2Quantile{p: p}, nil
+}
+
+// P returns the probability of the quantile estimated.
+func (e *P2Quantile) P() float64 {
+	return e.p
+}
+
+// Count returns the number of observations added.
+func (e *P2Quantile) Count() int {
+	return e.count
+}
+
+// Desired marker positions after n observations.
+func (e *P2Quantile) desired(n float64) [5]float64 {
+	return [5]float64{1, 1 + (n-1)*e.p/2, 1 + (n-1)*e.p, 1 + (n-1)*(1+e.p)/2, n}
+}
+
+// Add adds x.
+func (e *P2Quantile) Add(x float64) {
+	if e.count < 5 {
+		e.q[e.count] = x
+		e.count++
+		if e.count == 5 {
+			sort.Float64s(e.q[:])
+			e.n = [5]float64{1, 2, 3, 4, 5}
+			e.want = e.desired(5)
+		}
+		return
+	}
+	e.count++
+
+	// cell of x, extending the extreme markers
+	var k int
+	switch {
+	case x < e.q[0]:
+		e.q[0] = x
+	case x >= e.q[4]:
+		e.q[4] = x
+		k = 3
+	default:
+		for k = 0; x >= e.q[k+1]; k++ {
+		}
+	}
+
+	for i := k + 1; i < 5; i++ {
+		e.n[i]++
+	}
+	e.want = e.desired(float64(e.count))
+	e.adjust()
+}
+
+// Moves the middle markers that are a position or more away from where they should be.
+func (e *P2Quantile) adjust() {
+	for i := 1; i < 4; i++ {
+		d := e.want[i] - e.n[i]
+		if !(d >= 1 && e.n[i+1]-e.n[i] > 1 || d <= -1 && e.n[i-1]-e.n[i] < -1) {
+			continue
+		}
+
+		s := math.Copysign(1, d)
+		q := e.parabolic(i, s)
+		if !(e.q[i-1] < q && q < e.q[i+1]) {
+			j := i + int(s)
+			q = e.q[i] + s*(e.q[j]-e.q[i])/(e.n[j]-e.n[i])
+		}
+		e.q[i] = q
+		e.n[i] += s
+	}
+}
+
+func (e *P2Quantile) parabolic(i int, d float64) float64 {
+	q, n := e.q, e.n
+	return q[i] + d/(n[i+1]-n[i-1])*((n[i]-n[i-1]+d)*(q[i+1]-q[i])/(n[i+1]-n[i])+(n[i+1]-n[i]-d)*(q[i]-q[i-1])/(n[i]-n[i-1]))
+}
+
+// Value returns the estimate, the Linear sample quantile while fewer than five observations are added
+// and NaN before any is. The 0- and 1-quantiles are the exact minimum and maximum.
+func (e *P2Quantile) Value() float64 {
+	switch {
+	case e.count < 5:
+		return Quantile(e.p, Linear, e.q[:e.count], nil)
+	case e.p == 0:
+		return e.q[0]
+	case e.p == 1:
+		return e.q[4]
+	}
+
+	return e.q[2]
+}
+
+// Merge adds the observations of o to e, which must estimate the same quantile. Unless either has
+// fewer than five observations, when those are added one by one, P² markers cannot be merged exactly:
+// the markers of the result are placed on the average of the piecewise linear cdfs through the markers
+// of both, weighted by their counts.
+func (e *P2Quantile) Merge(o *P2Quantile) error {
+	if o.p != e.p {
+		return err.New(err.EINVAL, fmt.Sprintf("P2Quantile: cannot merge the %v-quantile into the %v-quantile", o.p, e.p))
+	}
+
+	if o.count < 5 {
+		for _, x := range o.q[:o.count] {
+			e.Add(x)
+		}
+		return nil
+	}
+
+	if e.count < 5 {
+		first := *e
+		*e = *o
+		for _, x := range first.q[:first.count] {
+			e.Add(x)
+		}
+		return nil
+	}
+
+	// the breakpoints of the combined cdf, and its value at each
+	total := float64(e.count + o.count)
+	xs := append(append([]float64(nil), e.q[:]...), o.q[:]...)
+	sort.Float64s(xs)
+	cdf := make([]float64, len(xs))
+	for i, x := range xs {
+		cdf[i] = (float64(e.count)*e.cdf(x) + float64(o.count)*o.cdf(x)) / total
+	}
+
+	e.count += o.count
+	e.want = e.desired(total)
+	e.n = e.want
+	e.q[0], e.q[4] = xs[0], xs[len(xs)-1]
+	for i := 1; i < 4; i++ {
+		p := (e.n[i] - 1) / (total - 1)
+		k := sort.SearchFloat64s(cdf, p)
+		switch {
+		case k == 0:
+			e.q[i] = xs[0]
+		case k == len(xs):
+			e.q[i] = xs[len(xs)-1]
+		case cdf[k] == cdf[k-1]:
+			e.q[i] = xs[k]
+		default:
+			e.q[i] = xs[k-1] + (xs[k]-xs[k-1])*(p-cdf[k-1])/(cdf[k]-cdf[k-1])
+		}
+	}
+
+	return nil
+}
+
+// Piecewise linear cdf through the markers.
+func (e *P2Quantile) cdf(x float64) float64 {
+	last := e.n[4] - 1
+	switch {
+	case x < e.q[0]:
+		return 0
+	case x >= e.q[4]:
+		return 1
+	}
+
+	i := 0
+	for x >= e.q[i+1] {
+		i++
+	}
+
+	f := (e.n[i] - 1) / last
+	if dq := e.q[i+1] - e.q[i]; dq > 0 {
+		f += (x - e.q[i]) / dq * (e.n[i+1] - e.n[i]) / last
+	}
+
+	return f
+}
+
+type p2QuantileJSON struct {
+	P       float64    `json:"p"`
+	Count   int        `json:"count"`
+	Heights [5]float64 `json:"heights"`
+	Pos     [5]float64 `json:"positions"`
+}
+
+func (e *P2Quantile) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p2QuantileJSON{e.p, e.count, e.q, e.n})
+}
+
+func (e *P2Quantile) UnmarshalJSON(b []byte) error {
+	var s p2QuantileJSON
+	if ee := json.Unmarshal(b, &s); ee != nil {
+		return ee
+	}
+
+	if !(s.P >= 0 && s.P <= 1) || s.Count < 0 {
+		return err.New(err.EINVAL, fmt.Sprintf("P2Quantile: p = %v, count = %v is not a valid state", s.P, s.Count))
+	}
+
+	*e = P2Quantile{p: s.P, count: s.Count, q: s.Heights, n: s.Pos}
+	if e.count >= 5 {
+		e.want = e.desired(float64(e.count))
+	}
+
+	return nil
+}
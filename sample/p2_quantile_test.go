@@ -0,0 +1,102 @@
+package sample
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestP2Quantile(t *testing.T) {
+	const n = 100000
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+
+	for _, p := range []float64{0, .01, .1, .25, .5, .9, .99, 1} {
+		e, _ := NewP2Quantile(p)
+		for _, v := range x {
+			e.Add(v)
+		}
+
+		want := Quantile(p, Linear, x, nil)
+		if got := e.Value(); math.Abs(got-want) > .02 {
+			t.Errorf("Mismatch. p = %v want: %v, got: %v", p, want, got)
+		}
+	}
+
+	// exact on fewer than five observations
+	e, _ := NewP2Quantile(.3)
+	if !math.IsNaN(e.Value()) {
+		t.Errorf("Mismatch. empty want: NaN, got: %v", e.Value())
+	}
+	for _, v := range data[:4] {
+		e.Add(v)
+	}
+	if want := Quantile(.3, Linear, data[:4], nil); e.Value() != want {
+		t.Errorf("Mismatch. 4 observations want: %v, got: %v", want, e.Value())
+	}
+
+	if _, e := NewP2Quantile(1.5); e == nil {
+		t.Errorf("Mismatch. NewP2Quantile(1.5) want: error, got: nil")
+	}
+}
+
+// A stream split across accumulators, as by goroutines, merges into an estimate close to that of the
+// whole.
+func TestP2QuantileMerge(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	parts := make([]*P2Quantile, 4)
+	var x []float64
+	for k := range parts {
+		parts[k], _ = NewP2Quantile(.9)
+		for i := 0; i < 20000; i++ {
+			v := rnd.ExpFloat64()
+			parts[k].Add(v)
+			x = append(x, v)
+		}
+	}
+
+	for _, o := range parts[1:] {
+		if e := parts[0].Merge(o); e != nil {
+			t.Fatal(e)
+		}
+	}
+
+	want := Quantile(.9, Linear, x, nil)
+	if got := parts[0].Value(); math.Abs(got-want) > .02*want || parts[0].Count() != len(x) {
+		t.Errorf("Mismatch. merged want: %v, got: %v", want, got)
+	}
+
+	// small accumulators are merged observation by observation
+	a, _ := NewP2Quantile(.5)
+	b, _ := NewP2Quantile(.5)
+	for _, v := range data[:3] {
+		a.Add(v)
+	}
+	for _, v := range data[3:5] {
+		b.Add(v)
+	}
+	a.Merge(b)
+	if want := Median(data[:5], nil); a.Value() != want {
+		t.Errorf("Mismatch. merged median want: %v, got: %v", want, a.Value())
+	}
+
+	c, _ := NewP2Quantile(.1)
+	if e := a.Merge(c); e == nil {
+		t.Errorf("Mismatch. merging the .1-quantile into the median want: error, got: nil")
+	}
+
+	js, _ := json.Marshal(parts[0])
+	var r P2Quantile
+	if e := json.Unmarshal(js, &r); e != nil {
+		t.Fatal(e)
+	}
+	r.Add(1)
+	parts[0].Add(1)
+	if r.Value() != parts[0].Value() || r.Count() != parts[0].Count() {
+		t.Errorf("Mismatch. round trip want: %v, got: %v", parts[0].Value(), r.Value())
+	}
+}
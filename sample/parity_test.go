@@ -0,0 +1,120 @@
+package sample_test
+
+import (
+	"github.com/jtejido/stats/dist/continuous"
+	"github.com/jtejido/stats/sample"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// Sample statistics of draws from distributions of known moments and quantiles.
+func TestParity(t *testing.T) {
+	const n = 100000
+	specs := []string{
+		"Normal(1, 2)",
+		"Uniform(-1, 3)",
+		"Gamma(shape=4, rate=2)",
+		"Beta(2, 5)",
+		"Logistic(0, 1)",
+		"Laplace(0, 1)",
+	}
+
+	for _, spec := range specs {
+		t.Run(spec, func(t *testing.T) {
+			d, e := continuous.ParseWithSource(spec, rand.NewSource(1))
+			if e != nil {
+				t.Fatalf("ParseWithSource(%q): %v", spec, e)
+			}
+
+			m := d.(interface {
+				Mean() float64
+				Variance() float64
+				Skewness() float64
+				ExKurtosis() float64
+			})
+
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = d.Rand()
+			}
+
+			// five standard errors, those of skewness and kurtosis taken as for the normal with room
+			// for the heavier tails
+			mean, variance, k := m.Mean(), m.Variance(), m.ExKurtosis()
+			checks := []struct {
+				name      string
+				got, want float64
+				tol       float64
+			}{
+				{"Mean", sample.Mean(x, nil), mean, 5 * math.Sqrt(variance/n)},
+				{"Variance", sample.Variance(x, nil), variance, 5 * variance * math.Sqrt((k+2)/n)},
+				{"Skewness", sample.Skewness(x, nil), m.Skewness(), 5 * math.Sqrt(6*(1+k)/n)},
+				{"ExKurtosis", sample.ExKurtosis(x, nil), k, 5 * math.Sqrt(24*(1+k)/n)},
+			}
+
+			ps := []float64{.01, .1, .25, .5, .75, .9, .99}
+			for _, tp := range []sample.QuantileType{sample.InverseCDF, sample.Linear, sample.MedianUnbiased} {
+				for i, q := range sample.Quantiles(ps, tp, x, nil) {
+					xp := d.Inverse(ps[i])
+					se := math.Sqrt(ps[i]*(1-ps[i])/n) / d.Probability(xp)
+					checks = append(checks, struct {
+						name      string
+						got, want float64
+						tol       float64
+					}{"Quantile", q, xp, 5 * se})
+				}
+			}
+
+			for _, c := range checks {
+				if math.Abs(c.got-c.want) > c.tol {
+					t.Errorf("Mismatch. %s want: %v ± %v, got: %v", c.name, c.want, c.tol, c.got)
+				}
+			}
+		})
+	}
+}
+
+// Streaming moments of draws fit the distributions they were drawn from by the method of moments.
+func TestMomentsFit(t *testing.T) {
+	const n = 100000
+	cases := []struct {
+		spec string
+		fit  func(continuous.SampleMoments) (continuous.Parametric, error)
+	}{
+		{"Gamma(shape=4, rate=2)", func(m continuous.SampleMoments) (continuous.Parametric, error) {
+			return continuous.NewGammaFromMoments(m)
+		}},
+		{"LogNormal(0.5, 0.4)", func(m continuous.SampleMoments) (continuous.Parametric, error) {
+			return continuous.NewLogNormalFromMoments(m)
+		}},
+		{"Beta(2, 5)", func(m continuous.SampleMoments) (continuous.Parametric, error) {
+			return continuous.NewBetaFromMoments(m)
+		}},
+	}
+
+	for _, c := range cases {
+		d, e := continuous.ParseWithSource(c.spec, rand.NewSource(3))
+		if e != nil {
+			t.Fatalf("ParseWithSource(%q): %v", c.spec, e)
+		}
+
+		var m sample.Moments
+		for i := 0; i < n; i++ {
+			m.Add(d.Rand())
+		}
+
+		fit, e := c.fit(&m)
+		if e != nil {
+			t.Errorf("Mismatch. %s: %v", c.spec, e)
+			continue
+		}
+
+		want := d.(continuous.Parametric).ParameterValues()
+		for k, v := range fit.ParameterValues() {
+			if math.Abs(v-want[k]) > .05*math.Abs(want[k])+.01 {
+				t.Errorf("Mismatch. %s %s want: %v, got: %v", c.spec, k, want[k], v)
+			}
+		}
+	}
+}
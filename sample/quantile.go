@@ -0,0 +1,167 @@
+package sample
+
+import (
+	gsl "github.com/jtejido/ggsl"
+	"math"
+	"sort"
+)
+
+// QuantileType selects one of the nine sample quantiles of Hyndman and Fan, numbered as in their paper
+// and in R. With weights, types 1 and 2 depend on the relative weights only, while the others take the
+// total weight for the sample size.
+//
+// R. J. Hyndman and Y. Fan, "Sample quantiles in statistical packages," The American Statistician,
+// vol. 50, no. 4, pp. 361-365, 1996.
+type QuantileType int
+
+const (
+	InverseCDF             QuantileType = iota + 1 // 1: inverse of the empirical cdf
+	AveragedInverseCDF                             // 2: as 1, averaging at discontinuities
+	ClosestObservation                             // 3: the nearest order statistic, even ones on ties
+	InterpolatedInverseCDF                         // 4: linear interpolation of the empirical cdf
+	Hazen                                          // 5: piecewise linear through (k-1/2)/n
+	Weibull                                        // 6: piecewise linear through k/(n+1)
+	Linear                                         // 7: piecewise linear through (k-1)/(n-1), the default of R
+	MedianUnbiased                                 // 8: approximately median-unbiased
+	NormalUnbiased                                 // 9: approximately unbiased for normal samples
+)
+
+// Plotting positions (k-a)/(n+1-a-b) of the continuous types 4 to 9.
+var quantileAB = map[QuantileType][2]float64{
+	InterpolatedInverseCDF: {0, 1},
+	Hazen:                  {.5, .5},
+	Weibull:                {0, 0},
+	Linear:                 {1, 1},
+	MedianUnbiased:         {1. / 3, 1. / 3},
+	NormalUnbiased:         {3. / 8, 3. / 8},
+}
+
+// Observations sorted, without those of weight 0, and their cumulative weights.
+type ordered struct {
+	x, cum []float64
+}
+
+func (o ordered) total() float64 {
+	return o.cum[len(o.cum)-1]
+}
+
+// Sorts x with its weights, returning false if x is empty or holds NaN.
+func order(x, weights []float64) (ordered, bool) {
+	if w := totalWeight(x, weights); !(w > 0) {
+		return ordered{}, false
+	}
+
+	idx := make([]int, 0, len(x))
+	for i, v := range x {
+		if math.IsNaN(v) {
+			return ordered{}, false
+		}
+
+		if weight(weights, i) > 0 {
+			idx = append(idx, i)
+		}
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return x[idx[i]] < x[idx[j]] })
+
+	o := ordered{x: make([]float64, len(idx)), cum: make([]float64, len(idx))}
+	var cum float64
+	for k, i := range idx {
+		cum += weight(weights, i)
+		o.x[k], o.cum[k] = x[i], cum
+	}
+
+	return o, true
+}
+
+// The k-th order statistic, the observation at which the cumulative weight reaches k, for k clamped to
+// the sample. Integer weights give the order statistics of the sample with each observation repeated.
+func (o ordered) stat(k float64) float64 {
+	fuzz := 4 * gsl.Float64Eps * o.total()
+	i := sort.Search(len(o.cum), func(i int) bool { return o.cum[i] >= k-fuzz })
+	if i == len(o.cum) {
+		i--
+	}
+
+	return o.x[i]
+}
+
+// Quantile returns the sample quantile of type t at probability p.
+func Quantile(p float64, t QuantileType, x, weights []float64) float64 {
+	return Quantiles([]float64{p}, t, x, weights)[0]
+}
+
+// Quantiles returns the sample quantiles of type t at each of ps, sorting x once.
+func Quantiles(ps []float64, t QuantileType, x, weights []float64) []float64 {
+	q := make([]float64, len(ps))
+	o, ok := order(x, weights)
+	for i, p := range ps {
+		if !ok || !(p >= 0 && p <= 1) || t < InverseCDF || t > NormalUnbiased {
+			q[i] = math.NaN()
+			continue
+		}
+		q[i] = o.quantile(p, t)
+	}
+
+	return q
+}
+
+// As R's quantile.default, with order statistics taken by cumulative weight. The discontinuous types 1
+// and 2 are read off the weighted empirical cdf directly, so that they only depend on the relative
+// weights.
+func (o ordered) quantile(p float64, t QuantileType) float64 {
+	n := o.total()
+	switch t {
+	case InverseCDF:
+		return o.stat(n * p)
+	case AveragedInverseCDF:
+		k := n * p
+		fuzz := 4 * gsl.Float64Eps * n
+		i := sort.Search(len(o.cum), func(i int) bool { return o.cum[i] >= k-fuzz })
+		if i >= len(o.cum)-1 {
+			return o.x[len(o.x)-1]
+		}
+
+		if k > 0 && math.Abs(o.cum[i]-k) <= fuzz {
+			return (o.x[i] + o.x[i+1]) / 2
+		}
+		return o.x[i]
+	}
+
+	var nppm float64
+	if t == ClosestObservation {
+		nppm = n*p - .5
+	} else {
+		ab := quantileAB[t]
+		nppm = ab[0] + p*(n+1-ab[0]-ab[1])
+	}
+
+	fuzz := 4 * gsl.Float64Eps * math.Max(1, math.Abs(nppm))
+	j := math.Floor(nppm + fuzz)
+	var h float64
+	switch t {
+	case ClosestObservation:
+		if math.Abs(nppm-j) > fuzz || math.Mod(j, 2) != 0 {
+			h = 1
+		}
+	default:
+		if h = nppm - j; math.Abs(h) < fuzz {
+			h = 0
+		}
+	}
+
+	lo, hi := o.stat(j), o.stat(j+1)
+	switch {
+	case h == 0 || lo == hi:
+		return lo
+	case h == 1:
+		return hi
+	}
+
+	return (1-h)*lo + h*hi
+}
+
+// Median returns the median of x, the mean of the two middle observations if they split the weight
+// evenly.
+func Median(x, weights []float64) float64 {
+	return Quantile(.5, AveragedInverseCDF, x, weights)
+}
@@ -0,0 +1,82 @@
+package sample
+
+import (
+	"math"
+	"testing"
+)
+
+var data = []float64{2.1, 5.3, 0.7, 3.9, 9.2, 4.4, 6.8, 1.5, 7.7, 3.3}
+
+func TestQuantile(t *testing.T) {
+	tol := 1e-14
+	ps := []float64{0, .1, .25, .5, .9, 1}
+
+	// R's quantile(data, ps, type = t)
+	cases := []struct {
+		t        QuantileType
+		expected []float64
+	}{
+		{InverseCDF, []float64{0.7, 0.7, 2.1, 3.9, 7.7, 9.2}},
+		{AveragedInverseCDF, []float64{0.7, 1.1, 2.1, 4.15, 8.45, 9.2}},
+		{ClosestObservation, []float64{0.7, 0.7, 1.5, 3.9, 7.7, 9.2}},
+		{InterpolatedInverseCDF, []float64{0.7, 0.7, 1.8, 3.9, 7.7, 9.2}},
+		{Hazen, []float64{0.7, 1.1, 2.1, 4.15, 8.45, 9.2}},
+		{Weibull, []float64{0.7, 0.78, 1.95, 4.15, 9.05, 9.2}},
+		{Linear, []float64{0.7, 1.42, 2.4, 4.15, 7.85, 9.2}},
+		{MedianUnbiased, []float64{0.7, 0.99333333333333333, 2.05, 4.15, 8.65, 9.2}},
+		{NormalUnbiased, []float64{0.7, 1.02, 2.0625, 4.15, 8.6, 9.2}},
+	}
+
+	for _, c := range cases {
+		for i, q := range Quantiles(ps, c.t, data, nil) {
+			if math.Abs(q-c.expected[i]) > tol*c.expected[i] {
+				t.Errorf("Mismatch. type %d, p = %v, want: %v, got: %v", c.t, ps[i], c.expected[i], q)
+			}
+		}
+	}
+
+	for _, p := range []float64{-.1, 1.1, math.NaN()} {
+		if q := Quantile(p, Linear, data, nil); !math.IsNaN(q) {
+			t.Errorf("Mismatch. p = %v want: NaN, got: %v", p, q)
+		}
+	}
+
+	if q := Quantile(.5, QuantileType(10), data, nil); !math.IsNaN(q) {
+		t.Errorf("Mismatch. type 10 want: NaN, got: %v", q)
+	}
+
+	if q := Median(nil, nil); !math.IsNaN(q) {
+		t.Errorf("Mismatch. median of nothing want: NaN, got: %v", q)
+	}
+}
+
+func TestWeightedQuantile(t *testing.T) {
+	// integer weights give the quantiles of the sample with each observation repeated, and weight 0
+	// removes one
+	weights := []float64{1, 3, 0, 2, 1, 1, 4, 2, 1, 1}
+	var repeated []float64
+	for i, w := range weights {
+		for k := 0; k < int(w); k++ {
+			repeated = append(repeated, data[i])
+		}
+	}
+
+	for tp := InverseCDF; tp <= NormalUnbiased; tp++ {
+		for p := 0.; p <= 1; p += 1. / 64 {
+			want := Quantile(p, tp, repeated, nil)
+			if got := Quantile(p, tp, data, weights); math.Abs(got-want) > 1e-14*want {
+				t.Errorf("Mismatch. type %d, p = %v, want: %v, got: %v", tp, p, want, got)
+			}
+		}
+	}
+
+	// scaling the weights leaves the median where the weight is split
+	half := make([]float64, len(weights))
+	for i, w := range weights {
+		half[i] = w / 8
+	}
+
+	if m, want := Median(data, half), Median(repeated, nil); m != want {
+		t.Errorf("Mismatch. weighted median want: %v, got: %v", want, m)
+	}
+}
@@ -0,0 +1,169 @@
+package sample
+
+import (
+	"math"
+)
+
+// NormalMADScale turns MAD into a consistent estimator of the standard deviation of normal samples,
+// being 1/Φ⁻¹(3/4).
+const NormalMADScale = 1.482602218505602
+
+// MAD returns the median absolute deviation of x from its median. Multiply by NormalMADScale to
+// estimate a standard deviation.
+func MAD(x, weights []float64) float64 {
+	med := Median(x, weights)
+	if math.IsNaN(med) {
+		return med
+	}
+
+	dev := make([]float64, len(x))
+	for i, v := range x {
+		dev[i] = math.Abs(v - med)
+	}
+
+	return Median(dev, weights)
+}
+
+// Weight each observation of o keeps once weight cut is removed from both ends of the sample, the
+// observations at the cuts keeping part of theirs.
+func (o ordered) trim(cut float64) []float64 {
+	n := o.total()
+	kept := make([]float64, len(o.x))
+	prev := 0.
+	for i, c := range o.cum {
+		kept[i] = math.Max(0, math.Min(c, n-cut)-math.Max(prev, cut))
+		prev = c
+	}
+
+	return kept
+}
+
+// TrimmedMean returns the mean of x left once a fraction alpha of the weight is removed from each end,
+// splitting the weight of the observations at the cuts. When alpha times the sample size is whole, this
+// is the usual trimmed mean. alpha is within [0, 1/2], where 1/2 gives the median.
+func TrimmedMean(alpha float64, x, weights []float64) float64 {
+	if !(alpha >= 0 && alpha <= .5) {
+		return math.NaN()
+	}
+
+	if alpha == .5 {
+		return Median(x, weights)
+	}
+
+	o, ok := order(x, weights)
+	if !ok {
+		return math.NaN()
+	}
+
+	kept := o.trim(alpha * o.total())
+	return mean(o.x, kept, o.total()*(1-2*alpha))
+}
+
+// WinsorizedMean returns the mean of x once a fraction alpha of the weight at each end is moved to the
+// observation at the cut, which is within [0, 1/2]. When alpha times the sample size is whole, this is
+// the usual winsorized mean.
+func WinsorizedMean(alpha float64, x, weights []float64) float64 {
+	if !(alpha >= 0 && alpha <= .5) {
+		return math.NaN()
+	}
+
+	o, ok := order(x, weights)
+	if !ok {
+		return math.NaN()
+	}
+
+	n := o.total()
+	cut := alpha * n
+	kept := o.trim(cut)
+
+	// the lowest and highest observations keeping any weight, or the median if none does
+	lo, hi := -1, -1
+	for i, k := range kept {
+		if k > 0 {
+			if lo < 0 {
+				lo = i
+			}
+			hi = i
+		}
+	}
+
+	if lo < 0 {
+		return o.quantile(.5, AveragedInverseCDF)
+	}
+
+	x2 := append(append([]float64(nil), o.x...), o.x[lo], o.x[hi])
+	w2 := append(kept, cut, cut)
+	return mean(x2, w2, n)
+}
+
+// Mode estimates the mode of x, taken to be drawn from a continuous distribution, by the half-sample
+// mode: the shortest interval holding half the weight is taken repeatedly until at most three
+// observations remain, the weighted mean of the closer two of which is the estimate.
+//
+// D. R. Bickel and R. Frühwirth, "On a fast, robust estimator of the mode: Comparisons to other robust
+// estimators with applications," Computational Statistics & Data Analysis, vol. 50, no. 12,
+// pp. 3500-3530, 2006.
+func Mode(x, weights []float64) float64 {
+	o, ok := order(x, weights)
+	if !ok {
+		return math.NaN()
+	}
+
+	w := make([]float64, len(o.x))
+	prev := 0.
+	for i, c := range o.cum {
+		w[i], prev = c-prev, c
+	}
+
+	xs := o.x
+	for len(xs) > 3 {
+		// shortest window [i, k] holding half the weight
+		var total float64
+		for _, v := range w {
+			total += v
+		}
+
+		best, bi, bk := math.Inf(1), 0, len(xs)-1
+		var held float64
+		k := -1
+		for i := range xs {
+			for held < total/2 && k < len(xs)-1 {
+				k++
+				held += w[k]
+			}
+
+			if held < total/2 {
+				break
+			}
+
+			if d := xs[k] - xs[i]; d < best {
+				best, bi, bk = d, i, k
+			}
+			held -= w[i]
+		}
+
+		if bi == 0 && bk == len(xs)-1 {
+			break
+		}
+
+		xs, w = xs[bi:bk+1], w[bi:bk+1]
+	}
+
+	if len(xs) == 3 {
+		switch d1, d2 := xs[1]-xs[0], xs[2]-xs[1]; {
+		case d1 < d2:
+			xs, w = xs[:2], w[:2]
+		case d1 > d2:
+			xs, w = xs[1:], w[1:]
+		default:
+			return xs[1]
+		}
+	}
+
+	var total float64
+	for _, v := range w {
+		total += v
+	}
+
+	return mean(xs, w, total)
+}
@@ -0,0 +1,70 @@
+package sample
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRobust(t *testing.T) {
+	tol := 1e-14
+	cases := []struct {
+		name          string
+		got, expected float64
+	}{
+		{"Median", Median(data, nil), 4.15},
+		{"MAD", MAD(data, nil), 2.35},
+		{"TrimmedMean(0)", TrimmedMean(0, data, nil), 4.49},
+		{"TrimmedMean(.1)", TrimmedMean(.1, data, nil), 4.375},
+		{"TrimmedMean(.2)", TrimmedMean(.2, data, nil), 4.3},
+		{"TrimmedMean(.5)", TrimmedMean(.5, data, nil), 4.15},
+		{"WinsorizedMean(0)", WinsorizedMean(0, data, nil), 4.49},
+		{"WinsorizedMean(.2)", WinsorizedMean(.2, data, nil), 4.36},
+		{"WinsorizedMean(.5)", WinsorizedMean(.5, data, nil), 4.15},
+
+		// a fraction of an observation is trimmed: (.5·1.5 + 2.1 + ... + 7.7 + .5·7.7) / 7
+		{"TrimmedMean(.15)", TrimmedMean(.15, data, nil), (.5*1.5 + 2.1 + 3.3 + 3.9 + 4.4 + 5.3 + 6.8 + .5*7.7) / 7},
+
+		// half-sample modes
+		{"Mode", Mode([]float64{9, 1, 2, 2.5, 2.75, 6}, nil), 2.625},
+		{"Mode tie", Mode([]float64{1, 2, 2.25, 2.5, 5, 9}, nil), 2.25},
+		{"Mode one", Mode([]float64{3}, nil), 3},
+		{"Mode weighted", Mode([]float64{1, 2, 3, 10}, []float64{1, 1, 1, 5}), 10},
+	}
+
+	for _, c := range cases {
+		if math.Abs(c.got-c.expected) > tol*math.Abs(c.expected) {
+			t.Errorf("Mismatch. %s want: %v, got: %v", c.name, c.expected, c.got)
+		}
+	}
+
+	for _, alpha := range []float64{-.1, .6, math.NaN()} {
+		if m := TrimmedMean(alpha, data, nil); !math.IsNaN(m) {
+			t.Errorf("Mismatch. TrimmedMean(%v) want: NaN, got: %v", alpha, m)
+		}
+	}
+}
+
+func TestWeightedRobust(t *testing.T) {
+	weights := []float64{1, 3, 0, 2, 1, 1, 4, 2, 1, 1}
+	var repeated []float64
+	for i, w := range weights {
+		for k := 0; k < int(w); k++ {
+			repeated = append(repeated, data[i])
+		}
+	}
+
+	cases := []struct {
+		name string
+		f    func(x, weights []float64) float64
+	}{
+		{"MAD", MAD},
+		{"TrimmedMean(.25)", func(x, w []float64) float64 { return TrimmedMean(.25, x, w) }},
+		{"WinsorizedMean(.25)", func(x, w []float64) float64 { return WinsorizedMean(.25, x, w) }},
+	}
+
+	for _, c := range cases {
+		if want, got := c.f(repeated, nil), c.f(data, weights); math.Abs(got-want) > 1e-14*math.Abs(want) {
+			t.Errorf("Mismatch. weighted %s want: %v, got: %v", c.name, want, got)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Package sample describes data: moments, quantiles and robust estimates of location and scale of a
+// sample, each with an optional weight per observation.
+//
+// Weights are frequency weights: an observation of weight 2 counts as two observations, so the
+// unbiased estimators divide by the total weight rather than the number of observations. A nil weights
+// slice weighs every observation 1. Functions return NaN for an empty sample, or one too small for the
+// estimator, and raise EBADLEN (weights and sample of different lengths) and EINVAL (negative or NaN
+// weights) through the err package, returning NaN if the handler returns.
+package sample
+
+import (
+	"github.com/jtejido/stats/err"
+	"math"
+)
+
+// Checks the weights against x, returning the total weight, or NaN after raising an error.
+func totalWeight(x, weights []float64) float64 {
+	if weights == nil {
+		return float64(len(x))
+	}
+
+	if len(weights) != len(x) {
+		return err.StatsErrorVal("sample: weights and sample of different lengths", err.EBADLEN, math.NaN())
+	}
+
+	var w float64
+	for _, v := range weights {
+		if !(v >= 0) {
+			return err.StatsErrorVal("sample: weights must be non-negative", err.EINVAL, math.NaN())
+		}
+		w += v
+	}
+
+	return w
+}
+
+func weight(weights []float64, i int) float64 {
+	if weights == nil {
+		return 1
+	}
+
+	return weights[i]
+}
+
+// Mean returns the mean of x.
+func Mean(x, weights []float64) float64 {
+	return mean(x, weights, totalWeight(x, weights))
+}
+
+// Mean of x, whose weights are known to sum to w.
+func mean(x, weights []float64, w float64) float64 {
+	if !(w > 0) {
+		return math.NaN()
+	}
+
+	// the compensated second pass corrects the rounding error of the first
+	m := kahanSum(x, weights) / w
+	var c float64
+	for i, v := range x {
+		c += weight(weights, i) * (v - m)
+	}
+
+	return m + c/w
+}
+
+// Weighted sum of x by Kahan summation.
+func kahanSum(x, weights []float64) float64 {
+	var sum, c float64
+	for i, v := range x {
+		y := weight(weights, i)*v - c
+		t := sum + y
+		c = (t - sum) - y
+		sum = t
+	}
+
+	return sum
+}
+
+// Central moments of orders 2 to 4 divided by the total weight, by the corrected two-pass algorithm.
+func centralMoments(x, weights []float64) (m, m2, m3, m4, w float64) {
+	w = totalWeight(x, weights)
+	if !(w > 0) {
+		return math.NaN(), math.NaN(), math.NaN(), math.NaN(), w
+	}
+
+	m = mean(x, weights, w)
+	var c float64
+	for i, v := range x {
+		wi := weight(weights, i)
+		d := v - m
+		d2 := d * d
+		c += wi * d
+		m2 += wi * d2
+		m3 += wi * d2 * d
+		m4 += wi * d2 * d2
+	}
+
+	// Chan, Golub and LeVeque's correction of m2 for the rounding error left in the mean
+	m2 = (m2 - c*c/w) / w
+	return m, m2, m3 / w, m4 / w, w
+}
+
+// Variance returns the unbiased variance of x, dividing the sum of squared deviations by the total weight
+// less 1.
+func Variance(x, weights []float64) float64 {
+	_, m2, _, _, w := centralMoments(x, weights)
+	if !(w > 1) {
+		return math.NaN()
+	}
+
+	return m2 * w / (w - 1)
+}
+
+// StdDev returns the square root of Variance.
+func StdDev(x, weights []float64) float64 {
+	return math.Sqrt(Variance(x, weights))
+}
+
+// Skewness returns the adjusted Fisher-Pearson skewness G₁ = g₁ √(n(n-1)) / (n-2) of x, where
+// g₁ = m₃/m₂^(3/2) and n is the total weight.
+func Skewness(x, weights []float64) float64 {
+	_, m2, m3, _, n := centralMoments(x, weights)
+	if !(n > 2) {
+		return math.NaN()
+	}
+
+	g1 := m3 / math.Pow(m2, 1.5)
+	return g1 * math.Sqrt(n*(n-1)) / (n - 2)
+}
+
+// ExKurtosis returns the adjusted excess kurtosis G₂ = (n-1)((n+1)g₂ + 6) / ((n-2)(n-3)) of x, where
+// g₂ = m₄/m₂² - 3 and n is the total weight.
+func ExKurtosis(x, weights []float64) float64 {
+	_, m2, _, m4, n := centralMoments(x, weights)
+	if !(n > 3) {
+		return math.NaN()
+	}
+
+	g2 := m4/(m2*m2) - 3
+	return (n - 1) * ((n+1)*g2 + 6) / ((n - 2) * (n - 3))
+}
@@ -0,0 +1,88 @@
+package sample
+
+import (
+	"github.com/jtejido/stats/err"
+	"math"
+	"testing"
+)
+
+func TestMoments(t *testing.T) {
+	tol := 1e-14
+	cases := []struct {
+		name     string
+		f        func(x, weights []float64) float64
+		expected float64
+	}{
+		{"Mean", Mean, 4.49},
+		{"Variance", Variance, 7.6965555555555545},
+		{"StdDev", StdDev, math.Sqrt(7.6965555555555545)},
+		{"Skewness", Skewness, 0.3493449193930853},
+		{"ExKurtosis", ExKurtosis, -0.8456410784993869},
+	}
+
+	for _, c := range cases {
+		if v := c.f(data, nil); math.Abs(v-c.expected) > tol*math.Abs(c.expected) {
+			t.Errorf("Mismatch. %s want: %v, got: %v", c.name, c.expected, v)
+		}
+
+		// integer weights count as repeated observations
+		weights := []float64{1, 3, 0, 2, 1, 1, 4, 2, 1, 1}
+		var repeated []float64
+		for i, w := range weights {
+			for k := 0; k < int(w); k++ {
+				repeated = append(repeated, data[i])
+			}
+		}
+
+		if want, got := c.f(repeated, nil), c.f(data, weights); math.Abs(got-want) > tol*math.Abs(want) {
+			t.Errorf("Mismatch. weighted %s want: %v, got: %v", c.name, want, got)
+		}
+	}
+
+	// too few observations
+	for _, c := range []struct {
+		f func(x, weights []float64) float64
+		x []float64
+	}{{Mean, nil}, {Variance, []float64{1}}, {Skewness, []float64{1, 2}}, {ExKurtosis, []float64{1, 2, 3}}} {
+		if v := c.f(c.x, nil); !math.IsNaN(v) {
+			t.Errorf("Mismatch. %v want: NaN, got: %v", c.x, v)
+		}
+	}
+}
+
+func TestMomentsStability(t *testing.T) {
+	// a large offset, which defeats the textbook one-pass formulas
+	x := make([]float64, len(data))
+	for i, v := range data {
+		x[i] = 1e9 + v
+	}
+
+	if m := Mean(x, nil); math.Abs(m-(1e9+4.49)) > 1e-6 {
+		t.Errorf("Mismatch. Mean want: %v, got: %v", 1e9+4.49, m)
+	}
+
+	if v := Variance(x, nil); math.Abs(v-7.6965555555555545) > 1e-6 {
+		t.Errorf("Mismatch. Variance want: 7.6965555555555545, got: %v", v)
+	}
+
+	if s := Skewness(x, nil); math.Abs(s-0.3493449193930853) > 1e-6 {
+		t.Errorf("Mismatch. Skewness want: 0.3493449193930853, got: %v", s)
+	}
+}
+
+func TestWeightErrors(t *testing.T) {
+	var errno []int
+	err.SetErrorHandler(func(reason, file string, line, e int) { errno = append(errno, e) })
+	defer err.SetErrorHandler(nil)
+
+	if m := Mean(data, []float64{1, 2}); !math.IsNaN(m) || len(errno) != 1 || errno[0] != err.EBADLEN {
+		t.Errorf("Mismatch. short weights want: NaN and EBADLEN, got: %v and %v", m, errno)
+	}
+
+	errno = nil
+	w := make([]float64, len(data))
+	w[3] = -1
+	if q := Median(data, w); !math.IsNaN(q) || len(errno) != 1 || errno[0] != err.EINVAL {
+		t.Errorf("Mismatch. negative weight want: NaN and EINVAL, got: %v and %v", q, errno)
+	}
+}
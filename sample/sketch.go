@@ -0,0 +1,67 @@
+package sample
+
+import (
+	"math"
+)
+
+// CDF is the cumulative distribution view shared by the sketches of this package and the distributions
+// of package continuous, whose continuous.Common satisfies it, so that one can be checked against the
+// other.
+type CDF interface {
+	Distribution(float64) float64
+	Inverse(float64) float64
+}
+
+// Sketch summarises a stream in bounded memory, answering rank and quantile queries approximately. Its
+// Distribution and Inverse are those of the summarised sample, Rank/Count and Quantile respectively.
+type Sketch interface {
+	CDF
+	Add(float64)
+	Count() float64
+	Rank(float64) float64
+	Quantile(float64) float64
+
+	// points at which the sketch's cdf changes slope or jumps, with its left limit and value there
+	breakpoints() (x, lower, upper []float64)
+}
+
+// KolmogorovSmirnov returns the Kolmogorov-Smirnov statistic D, the largest difference between the cdf
+// of the sketch s and d, taken over the breakpoints of s, and its asymptotic p-value for a sample of
+// s.Count() observations. The rank error of the sketch adds to D, which at large counts lowers the
+// p-value of even a true fit: compare D with that error too.
+func KolmogorovSmirnov(s Sketch, d CDF) (D, p float64) {
+	x, lower, upper := s.breakpoints()
+	if len(x) == 0 {
+		return math.NaN(), math.NaN()
+	}
+
+	for i, v := range x {
+		f := d.Distribution(v)
+		D = math.Max(D, math.Max(math.Abs(f-lower[i]), math.Abs(f-upper[i])))
+	}
+
+	return D, KolmogorovSmirnovPValue(D, s.Count())
+}
+
+// KolmogorovSmirnovPValue returns the probability that the Kolmogorov-Smirnov statistic of n draws
+// exceeds D, using the asymptotic distribution with Stephens' correction for finite n.
+func KolmogorovSmirnovPValue(D, n float64) float64 {
+	sn := math.Sqrt(n)
+	l := (sn + .12 + .11/sn) * D
+	if l < .2 {
+		return 1
+	}
+
+	var sum float64
+	sign := 1.
+	for j := 1.; j <= 100; j++ {
+		term := sign * math.Exp(-2*j*j*l*l)
+		sum += term
+		if math.Abs(term) <= 1e-12*sum {
+			break
+		}
+		sign = -sign
+	}
+
+	return math.Max(0, math.Min(1, 2*sum))
+}
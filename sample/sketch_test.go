@@ -0,0 +1,72 @@
+package sample_test
+
+import (
+	"github.com/jtejido/stats/dist/continuous"
+	"github.com/jtejido/stats/sample"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var _ sample.CDF = continuous.Common(nil)
+
+// Sketches of draws from LogNormal and Weibull fit them, and not each other.
+func TestSketchFit(t *testing.T) {
+	const n = 50000
+	ln, _ := continuous.NewLogNormalWithSource(.5, .4, rand.NewSource(1))
+	w, _ := continuous.NewWeibullWithSource(2, 1.5, rand.NewSource(2))
+
+	cases := []struct {
+		name string
+		d    continuous.Common
+		fit  func(*sample.Moments) continuous.Common
+		not  continuous.Common
+	}{
+		{"LogNormal", ln, func(m *sample.Moments) continuous.Common { d, _ := continuous.NewLogNormalFromMoments(m); return d }, w},
+		{"Weibull", w, func(*sample.Moments) continuous.Common { return w }, ln},
+	}
+
+	for _, c := range cases {
+		td, _ := sample.NewTDigest(sample.DefaultCompression)
+		kll, _ := sample.NewKLLWithSource(sample.DefaultKLLSize, rand.NewSource(3))
+		var m sample.Moments
+		for i := 0; i < n; i++ {
+			x := c.d.Rand()
+			td.Add(x)
+			kll.Add(x)
+			m.Add(x)
+		}
+
+		fit := c.fit(&m)
+		for _, s := range []sample.Sketch{td, kll} {
+			// within the rank error of the sketch
+			if D, _ := sample.KolmogorovSmirnov(s, fit); D > .02 {
+				t.Errorf("Mismatch. %s %T D want: < .02, got: %v", c.name, s, D)
+			}
+
+			if D, p := sample.KolmogorovSmirnov(s, c.not); D < .05 || p > 1e-6 {
+				t.Errorf("Mismatch. %s %T against the other D want: > .05, got: %v, p-value %v", c.name, s, D, p)
+			}
+		}
+	}
+}
+
+// Values from the Kolmogorov distribution, 1 - K(λ)
+func TestKSPValue(t *testing.T) {
+	cases := []struct {
+		l, expected float64
+	}{
+		{0.5, 0.9639452436648751},
+		{1, 0.26999967167735456},
+		{1.36, 0.049485876755377876},
+		{2, 0.0006709252557796953},
+	}
+
+	// with n large enough for Stephens' correction to vanish
+	n := 1e12
+	for _, c := range cases {
+		if res := sample.KolmogorovSmirnovPValue(c.l/math.Sqrt(n), n); math.Abs(res-c.expected) > 1e-6 {
+			t.Errorf("Mismatch. λ = %v, want: %v, got: %v", c.l, c.expected, res)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package sample
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jtejido/stats/err"
+	"math"
+	"sort"
+)
+
+// DefaultCompression is a compression of TDigest good to about 1e-3 in rank around the median and much
+// better in the tails, in a few kilobytes.
+const DefaultCompression = 100
+
+// TDigest is a merging t-digest: a sketch of a stream as at most about πδ/2 weighted centroids for the
+// compression δ, small in the tails and large around the median, so that extreme quantiles keep a
+// small relative error. Its cdf is piecewise linear through the centroids, from the exact minimum to
+// the exact maximum. It is not safe for concurrent use, queries included.
+//
+// T. Dunning and O. Ertl, "Computing extremely accurate quantiles using t-digests," arXiv:1902.04023,
+// 2019.
+type TDigest struct {
+	compression float64
+	centroids   []centroid // sorted by mean
+	count       float64    // weight of the centroids
+	buf         []centroid // observations not yet merged into the centroids
+	min, max    float64
+}
+
+type centroid struct {
+	mean, weight float64
+}
+
+// NewTDigest returns an empty t-digest of compression δ > 0.
+func NewTDigest(compression float64) (*TDigest, error) {
+	if !(compression > 0 && !math.IsInf(compression, 1)) {
+		return nil, err.New(err.EINVAL, fmt.Sprintf("TDigest: compression %v is not positive", compression))
+	}
+
+	return &TDigest{compression: compression, min: math.Inf(1), max: math.Inf(-1)}, nil
+}
+
+// Compression returns δ.
+func (td *TDigest) Compression() float64 {
+	return td.compression
+}
+
+// Add adds x with weight 1.
+func (td *TDigest) Add(x float64) {
+	td.AddWeighted(x, 1)
+}
+
+// AddWeighted adds x with weight w. NaN, and a weight that is not positive, are ignored.
+func (td *TDigest) AddWeighted(x, w float64) {
+	if !(w > 0) || math.IsNaN(x) {
+		return
+	}
+
+	td.min, td.max = math.Min(td.min, x), math.Max(td.max, x)
+	td.buf = append(td.buf, centroid{x, w})
+	if len(td.buf) >= int(5*td.compression)+10 {
+		td.flush()
+	}
+}
+
+// Merge adds the observations summarised by o to td.
+func (td *TDigest) Merge(o *TDigest) {
+	o.flush()
+	for _, c := range o.centroids {
+		td.buf = append(td.buf, c)
+	}
+
+	if o.count > 0 {
+		td.min, td.max = math.Min(td.min, o.min), math.Max(td.max, o.max)
+	}
+	td.flush()
+}
+
+// Count returns the total weight added.
+func (td *TDigest) Count() float64 {
+	td.flush()
+	return td.count
+}
+
+// Merges the buffer into the centroids in one sweep, joining neighbours while the scale function
+// k(q) = δ/2π asin(2q-1) grows by at most 1 across the result.
+func (td *TDigest) flush() {
+	if len(td.buf) == 0 {
+		return
+	}
+
+	all := append(append(make([]centroid, 0, len(td.centroids)+len(td.buf)), td.centroids...), td.buf...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+	var total float64
+	for _, c := range all {
+		total += c.weight
+	}
+
+	merged := td.centroids[:0]
+	cur := all[0]
+	var before float64 // weight left of cur
+	for _, c := range all[1:] {
+		if td.k((before+cur.weight+c.weight)/total)-td.k(before/total) <= 1 {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+
+		merged = append(merged, cur)
+		before += cur.weight
+		cur = c
+	}
+
+	td.centroids = append(merged, cur)
+	td.count = total
+	td.buf = td.buf[:0]
+}
+
+func (td *TDigest) k(q float64) float64 {
+	return td.compression / (2 * math.Pi) * math.Asin(math.Max(-1, math.Min(1, 2*q-1)))
+}
+
+// Quantile returns the p-quantile of the sketch, NaN if it is empty.
+func (td *TDigest) Quantile(p float64) float64 {
+	td.flush()
+	if td.count == 0 || !(p >= 0 && p <= 1) {
+		return math.NaN()
+	}
+
+	h := p * td.count
+	prevX, prevH := td.min, 0.
+	var cum float64
+	for _, c := range td.centroids {
+		mid := cum + c.weight/2
+		if h <= mid {
+			return interpolate(h, prevH, mid, prevX, c.mean)
+		}
+		prevX, prevH = c.mean, mid
+		cum += c.weight
+	}
+
+	return interpolate(h, prevH, td.count, prevX, td.max)
+}
+
+// Inverse returns Quantile(p).
+func (td *TDigest) Inverse(p float64) float64 {
+	return td.Quantile(p)
+}
+
+// Rank returns the weight of the sketch at or below x.
+func (td *TDigest) Rank(x float64) float64 {
+	return td.Distribution(x) * td.count
+}
+
+// Distribution returns the cdf of the sketch at x, NaN if it is empty.
+func (td *TDigest) Distribution(x float64) float64 {
+	td.flush()
+	switch {
+	case td.count == 0 || math.IsNaN(x):
+		return math.NaN()
+	case x < td.min:
+		return 0
+	case x >= td.max:
+		return 1
+	}
+
+	prevX, prevH := td.min, 0.
+	var cum float64
+	for _, c := range td.centroids {
+		mid := cum + c.weight/2
+		if x < c.mean {
+			return interpolate(x, prevX, c.mean, prevH, mid) / td.count
+		}
+		prevX, prevH = c.mean, mid
+		cum += c.weight
+	}
+
+	return interpolate(x, prevX, td.max, prevH, td.count) / td.count
+}
+
+// Linear interpolation at t between (t0, y0) and (t1, y1).
+func interpolate(t, t0, t1, y0, y1 float64) float64 {
+	if t1 <= t0 {
+		return y1
+	}
+
+	return y0 + (y1-y0)*(t-t0)/(t1-t0)
+}
+
+func (td *TDigest) breakpoints() (x, lower, upper []float64) {
+	td.flush()
+	if td.count == 0 {
+		return nil, nil, nil
+	}
+
+	x = append(x, td.min)
+	for _, c := range td.centroids {
+		x = append(x, c.mean)
+	}
+	x = append(x, td.max)
+
+	f := make([]float64, len(x))
+	for i, v := range x {
+		f[i] = td.Distribution(v)
+	}
+
+	return x, f, f
+}
+
+type tDigestJSON struct {
+	Compression float64      `json:"compression"`
+	Min         float64      `json:"min"`
+	Max         float64      `json:"max"`
+	Centroids   [][2]float64 `json:"centroids"`
+}
+
+func (td *TDigest) MarshalJSON() ([]byte, error) {
+	td.flush()
+	s := tDigestJSON{Compression: td.compression, Min: td.min, Max: td.max, Centroids: make([][2]float64, len(td.centroids))}
+	if td.count == 0 {
+		s.Min, s.Max = 0, 0
+	}
+
+	for i, c := range td.centroids {
+		s.Centroids[i] = [2]float64{c.mean, c.weight}
+	}
+
+	return json.Marshal(s)
+}
+
+func (td *TDigest) UnmarshalJSON(b []byte) error {
+	var s tDigestJSON
+	if ee := json.Unmarshal(b, &s); ee != nil {
+		return ee
+	}
+
+	r, ee := NewTDigest(s.Compression)
+	if ee != nil {
+		return ee
+	}
+
+	if len(s.Centroids) > 0 {
+		r.min, r.max = s.Min, s.Max
+	}
+
+	for _, c := range s.Centroids {
+		if !(c[1] > 0) || c[0] < s.Min || c[0] > s.Max {
+			return err.New(err.EINVAL, fmt.Sprintf("TDigest: centroid %v is not within [%v, %v] of positive weight", c, s.Min, s.Max))
+		}
+		r.buf = append(r.buf, centroid{c[0], c[1]})
+	}
+	r.flush()
+
+	*td = *r
+	return nil
+}
@@ -0,0 +1,100 @@
+package sample
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestTDigest(t *testing.T) {
+	const n = 100000
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, n)
+	td, _ := NewTDigest(DefaultCompression)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+		td.Add(x[i])
+	}
+
+	if td.Count() != n || float64(len(td.centroids)) > math.Pi*DefaultCompression/2+1 {
+		t.Errorf("Mismatch. Count, centroids want: %v, at most %v, got: %v, %v", n, math.Pi*DefaultCompression/2, td.Count(), len(td.centroids))
+	}
+
+	// the rank error shrinks towards the tails
+	for _, p := range []float64{.001, .01, .1, .25, .5, .75, .9, .99, .999} {
+		q := td.Quantile(p)
+		if r := float64(countBelow(x, q)) / n; math.Abs(r-p) > .02*math.Sqrt(p*(1-p)) {
+			t.Errorf("Mismatch. rank of Quantile(%v) want: %v, got: %v", p, p, r)
+		}
+
+		if got := td.Distribution(q); math.Abs(got-p) > 1e-12 {
+			t.Errorf("Mismatch. Distribution(Quantile(%v)) want: %v, got: %v", p, p, got)
+		}
+	}
+
+	if td.Quantile(0) != Quantile(0, Linear, x, nil) || td.Quantile(1) != Quantile(1, Linear, x, nil) {
+		t.Errorf("Mismatch. extremes want: %v, %v, got: %v, %v", Quantile(0, Linear, x, nil), Quantile(1, Linear, x, nil), td.Quantile(0), td.Quantile(1))
+	}
+
+	empty, _ := NewTDigest(DefaultCompression)
+	if !math.IsNaN(empty.Quantile(.5)) || !math.IsNaN(empty.Distribution(0)) {
+		t.Errorf("Mismatch. empty want: NaN, got: %v, %v", empty.Quantile(.5), empty.Distribution(0))
+	}
+
+	if _, e := NewTDigest(0); e == nil {
+		t.Errorf("Mismatch. NewTDigest(0) want: error, got: nil")
+	}
+}
+
+func countBelow(x []float64, q float64) int {
+	var c int
+	for _, v := range x {
+		if v <= q {
+			c++
+		}
+	}
+
+	return c
+}
+
+func TestTDigestMergeJSON(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	var x []float64
+	parts := make([]*TDigest, 4)
+	for k := range parts {
+		parts[k], _ = NewTDigest(DefaultCompression)
+		for i := 0; i < 25000; i++ {
+			v := rnd.ExpFloat64() * float64(k+1)
+			parts[k].Add(v)
+			x = append(x, v)
+		}
+	}
+
+	for _, o := range parts[1:] {
+		parts[0].Merge(o)
+	}
+
+	td := parts[0]
+	for _, p := range []float64{.01, .5, .9, .99} {
+		if r := float64(countBelow(x, td.Quantile(p))) / float64(len(x)); math.Abs(r-p) > .005 {
+			t.Errorf("Mismatch. merged rank of Quantile(%v) want: %v, got: %v", p, p, r)
+		}
+	}
+
+	b, e := json.Marshal(td)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	var r TDigest
+	if e := json.Unmarshal(b, &r); e != nil {
+		t.Fatal(e)
+	}
+
+	for _, p := range []float64{0, .3, .99, 1} {
+		if r.Quantile(p) != td.Quantile(p) || r.Count() != td.Count() {
+			t.Errorf("Mismatch. round trip Quantile(%v) want: %v, got: %v", p, td.Quantile(p), r.Quantile(p))
+		}
+	}
+}
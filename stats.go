@@ -1,13 +1,35 @@
 package stats
 
 import (
-	"fmt"
 	gslerr "github.com/jtejido/ggsl/err"
-	"math"
+	"github.com/jtejido/stats/err"
+	"sync"
+)
+
+var (
+	gslErrorsMu sync.RWMutex
+	gslErrors   = &err.Config{Policy: err.PolicyCollect}
 )
 
 func init() {
-	gslerr.SetErrorHandlerOff()
+	gslerr.SetErrorHandler(func(reason, file string, line, gsl_errno int) {
+		gslErrorsMu.RLock()
+		c := gslErrors
+		gslErrorsMu.RUnlock()
+		c.Handle(reason, file, line, gsl_errno)
+	})
+}
+
+// SetGSLErrors sets the policy for errors raised inside ggsl, such as underflows in special functions,
+// which are mostly harmless to the distributions built on them, and returns the previous one. By default
+// they are collected as diagnostics, and dropped when none are being collected; a nil c hands them to the
+// default error handler of the err package.
+func SetGSLErrors(c *err.Config) *err.Config {
+	gslErrorsMu.Lock()
+	defer gslErrorsMu.Unlock()
+	previous := gslErrors
+	gslErrors = c
+	return previous
 }
 
 type RandomVariate interface {
@@ -30,101 +52,3 @@ func (l Limits) String() string {
 
 	return s
 }
-
-// https://en.wikipedia.org/wiki/Interval_(mathematics)
-type Interval struct {
-	Lower, Upper         float64
-	LowerOpen, UpperOpen bool
-}
-
-// Check if x is within the interval.
-func (i Interval) IsWithinInterval(x float64) bool {
-	// disregard NaNs.
-	if math.IsNaN(x) {
-		return false
-	}
-
-	// If the lower limit is -∞, we are always within bounds.
-	if !math.IsInf(i.Lower, -1) {
-		if i.LowerOpen {
-			if x <= i.Lower {
-				return false // x must be > lower bound
-			}
-		}
-
-		if x < i.Lower {
-			return false // x must be >= lower bound
-		}
-	}
-
-	// If the upper limit is ∞, we are always within bounds.
-	if !math.IsInf(i.Upper, 1) {
-		if i.UpperOpen {
-			if x >= i.Upper {
-				return false // x must be < upper bound
-			}
-		}
-
-		if x > i.Upper {
-			return false // x must be <= upper bound
-		}
-
-	}
-
-	return true
-}
-
-// Check if length is equal the interval.
-func (i Interval) IsEqualLength(len float64) bool {
-	// disregard NaNs.
-	if math.IsNaN(len) {
-		return false
-	}
-
-	a := i.Lower
-	b := i.Upper
-
-	if i.LowerOpen {
-		a -= math.SmallestNonzeroFloat64
-	}
-
-	if i.UpperOpen {
-		b -= math.SmallestNonzeroFloat64
-	}
-
-	return math.Abs(a-b) == len
-}
-
-func (i Interval) String() string {
-	l_temp := fmt.Sprintf("%v", i.Lower)
-
-	if math.IsInf(i.Lower, 1) {
-		l_temp = "∞"
-	}
-
-	if math.IsInf(i.Lower, -1) {
-		l_temp = "-∞"
-	}
-
-	u_temp := fmt.Sprintf("%v", i.Upper)
-
-	if math.IsInf(i.Upper, -1) {
-		u_temp = "-∞"
-	}
-
-	if math.IsInf(i.Upper, 1) {
-		u_temp = "∞"
-	}
-
-	str := "(" + l_temp + "," + u_temp + ")"
-
-	if !i.LowerOpen {
-		str = "[" + str[1:]
-	}
-
-	if !i.UpperOpen {
-		str = str[:len(str)-1] + "]"
-	}
-
-	return str
-}
@@ -0,0 +1,290 @@
+package test
+
+import (
+	"github.com/jtejido/stats"
+	"github.com/jtejido/stats/sample"
+	"math"
+	"sort"
+	"testing"
+)
+
+// Distribution is what the conformance checks need of a continuous distribution.
+type Distribution interface {
+	Probability(float64) float64
+	Distribution(float64) float64
+	Inverse(float64) float64
+	Rand() float64
+	Support() stats.Interval
+}
+
+// Config tunes the conformance checks, zero fields take their defaults.
+type Config struct {
+	Tol     float64 // absolute tolerance on probabilities and relative tolerance on quantiles, default 1e-6
+	Samples int     // number of variates drawn by Moments and KolmogorovSmirnov, default 10000
+	Alpha   float64 // significance level of the statistical checks, default 1e-4
+}
+
+func (c Config) withDefaults() Config {
+	if c.Tol <= 0 {
+		c.Tol = 1e-6
+	}
+
+	if c.Samples <= 0 {
+		c.Samples = 10000
+	}
+
+	if c.Alpha <= 0 {
+		c.Alpha = 1e-4
+	}
+
+	return c
+}
+
+// Probabilities at which the checks place their grid of quantiles.
+var gridProbabilities = []float64{.001, .01, .05, .1, .2, .3, .4, .5, .6, .7, .8, .9, .95, .99, .999}
+
+// Conformance runs every check below on d as subtests of t. The draws from d.Rand() make the statistical
+// checks deterministic only if d has a seeded source; their false failure rate is Alpha otherwise.
+func Conformance(t *testing.T, d Distribution, c Config) {
+	t.Run("Normalization", func(t *testing.T) { Normalization(t, d, c) })
+	t.Run("Distribution", func(t *testing.T) { CumulativeDistribution(t, d, c) })
+	t.Run("Inverse", func(t *testing.T) { Inverse(t, d, c) })
+	t.Run("Moments", func(t *testing.T) { Moments(t, d, c) })
+	t.Run("KolmogorovSmirnov", func(t *testing.T) { KolmogorovSmirnov(t, d, c) })
+}
+
+// Normalization checks that the density integrates to 1 over Support().
+func Normalization(t *testing.T, d Distribution, c Config) {
+	c = c.withDefaults()
+	g := newGrid(d, c)
+	var total float64
+	for _, p := range g.pieces {
+		total += p
+	}
+
+	if math.Abs(total-1) > c.Tol {
+		t.Errorf("Mismatch. integral of Probability over %v want: 1, got: %v", d.Support(), total)
+	}
+}
+
+// CumulativeDistribution checks that Distribution is non-decreasing within [0, 1] over Support(), that it
+// matches the integral of Probability between quantiles and out to either end of the support, and that it
+// is 0 below the support and 1 above it.
+func CumulativeDistribution(t *testing.T, d Distribution, c Config) {
+	c = c.withDefaults()
+	g := newGrid(d, c)
+	if len(g.x) == 0 {
+		t.Fatalf("no quantiles of %v within %v", d, d.Support())
+	}
+
+	var prev, cum float64
+	for i, x := range g.x {
+		// fill 16 points between consecutive quantiles for the monotonicity check
+		if i > 0 {
+			for k := 1; k < 16; k++ {
+				y := g.x[i-1] + (x-g.x[i-1])*float64(k)/16
+				prev = checkMonotone(t, d, y, prev)
+			}
+		}
+		prev = checkMonotone(t, d, x, prev)
+
+		cum += g.pieces[i]
+		if p := d.Distribution(x); math.Abs(p-cum) > c.Tol {
+			t.Errorf("Mismatch. Distribution(%v) want: %v (integral of Probability), got: %v", x, cum, p)
+		}
+	}
+
+	s := d.Support()
+	for _, x := range []float64{math.Inf(-1), s.Lower - 1, s.Lower} {
+		if lo := d.Distribution(x); math.Abs(lo) > c.Tol {
+			t.Errorf("Mismatch. Distribution(%v) want: 0, got: %v", x, lo)
+		}
+	}
+
+	for _, x := range []float64{s.Upper, s.Upper + 1, math.Inf(1)} {
+		if hi := d.Distribution(x); math.Abs(hi-1) > c.Tol {
+			t.Errorf("Mismatch. Distribution(%v) want: 1, got: %v", x, hi)
+		}
+	}
+}
+
+func checkMonotone(t *testing.T, d Distribution, x, prev float64) float64 {
+	p := d.Distribution(x)
+	if !(p >= prev && p <= 1) {
+		t.Errorf("Distribution(%v) = %v, not within [%v, 1]", x, p, prev)
+		return prev
+	}
+
+	return p
+}
+
+// Inverse checks that Inverse(Distribution(x)) ≈ x at the grid of quantiles. Where the distribution is
+// too flat for x to be recovered within Tol, the round trip is instead checked in probability.
+func Inverse(t *testing.T, d Distribution, c Config) {
+	c = c.withDefaults()
+	g := newGrid(d, c)
+	for _, x := range g.x {
+		p := d.Distribution(x)
+		if p <= 0 || p >= 1 {
+			continue
+		}
+
+		y := d.Inverse(p)
+		if math.Abs(y-x) <= c.Tol*math.Max(1, math.Abs(x)) {
+			continue
+		}
+
+		if q := d.Distribution(y); !(math.Abs(q-p) <= c.Tol*math.Max(p, 1-p)) {
+			t.Errorf("Mismatch. Inverse(Distribution(%v)) want: %v, got: %v", x, x, y)
+		}
+	}
+
+	s := d.Support()
+	if lo := d.Inverse(0); lo != s.Lower {
+		t.Errorf("Mismatch. Inverse(0) want: %v, got: %v", s.Lower, lo)
+	}
+
+	if hi := d.Inverse(1); hi != s.Upper {
+		t.Errorf("Mismatch. Inverse(1) want: %v, got: %v", s.Upper, hi)
+	}
+}
+
+// Moments checks the sample mean and variance of Rand() against Mean() and Variance(), if d has them and
+// they are finite. The variance is only checked when ExKurtosis() is finite, as its sampling error
+// depends on it. A moment whose method panics, as the unimplemented ones do, is taken to be missing.
+func Moments(t *testing.T, d Distribution, c Config) {
+	c = c.withDefaults()
+	mean := moment(d, "Mean")
+	if math.IsNaN(mean) || math.IsInf(mean, 0) {
+		t.Skipf("%v has no finite mean", d)
+	}
+
+	n := float64(c.Samples)
+	var sm, sv float64
+	for i := 1; i <= c.Samples; i++ {
+		x := d.Rand()
+		delta := x - sm
+		sm += delta / float64(i)
+		sv += delta * (x - sm)
+	}
+	sv /= n - 1
+
+	variance := moment(d, "Variance")
+	if math.IsNaN(variance) || math.IsInf(variance, 0) {
+		return
+	}
+
+	z := normalQuantile(1 - c.Alpha/2)
+	if math.Abs(sm-mean) > z*math.Sqrt(variance/n) {
+		t.Errorf("Mismatch. sample mean want: %v, got: %v", mean, sm)
+	}
+
+	k := moment(d, "ExKurtosis")
+	if math.IsNaN(k) || math.IsInf(k, 0) {
+		return
+	}
+
+	if se := variance * math.Sqrt((k+2)/n); math.Abs(sv-variance) > z*se {
+		t.Errorf("Mismatch. sample variance want: %v, got: %v", variance, sv)
+	}
+}
+
+// Value of d's Mean, Variance or ExKurtosis method, or NaN if d has no such method or it panics.
+func moment(d Distribution, name string) (v float64) {
+	var f func() float64
+	switch name {
+	case "Mean":
+		if m, ok := d.(interface{ Mean() float64 }); ok {
+			f = m.Mean
+		}
+	case "Variance":
+		if m, ok := d.(interface{ Variance() float64 }); ok {
+			f = m.Variance
+		}
+	case "ExKurtosis":
+		if m, ok := d.(interface{ ExKurtosis() float64 }); ok {
+			f = m.ExKurtosis
+		}
+	}
+
+	if f == nil {
+		return math.NaN()
+	}
+
+	defer func() {
+		if recover() != nil {
+			v = math.NaN()
+		}
+	}()
+
+	return f()
+}
+
+// KolmogorovSmirnov tests Samples draws of Rand() against Distribution, failing at significance Alpha.
+func KolmogorovSmirnov(t *testing.T, d Distribution, c Config) {
+	c = c.withDefaults()
+	x := make([]float64, c.Samples)
+	for i := range x {
+		x[i] = d.Rand()
+	}
+	sort.Float64s(x)
+
+	n := float64(len(x))
+	var D float64
+	for i, v := range x {
+		p := d.Distribution(v)
+		D = math.Max(D, math.Max(p-float64(i)/n, float64(i+1)/n-p))
+	}
+
+	if p := sample.KolmogorovSmirnovPValue(D, n); p < c.Alpha {
+		t.Errorf("Rand() fails the Kolmogorov-Smirnov test, D = %v, p-value = %v", D, p)
+	}
+}
+
+// Quantiles of d at gridProbabilities that lie strictly within its support, with the integral of the
+// density from the previous quantile (or the lower end of the support) up to each one, and from the last
+// one to the upper end.
+type grid struct {
+	x      []float64
+	pieces []float64
+}
+
+func newGrid(d Distribution, c Config) grid {
+	s := d.Support()
+	var g grid
+	for _, p := range gridProbabilities {
+		x := d.Inverse(p)
+		if !(x > s.Lower && x < s.Upper) || (len(g.x) > 0 && x <= g.x[len(g.x)-1]) {
+			continue
+		}
+		g.x = append(g.x, x)
+	}
+
+	scale := 1.
+	if len(g.x) > 1 {
+		scale = (g.x[len(g.x)-1] - g.x[0]) / 2
+	}
+
+	tol := c.Tol / float64(4*(len(g.x)+1))
+	lower := s.Lower
+	for _, x := range append(g.x, s.Upper) {
+		v, _ := integrate(d.Probability, lower, x, scale, tol)
+		g.pieces = append(g.pieces, v)
+		lower = x
+	}
+
+	return g
+}
+
+// Quantile of the standard normal, refined from the Abramowitz and Stegun 26.2.23 approximation by Newton
+// steps on math.Erfc. Only needed for p close to 1.
+func normalQuantile(p float64) float64 {
+	q := 1 - p
+	u := math.Sqrt(-2 * math.Log(q))
+	z := u - (2.515517+.802853*u+.010328*u*u)/(1+1.432788*u+.189269*u*u+.001308*u*u*u)
+	for i := 0; i < 3; i++ {
+		z += (math.Erfc(z/math.Sqrt2)/2 - q) / (math.Exp(-z*z/2) / math.Sqrt(2*math.Pi))
+	}
+
+	return z
+}
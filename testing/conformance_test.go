@@ -0,0 +1,47 @@
+package test
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntegrate(t *testing.T) {
+	cases := []struct {
+		name     string
+		f        func(float64) float64
+		a, b     float64
+		expected float64
+	}{
+		{"x²", func(x float64) float64 { return x * x }, 0, 3, 9},
+		{"exp(-x)", func(x float64) float64 { return math.Exp(-x) }, 0, math.Inf(1), 1},
+		{"exp(x)", math.Exp, math.Inf(-1), 0, 1},
+		{"gaussian", func(x float64) float64 { return math.Exp(-x * x / 2) }, math.Inf(-1), math.Inf(1), math.Sqrt(2 * math.Pi)},
+		{"arcsine", func(x float64) float64 { return 1 / (math.Pi * math.Sqrt(x*(1-x))) }, 0, 1, 1},
+		{"cauchy", func(x float64) float64 { return 1 / (math.Pi * (1 + x*x)) }, math.Inf(-1), math.Inf(1), 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, _ := integrate(c.f, c.a, c.b, 1, 1e-10)
+			if math.Abs(res-c.expected) > 1e-8 {
+				t.Errorf("Mismatch. want: %v, got: %v", c.expected, res)
+			}
+		})
+	}
+}
+
+func TestNormalQuantile(t *testing.T) {
+	cases := []struct {
+		p, expected float64
+	}{
+		{.975, 1.959963984540054},
+		{.99995, 3.890591886413094},
+		{.999, 3.090232306167813},
+	}
+
+	for _, c := range cases {
+		if res := normalQuantile(c.p); math.Abs(res-c.expected) > 1e-9 {
+			t.Errorf("Mismatch. p = %v, want: %v, got: %v", c.p, c.expected, res)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Golden is one line of reference data for a distribution, produced offline to more digits than a float64
+// holds. A point line gives the density and cdf at X, a quantile line the quantile at P; fields that a
+// line does not give are NaN.
+type Golden struct {
+	Dist     string  `json:"dist"`   // registered name, e.g. Gamma
+	Params   string  `json:"params"` // arguments as written in a spec, e.g. "shape=2, rate=0.5"
+	X        float64 `json:"x"`
+	PDF      float64 `json:"pdf"`
+	CDF      float64 `json:"cdf"`
+	P        float64 `json:"p"`
+	Quantile float64 `json:"quantile"`
+}
+
+// Spec returns the distribution of g as a spec, e.g. Gamma(shape=2, rate=0.5).
+func (g Golden) Spec() string {
+	return g.Dist + "(" + g.Params + ")"
+}
+
+func (g *Golden) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Dist, Params             string
+		X, PDF, CDF, P, Quantile *float64
+	}
+	if e := json.Unmarshal(b, &raw); e != nil {
+		return e
+	}
+
+	g.Dist, g.Params = raw.Dist, raw.Params
+	for _, f := range []struct {
+		dst *float64
+		src *float64
+	}{{&g.X, raw.X}, {&g.PDF, raw.PDF}, {&g.CDF, raw.CDF}, {&g.P, raw.P}, {&g.Quantile, raw.Quantile}} {
+		*f.dst = math.NaN()
+		if f.src != nil {
+			*f.dst = *f.src
+		}
+	}
+
+	return nil
+}
+
+// Columns of a golden CSV file, which starts with them as its header.
+var goldenColumns = []string{"dist", "params", "x", "pdf", "cdf", "p", "quantile"}
+
+// LoadGolden reads the golden file at path, as CSV or JSON depending on its extension.
+func LoadGolden(path string) ([]Golden, error) {
+	f, e := os.Open(path)
+	if e != nil {
+		return nil, e
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ReadGoldenCSV(f)
+	case ".json":
+		return ReadGoldenJSON(f)
+	}
+
+	return nil, fmt.Errorf("%s: unknown golden file format", path)
+}
+
+// ReadGoldenCSV reads golden lines from CSV with the header dist,params,x,pdf,cdf,p,quantile. Empty
+// cells are read as NaN.
+func ReadGoldenCSV(r io.Reader) ([]Golden, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	header, e := cr.Read()
+	if e != nil {
+		return nil, e
+	}
+
+	if strings.Join(header, ",") != strings.Join(goldenColumns, ",") {
+		return nil, fmt.Errorf("golden CSV header want: %s, got: %s", strings.Join(goldenColumns, ","), strings.Join(header, ","))
+	}
+
+	var rows []Golden
+	for n := 2; ; n++ {
+		rec, e := cr.Read()
+		if e == io.EOF {
+			return rows, nil
+		}
+
+		if e != nil {
+			return nil, e
+		}
+
+		g := Golden{Dist: rec[0], Params: rec[1]}
+		for i, dst := range []*float64{&g.X, &g.PDF, &g.CDF, &g.P, &g.Quantile} {
+			if *dst, e = parseGoldenFloat(rec[i+2]); e != nil {
+				return nil, fmt.Errorf("golden CSV record %d: %v", n, e)
+			}
+		}
+		rows = append(rows, g)
+	}
+}
+
+// ReadGoldenJSON reads golden lines from a JSON array of objects with the keys of the CSV header. Missing
+// keys are read as NaN.
+func ReadGoldenJSON(r io.Reader) ([]Golden, error) {
+	var rows []Golden
+	if e := json.NewDecoder(r).Decode(&rows); e != nil {
+		return nil, e
+	}
+
+	return rows, nil
+}
+
+func parseGoldenFloat(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return math.NaN(), nil
+	}
+
+	return strconv.ParseFloat(s, 64)
+}
+
+// Quantiles is what CheckGolden needs of a distribution.
+type Quantiles interface {
+	Probability(float64) float64
+	Distribution(float64) float64
+	Inverse(float64) float64
+}
+
+// Tolerances gives the largest fractional difference CheckGolden accepts, looked up by "Dist.Function"
+// (Function being Probability, Distribution or Inverse), then by "Dist", then by "".
+type Tolerances map[string]float64
+
+func (tol Tolerances) of(dist, function string) float64 {
+	for _, k := range []string{dist + "." + function, dist, ""} {
+		if v, ok := tol[k]; ok {
+			return v
+		}
+	}
+
+	return 0
+}
+
+// Accuracy summarises the errors of one function of one distribution against its golden values.
+type Accuracy struct {
+	Dist, Function string
+	Count          int
+	MaxFracDiff    float64 // largest FracDiff
+	MeanFracDiff   float64
+	MaxULP         float64 // largest ULP distance
+	Worst          string  // the spec and argument of the largest FracDiff
+}
+
+// Report holds the accuracy of every function checked, sorted by distribution then function.
+type Report []*Accuracy
+
+func (r Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-24s %-12s %6s %12s %12s %12s  %s\n", "dist", "function", "n", "max frac", "mean frac", "max ulp", "worst")
+	for _, a := range r {
+		fmt.Fprintf(&b, "%-24s %-12s %6d %12.3e %12.3e %12.4g  %s\n", a.Dist, a.Function, a.Count, a.MaxFracDiff, a.MeanFracDiff, a.MaxULP, a.Worst)
+	}
+
+	return b.String()
+}
+
+// CheckGolden evaluates every golden line on the distribution parse builds from its spec, reporting to t
+// each value whose FracDiff from the reference exceeds its tolerance. It returns the accuracy of every
+// function, which is also logged when STAT_TEST_VERBOSE is set.
+func CheckGolden(t *testing.T, rows []Golden, parse func(spec string) (Quantiles, error), tol Tolerances) Report {
+	if tests == 0 {
+		initialise()
+	}
+
+	acc := make(map[[2]string]*Accuracy)
+	record := func(g Golden, function string, arg, got, want float64) {
+		k := [2]string{g.Dist, function}
+		a, ok := acc[k]
+		if !ok {
+			a = &Accuracy{Dist: g.Dist, Function: function}
+			acc[k] = a
+		}
+
+		f := FracDiff(want, got)
+		a.Count++
+		a.MeanFracDiff += (f - a.MeanFracDiff) / float64(a.Count)
+		a.MaxULP = math.Max(a.MaxULP, ULPs(got, want))
+		desc := fmt.Sprintf("%s.%s(%v)", g.Spec(), function, arg)
+		if f > a.MaxFracDiff || a.Count == 1 {
+			a.MaxFracDiff = f
+			a.Worst = desc
+		}
+
+		status := 0
+		if !(f <= tol.of(g.Dist, function)) {
+			status = 1
+		}
+
+		Test(t, status, fmt.Sprintf("%s want: %.17g, got: %.17g, fracdiff: %.3e", desc, want, got, f))
+	}
+
+	specs := make(map[string]Quantiles)
+	for _, g := range rows {
+		d, ok := specs[g.Spec()]
+		if !ok {
+			var e error
+			if d, e = parse(g.Spec()); e != nil {
+				t.Errorf("%s: %v", g.Spec(), e)
+				continue
+			}
+			specs[g.Spec()] = d
+		}
+
+		if !math.IsNaN(g.X) {
+			if !math.IsNaN(g.PDF) {
+				record(g, "Probability", g.X, d.Probability(g.X), g.PDF)
+			}
+
+			if !math.IsNaN(g.CDF) {
+				record(g, "Distribution", g.X, d.Distribution(g.X), g.CDF)
+			}
+		}
+
+		if !math.IsNaN(g.P) && !math.IsNaN(g.Quantile) {
+			record(g, "Inverse", g.P, d.Inverse(g.P), g.Quantile)
+		}
+	}
+
+	report := make(Report, 0, len(acc))
+	for _, a := range acc {
+		report = append(report, a)
+	}
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Dist != report[j].Dist {
+			return report[i].Dist < report[j].Dist
+		}
+		return report[i].Function < report[j].Function
+	})
+
+	if verbose != 0 {
+		t.Logf("\n%v", report)
+	}
+
+	return report
+}
+
+// FracDiff is the fractional difference |x1-x2|/|x1+x2| by which the tests of this module compare an
+// expected x1 to an obtained x2: |x2| if x1 is 0, and 1 if either is infinite or their sum is 0. Equal
+// values, and two NaN, differ by 0.
+func FracDiff(x1, x2 float64) float64 {
+	if x1 == x2 || (math.IsNaN(x1) && math.IsNaN(x2)) {
+		return 0
+	} else if x1 == 0.0 {
+		return math.Abs(x2)
+	} else if x1 <= math.MaxFloat64 && x2 <= math.MaxFloat64 && (x1+x2 != 0.0) {
+		return math.Abs((x1 - x2) / (x1 + x2))
+	}
+
+	return 1.0
+}
+
+// ULPs is the number of representable float64 values between x1 and x2, +Inf if either is NaN.
+func ULPs(x1, x2 float64) float64 {
+	if math.IsNaN(x1) || math.IsNaN(x2) {
+		if math.IsNaN(x1) && math.IsNaN(x2) {
+			return 0
+		}
+		return math.Inf(1)
+	}
+
+	return math.Abs(float64(ordered(x1) - ordered(x2)))
+}
+
+// Maps the bits of x onto integers that are ordered as the floats are.
+func ordered(x float64) int64 {
+	b := int64(math.Float64bits(x))
+	if b < 0 {
+		return math.MinInt64 - b
+	}
+
+	return b
+}
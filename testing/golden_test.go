@@ -0,0 +1,101 @@
+package test
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestReadGolden(t *testing.T) {
+	csv := `dist,params,x,pdf,cdf,p,quantile
+# a comment
+Normal,"location=0, scale=1",0,0.398942280401432677940,0.5,,
+Normal,"location=0, scale=1",,,,0.975,1.95996398454005423552
+`
+	json := `[
+	{"dist": "Normal", "params": "location=0, scale=1", "x": 0, "pdf": 0.398942280401432677940, "cdf": 0.5},
+	{"dist": "Normal", "params": "location=0, scale=1", "p": 0.975, "quantile": 1.95996398454005423552}
+]`
+
+	fromCSV, e := ReadGoldenCSV(strings.NewReader(csv))
+	if e != nil {
+		t.Fatalf("ReadGoldenCSV: %v", e)
+	}
+
+	fromJSON, e := ReadGoldenJSON(strings.NewReader(json))
+	if e != nil {
+		t.Fatalf("ReadGoldenJSON: %v", e)
+	}
+
+	for _, rows := range [][]Golden{fromCSV, fromJSON} {
+		if len(rows) != 2 {
+			t.Fatalf("Mismatch. want: 2 lines, got: %v", rows)
+		}
+
+		if s := rows[0].Spec(); s != "Normal(location=0, scale=1)" {
+			t.Errorf("Mismatch. want: Normal(location=0, scale=1), got: %s", s)
+		}
+
+		p, q := rows[0], rows[1]
+		if p.X != 0 || p.PDF != 0.3989422804014327 || p.CDF != .5 || !math.IsNaN(p.P) || !math.IsNaN(p.Quantile) {
+			t.Errorf("Mismatch. point line got: %+v", p)
+		}
+
+		if !math.IsNaN(q.X) || !math.IsNaN(q.PDF) || !math.IsNaN(q.CDF) || q.P != .975 || q.Quantile != 1.9599639845400543 {
+			t.Errorf("Mismatch. quantile line got: %+v", q)
+		}
+	}
+
+	for _, bad := range []string{
+		"dist,params,x,pdf,cdf\nNormal,,0,1,1\n",
+		"dist,params,x,pdf,cdf,p,quantile\nNormal,,zero,,,,\n",
+		"dist,params,x,pdf,cdf,p,quantile\nNormal,,0\n",
+	} {
+		if rows, e := ReadGoldenCSV(strings.NewReader(bad)); e == nil {
+			t.Errorf("ReadGoldenCSV(%q) want error, got: %v", bad, rows)
+		}
+	}
+}
+
+func TestFracDiff(t *testing.T) {
+	cases := []struct {
+		x1, x2, expected float64
+	}{
+		{0, 0, 0},
+		{1, 1, 0},
+		{math.Inf(1), math.Inf(1), 0},
+		{math.NaN(), math.NaN(), 0},
+		{0, 1e-20, 1e-20},
+		{1e-20, 0, 1},
+		{1, 3, .5},
+		{1, -1, 1},
+		{math.Inf(1), 1, 1},
+		{1, math.NaN(), 1},
+	}
+
+	for _, c := range cases {
+		if res := FracDiff(c.x1, c.x2); res != c.expected {
+			t.Errorf("Mismatch. FracDiff(%v, %v) want: %v, got: %v", c.x1, c.x2, c.expected, res)
+		}
+	}
+}
+
+func TestULPs(t *testing.T) {
+	cases := []struct {
+		x1, x2, expected float64
+	}{
+		{1, 1, 0},
+		{1, math.Nextafter(1, 2), 1},
+		{1, math.Nextafter(math.Nextafter(1, 0), 0), 2},
+		{0, math.Copysign(0, -1), 0},
+		{math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64, 2},
+		{math.MaxFloat64, math.Inf(1), 1},
+		{1, math.NaN(), math.Inf(1)},
+	}
+
+	for _, c := range cases {
+		if res := ULPs(c.x1, c.x2); res != c.expected {
+			t.Errorf("Mismatch. ULPs(%v, %v) want: %v, got: %v", c.x1, c.x2, c.expected, res)
+		}
+	}
+}
@@ -2,9 +2,6 @@ package test
 
 import (
 	"fmt"
-	gsl "github.com/jtejido/ggsl"
-	"github.com/jtejido/ggsl/sys"
-	"math"
 	"os"
 	"strconv"
 	"testing"